@@ -3,6 +3,7 @@ package builder
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -10,6 +11,7 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/jasoet/pkg/v2/otel"
@@ -47,20 +49,25 @@ type WorkflowBuilder struct {
 	serviceAccount        string
 	archiveLogs           *bool
 	retryStrategy         *v1alpha1.RetryStrategy
+	workflowRetry         *v1alpha1.RetryStrategy
 	podGC                 *v1alpha1.PodGC
 	ttl                   *v1alpha1.TTLStrategy
 	volumes               []corev1.Volume
+	parameters            []v1alpha1.Parameter
 	labels                map[string]string
 	annotations           map[string]string
 	activeDeadlineSeconds *int64
 
 	// Workflow structure
 	entryPoint      []v1alpha1.ParallelSteps
+	dagTasks        []v1alpha1.DAGTask
 	templates       []v1alpha1.Template
 	exitHandlers    []v1alpha1.ParallelSteps
 	metrics         *v1alpha1.Metrics
 	uniqueTemplates map[string]struct{}
-	errors          []error
+	// templateConflicts holds names added again with a different spec
+	templateConflicts []string
+	errors            []error
 
 	// OpenTelemetry
 	otelConfig *otel.Config
@@ -225,6 +232,117 @@ func (b *WorkflowBuilder) AddParallel(source WorkflowSourceV2) *WorkflowBuilder
 	return b
 }
 
+// AddWithDeps adds a WorkflowSource as DAG tasks that run after the named tasks.
+// Each step of the source becomes a DAG task with the same name. The first task
+// depends on dependsOn; any further steps from the same source run sequentially
+// after it. Every name in dependsOn must refer to a task added earlier with
+// AddWithDeps, otherwise Build returns an error.
+//
+// When AddWithDeps is used, the entrypoint is a DAG template and cannot be
+// combined with Add or AddParallel.
+//
+// Example (diamond: A → B, A → C, B & C → D):
+//
+//	builder.
+//	    AddWithDeps(a).
+//	    AddWithDeps(b, "a").
+//	    AddWithDeps(c, "a").
+//	    AddWithDeps(d, "b", "c")
+func (b *WorkflowBuilder) AddWithDeps(source WorkflowSource, dependsOn ...string) *WorkflowBuilder {
+	ctx := context.Background()
+
+	// Start tracing
+	if b.otel != nil {
+		var span trace.Span
+		ctx, span = b.otel.startSpan(ctx, "WorkflowBuilder.AddWithDeps")
+		defer span.End()
+	}
+
+	logger := otel.NewLogHelper(ctx, b.otelConfig,
+		"github.com/jasoet/pkg/v2/argo/builder", "WorkflowBuilder.AddWithDeps")
+	logger.Debug("Adding workflow source as DAG tasks", otel.F("depends_on", strings.Join(dependsOn, ",")))
+
+	// Validate dependencies refer to previously added tasks
+	for _, dep := range dependsOn {
+		if !b.hasDAGTask(dep) {
+			err := fmt.Errorf("dependency %q does not refer to a previously added task", dep)
+			b.errors = append(b.errors, err)
+			logger.Error(err, "Invalid DAG dependency")
+			return b
+		}
+	}
+
+	// Get templates from source
+	templates, err := source.Templates()
+	if err != nil {
+		b.errors = append(b.errors, fmt.Errorf("failed to get templates: %w", err))
+		logger.Error(err, "Failed to get templates from source")
+		return b
+	}
+
+	// Add templates (deduplicated)
+	for _, t := range templates {
+		b.insertTemplate(t)
+	}
+
+	// Get steps from source
+	steps, err := source.Steps()
+	if err != nil {
+		b.errors = append(b.errors, fmt.Errorf("failed to get steps: %w", err))
+		logger.Error(err, "Failed to get steps from source")
+		return b
+	}
+
+	// Convert steps to DAG tasks, chaining steps of the same source
+	deps := dependsOn
+	for _, step := range steps {
+		if b.hasDAGTask(step.Name) {
+			err := fmt.Errorf("duplicate DAG task name %q", step.Name)
+			b.errors = append(b.errors, err)
+			logger.Error(err, "Duplicate DAG task")
+			return b
+		}
+
+		b.dagTasks = append(b.dagTasks, v1alpha1.DAGTask{
+			Name:         step.Name,
+			Template:     step.Template,
+			TemplateRef:  step.TemplateRef,
+			Inline:       step.Inline,
+			Arguments:    step.Arguments,
+			When:         step.When,
+			ContinueOn:   step.ContinueOn,
+			WithItems:    step.WithItems,
+			WithParam:    step.WithParam,
+			WithSequence: step.WithSequence,
+			Hooks:        step.Hooks,
+			Dependencies: append([]string(nil), deps...),
+		})
+		deps = []string{step.Name}
+	}
+
+	// Record metrics
+	if b.otel != nil {
+		b.otel.incrementCounter(ctx, "sources_added", 1)
+		b.otel.incrementCounter(ctx, "templates_added", int64(len(templates)))
+	}
+
+	logger.Debug("DAG tasks added successfully",
+		otel.F("templates_count", len(templates)),
+		otel.F("tasks_count", len(steps)))
+
+	return b
+}
+
+// hasDAGTask reports whether a DAG task with the given name has been added.
+func (b *WorkflowBuilder) hasDAGTask(name string) bool {
+	for _, task := range b.dagTasks {
+		if task.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
 // AddExitHandler adds a WorkflowSource as an exit handler.
 // Exit handlers always run when the workflow completes, regardless of success or failure.
 // They are useful for cleanup operations and callbacks.
@@ -320,11 +438,17 @@ func (b *WorkflowBuilder) WithMetrics(provider WorkflowMetricsProvider) *Workflo
 // Returns an error if any errors occurred during workflow construction.
 //
 // The build process:
-// 1. Validates that at least one step exists (adds a no-op if empty)
+// 1. Validates the workflow and returns a *ValidationError listing every problem
 // 2. Creates the entrypoint template from collected steps
 // 3. Creates exit handler template if any exit handlers were added
 // 4. Assembles the complete workflow specification
 //
+// Validation catches mistakes that would otherwise only fail on submission: a
+// workflow without steps, duplicate step names, a template name reused for a
+// different spec, missing or malformed container images, steps referring to
+// unknown templates, and when conditions or dependencies referring to unknown
+// steps or tasks.
+//
 // Example:
 //
 //	wf, err := builder.
@@ -370,23 +494,55 @@ func (b *WorkflowBuilder) Build() (*v1alpha1.Workflow, error) {
 		return nil, b.errors[0]
 	}
 
-	// Ensure we have at least one step
-	if len(b.entryPoint) == 0 {
-		logger.Warn("No steps provided, workflow will be empty")
+	if len(b.entryPoint) > 0 && len(b.dagTasks) > 0 {
+		err := fmt.Errorf("cannot combine sequential steps (Add/AddParallel) with DAG tasks (AddWithDeps)")
+		if b.otel != nil {
+			b.otel.recordError(ctx, "build_validation_error", err)
+		}
+		logger.Error(err, "Failed to build workflow")
+		return nil, err
 	}
 
-	// Build a fresh templates slice so Build() is safe to call multiple times.
 	const entrypointName = "main"
+	const exitHandlerName = "exit-handler"
+
+	// Validate the workflow structure, collecting all problems
+	reserved := []string{entrypointName}
+	if len(b.exitHandlers) > 0 {
+		reserved = append(reserved, exitHandlerName)
+	}
+	problems := b.validateTemplates(reserved...)
+	if len(b.entryPoint) == 0 && len(b.dagTasks) == 0 {
+		problems = append(problems, fmt.Errorf("workflow has no steps"))
+	}
+	problems = append(problems, b.validateSteps(entrypointName, b.entryPoint)...)
+	problems = append(problems, b.validateDAG(b.dagTasks)...)
+	problems = append(problems, b.validateSteps(exitHandlerName, b.exitHandlers)...)
+	if len(problems) > 0 {
+		err := &ValidationError{Problems: problems}
+		if b.otel != nil {
+			b.otel.recordError(ctx, "build_validation_error", err)
+		}
+		logger.Error(err, "Workflow validation failed")
+		return nil, err
+	}
+
+	// Build a fresh templates slice so Build() is safe to call multiple times.
 	entrypoint := v1alpha1.Template{
 		Name:  entrypointName,
 		Steps: b.entryPoint,
 	}
+	if len(b.dagTasks) > 0 {
+		entrypoint.Steps = nil
+		entrypoint.DAG = &v1alpha1.DAGTemplate{
+			Tasks: append([]v1alpha1.DAGTask(nil), b.dagTasks...),
+		}
+	}
 	templates := make([]v1alpha1.Template, len(b.templates), len(b.templates)+2)
 	copy(templates, b.templates)
 	templates = append(templates, entrypoint)
 
 	// Create exit handler template if needed
-	const exitHandlerName = "exit-handler"
 	var onExit string
 	if len(b.exitHandlers) > 0 {
 		exitHandler := v1alpha1.Template{
@@ -409,12 +565,14 @@ func (b *WorkflowBuilder) Build() (*v1alpha1.Workflow, error) {
 			Entrypoint:            entrypointName,
 			ServiceAccountName:    b.serviceAccount,
 			Templates:             templates,
+			Arguments:             v1alpha1.Arguments{Parameters: b.parameters},
 			Volumes:               b.volumes,
 			Metrics:               b.metrics,
 			ArchiveLogs:           b.archiveLogs,
 			PodGC:                 b.podGC,
 			TTLStrategy:           b.ttl,
 			ActiveDeadlineSeconds: b.activeDeadlineSeconds,
+			RetryStrategy:         b.workflowRetry,
 			OnExit:                onExit,
 		},
 	}
@@ -436,6 +594,7 @@ func (b *WorkflowBuilder) Build() (*v1alpha1.Workflow, error) {
 			attribute.String("workflow.namespace", b.namespace),
 			attribute.Int("workflow.templates_count", len(templates)),
 			attribute.Int("workflow.steps_count", len(b.entryPoint)),
+			attribute.Int("workflow.dag_tasks_count", len(b.dagTasks)),
 			attribute.Bool("workflow.has_exit_handler", len(b.exitHandlers) > 0),
 		)
 	}
@@ -513,12 +672,29 @@ func (b *WorkflowBuilder) BuildWithEntrypoint(entrypointName string) (*v1alpha1.
 		return nil, err
 	}
 
+	const exitHandlerName = "exit-handler"
+
+	// Validate templates and exit handlers, collecting all problems
+	var reserved []string
+	if len(b.exitHandlers) > 0 {
+		reserved = append(reserved, exitHandlerName)
+	}
+	problems := b.validateTemplates(reserved...)
+	problems = append(problems, b.validateSteps(exitHandlerName, b.exitHandlers)...)
+	if len(problems) > 0 {
+		err := &ValidationError{Problems: problems}
+		if b.otel != nil {
+			b.otel.recordError(ctx, "build_validation_error", err)
+		}
+		logger.Error(err, "Workflow validation failed")
+		return nil, err
+	}
+
 	// Build a fresh templates slice so BuildWithEntrypoint() is safe to call multiple times.
 	templates := make([]v1alpha1.Template, len(b.templates), len(b.templates)+1)
 	copy(templates, b.templates)
 
 	// Create exit handler template if needed
-	const exitHandlerName = "exit-handler"
 	var onExit string
 	if len(b.exitHandlers) > 0 {
 		exitHandler := v1alpha1.Template{
@@ -541,12 +717,14 @@ func (b *WorkflowBuilder) BuildWithEntrypoint(entrypointName string) (*v1alpha1.
 			Entrypoint:            entrypointName,
 			ServiceAccountName:    b.serviceAccount,
 			Templates:             templates,
+			Arguments:             v1alpha1.Arguments{Parameters: b.parameters},
 			Volumes:               b.volumes,
 			Metrics:               b.metrics,
 			ArchiveLogs:           b.archiveLogs,
 			PodGC:                 b.podGC,
 			TTLStrategy:           b.ttl,
 			ActiveDeadlineSeconds: b.activeDeadlineSeconds,
+			RetryStrategy:         b.workflowRetry,
 			OnExit:                onExit,
 		},
 	}
@@ -582,6 +760,48 @@ func (b *WorkflowBuilder) BuildWithEntrypoint(entrypointName string) (*v1alpha1.
 	return wf, nil
 }
 
+// BuildCronWorkflow builds the workflow and wraps it in a CronWorkflow that runs
+// on the given cron schedule (standard five-field syntax or a macro such as
+// "@hourly"). The CronWorkflow is named after the builder name; labels and
+// annotations are set on both the CronWorkflow and the workflows it creates.
+//
+// Example:
+//
+//	cwf, err := NewWorkflowBuilder("nightly-backup", "argo").
+//	    Add(backup).
+//	    BuildCronWorkflow("0 2 * * *")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	cwf.Spec.Timezone = "Asia/Jakarta"
+func (b *WorkflowBuilder) BuildCronWorkflow(schedule string) (*v1alpha1.CronWorkflow, error) {
+	if strings.TrimSpace(schedule) == "" {
+		return nil, fmt.Errorf("cron schedule is required")
+	}
+
+	wf, err := b.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1alpha1.CronWorkflow{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        strings.TrimSuffix(b.namePrefix, "-"),
+			Namespace:   b.namespace,
+			Labels:      wf.Labels,
+			Annotations: wf.Annotations,
+		},
+		Spec: v1alpha1.CronWorkflowSpec{
+			Schedule:     schedule,
+			WorkflowSpec: wf.Spec,
+			WorkflowMetadata: &metav1.ObjectMeta{
+				Labels:      wf.Labels,
+				Annotations: wf.Annotations,
+			},
+		},
+	}, nil
+}
+
 // AddTemplate adds a template directly to the workflow builder.
 // This is useful for advanced use cases where you need to manually construct templates.
 // Templates are automatically deduplicated by name.
@@ -599,9 +819,21 @@ func (b *WorkflowBuilder) AddTemplate(template v1alpha1.Template) *WorkflowBuild
 }
 
 // insertTemplate adds a template to the workflow, deduplicating by name.
+// A template whose name is already taken by a different spec is recorded as a
+// conflict and reported by Build.
 func (b *WorkflowBuilder) insertTemplate(t v1alpha1.Template) {
 	if _, exists := b.uniqueTemplates[t.Name]; !exists {
 		b.templates = append(b.templates, t)
 		b.uniqueTemplates[t.Name] = struct{}{}
+		return
+	}
+
+	for _, existing := range b.templates {
+		if existing.Name == t.Name {
+			if !equality.Semantic.DeepEqual(existing, t) && !slices.Contains(b.templateConflicts, t.Name) {
+				b.templateConflicts = append(b.templateConflicts, t.Name)
+			}
+			return
+		}
 	}
 }
@@ -19,10 +19,17 @@ func TestWorkflowBuilder_Build(t *testing.T) {
 		validateWorkflow func(t *testing.T, wf *v1alpha1.Workflow)
 	}{
 		{
-			name: "empty workflow should have main entrypoint",
+			name: "empty workflow is rejected",
 			setupBuilder: func() *WorkflowBuilder {
 				return NewWorkflowBuilder("test", "argo")
 			},
+			wantErr: true,
+		},
+		{
+			name: "workflow should have main entrypoint",
+			setupBuilder: func() *WorkflowBuilder {
+				return NewWorkflowBuilder("test", "argo").Add(template.NewNoop())
+			},
 			wantErr: false,
 			validateWorkflow: func(t *testing.T, wf *v1alpha1.Workflow) {
 				assert.Equal(t, "test-", wf.GenerateName)
@@ -512,6 +519,87 @@ func TestWorkflowBuilder_BuildWithEntrypoint_Reentrant(t *testing.T) {
 	assert.Equal(t, 1, exitCount, "there should be exactly one 'exit-handler' template")
 }
 
+func TestWorkflowBuilder_ParameterPassing(t *testing.T) {
+	produce := template.NewContainer("produce", "alpine:latest",
+		template.WithCommand("sh", "-c", "echo -n {{workflow.parameters.greeting}} > /tmp/msg.txt"),
+		template.WithOutputParameter("msg", "/tmp/msg.txt"))
+
+	consume := template.NewContainer("consume", "alpine:latest",
+		template.WithCommand("echo", "{{inputs.parameters.msg}}"),
+		template.WithInputParameter("msg", "{{steps.produce.outputs.parameters.msg}}"))
+
+	wf, err := NewWorkflowBuilder("params", "argo",
+		WithParameter("greeting", "hello")).
+		Add(produce).
+		Add(consume).
+		Build()
+	require.NoError(t, err)
+
+	// Workflow-level arguments
+	require.Len(t, wf.Spec.Arguments.Parameters, 1)
+	assert.Equal(t, "greeting", wf.Spec.Arguments.Parameters[0].Name)
+	assert.Equal(t, "hello", wf.Spec.Arguments.Parameters[0].Value.String())
+
+	templates := make(map[string]v1alpha1.Template)
+	for _, tmpl := range wf.Spec.Templates {
+		templates[tmpl.Name] = tmpl
+	}
+
+	// Step one emits the parameter
+	producer := templates["produce-template"]
+	require.Len(t, producer.Outputs.Parameters, 1)
+	assert.Equal(t, "msg", producer.Outputs.Parameters[0].Name)
+	require.NotNil(t, producer.Outputs.Parameters[0].ValueFrom)
+	assert.Equal(t, "/tmp/msg.txt", producer.Outputs.Parameters[0].ValueFrom.Path)
+
+	// Step two declares the input
+	consumer := templates["consume-template"]
+	require.Len(t, consumer.Inputs.Parameters, 1)
+	assert.Equal(t, "msg", consumer.Inputs.Parameters[0].Name)
+
+	// ... and the main template binds it to step one's output
+	main := templates["main"]
+	require.Len(t, main.Steps, 2)
+	consumeStep := main.Steps[1].Steps[0]
+	assert.Equal(t, "consume", consumeStep.Name)
+	require.Len(t, consumeStep.Arguments.Parameters, 1)
+	assert.Equal(t, "msg", consumeStep.Arguments.Parameters[0].Name)
+	assert.Equal(t, "{{steps.produce.outputs.parameters.msg}}", consumeStep.Arguments.Parameters[0].Value.String())
+}
+
+func TestWorkflowBuilder_ResourceStep(t *testing.T) {
+	manifest := `apiVersion: v1
+kind: ConfigMap
+metadata:
+  generateName: app-config-
+data:
+  env: production
+`
+
+	wf, err := NewWorkflowBuilder("resource", "argo").
+		Add(template.NewResource("create-config", template.ResourceActionCreate, manifest)).
+		Build()
+	require.NoError(t, err)
+
+	var resourceTemplate *v1alpha1.Template
+	for i := range wf.Spec.Templates {
+		if wf.Spec.Templates[i].Name == "create-config-template" {
+			resourceTemplate = &wf.Spec.Templates[i]
+		}
+	}
+	require.NotNil(t, resourceTemplate)
+	require.NotNil(t, resourceTemplate.Resource)
+	assert.Equal(t, "create", resourceTemplate.Resource.Action)
+	assert.Equal(t, manifest, resourceTemplate.Resource.Manifest)
+
+	t.Run("invalid action fails build", func(t *testing.T) {
+		_, err := NewWorkflowBuilder("resource", "argo").
+			Add(template.NewResource("bad", "replace", manifest)).
+			Build()
+		require.Error(t, err)
+	})
+}
+
 // mockParallelSource implements WorkflowSourceV2 for testing
 type mockParallelSource struct {
 	parallelSteps    []v1alpha1.ParallelSteps
@@ -527,3 +615,167 @@ func (m *mockParallelSource) ParallelSteps() ([]v1alpha1.ParallelSteps, error) {
 func (m *mockParallelSource) Templates() ([]v1alpha1.Template, error) {
 	return m.templates, m.templatesErr
 }
+
+func TestWorkflowBuilder_AddWithDeps(t *testing.T) {
+	newStep := func(name string) *template.Container {
+		return template.NewContainer(name, "alpine:latest", template.WithCommand("echo", name))
+	}
+
+	t.Run("builds diamond DAG", func(t *testing.T) {
+		wf, err := NewWorkflowBuilder("diamond", "argo").
+			AddWithDeps(newStep("a")).
+			AddWithDeps(newStep("b"), "a").
+			AddWithDeps(newStep("c"), "a").
+			AddWithDeps(newStep("d"), "b", "c").
+			Build()
+		require.NoError(t, err)
+		require.NotNil(t, wf)
+
+		var mainTemplate *v1alpha1.Template
+		for i := range wf.Spec.Templates {
+			if wf.Spec.Templates[i].Name == "main" {
+				mainTemplate = &wf.Spec.Templates[i]
+				break
+			}
+		}
+		require.NotNil(t, mainTemplate)
+		assert.Empty(t, mainTemplate.Steps)
+		require.NotNil(t, mainTemplate.DAG)
+
+		deps := make(map[string][]string)
+		templates := make(map[string]string)
+		for _, task := range mainTemplate.DAG.Tasks {
+			deps[task.Name] = task.Dependencies
+			templates[task.Name] = task.Template
+		}
+
+		assert.Len(t, mainTemplate.DAG.Tasks, 4)
+		assert.Empty(t, deps["a"])
+		assert.Equal(t, []string{"a"}, deps["b"])
+		assert.Equal(t, []string{"a"}, deps["c"])
+		assert.Equal(t, []string{"b", "c"}, deps["d"])
+		assert.Equal(t, "d-template", templates["d"])
+
+		// main + one template per task
+		assert.Len(t, wf.Spec.Templates, 5)
+	})
+
+	t.Run("chains multiple steps from one source", func(t *testing.T) {
+		source := &mockWorkflowSource{
+			steps: []v1alpha1.WorkflowStep{
+				{Name: "first", Template: "tmpl"},
+				{Name: "second", Template: "tmpl"},
+			},
+			templates: []v1alpha1.Template{
+				{Name: "tmpl", Container: &corev1.Container{Image: "alpine"}},
+			},
+		}
+
+		wf, err := NewWorkflowBuilder("chain", "argo").
+			AddWithDeps(newStep("setup")).
+			AddWithDeps(source, "setup").
+			Build()
+		require.NoError(t, err)
+
+		var tasks []v1alpha1.DAGTask
+		for _, tmpl := range wf.Spec.Templates {
+			if tmpl.Name == "main" {
+				require.NotNil(t, tmpl.DAG)
+				tasks = tmpl.DAG.Tasks
+			}
+		}
+		require.Len(t, tasks, 3)
+		assert.Equal(t, []string{"setup"}, tasks[1].Dependencies)
+		assert.Equal(t, []string{"first"}, tasks[2].Dependencies)
+	})
+
+	t.Run("unknown dependency", func(t *testing.T) {
+		wf, err := NewWorkflowBuilder("test", "argo").
+			AddWithDeps(newStep("a")).
+			AddWithDeps(newStep("b"), "missing").
+			Build()
+		require.Error(t, err)
+		assert.Nil(t, wf)
+		assert.Contains(t, err.Error(), `dependency "missing"`)
+	})
+
+	t.Run("dependency must be added first", func(t *testing.T) {
+		_, err := NewWorkflowBuilder("test", "argo").
+			AddWithDeps(newStep("b"), "a").
+			AddWithDeps(newStep("a")).
+			Build()
+		require.Error(t, err)
+	})
+
+	t.Run("duplicate task name", func(t *testing.T) {
+		_, err := NewWorkflowBuilder("test", "argo").
+			AddWithDeps(newStep("a")).
+			AddWithDeps(newStep("a")).
+			Build()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "duplicate DAG task name")
+	})
+
+	t.Run("cannot mix with sequential steps", func(t *testing.T) {
+		_, err := NewWorkflowBuilder("test", "argo").
+			Add(newStep("a")).
+			AddWithDeps(newStep("b")).
+			Build()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot combine")
+	})
+}
+
+// mockWorkflowSource implements WorkflowSource for testing
+type mockWorkflowSource struct {
+	steps     []v1alpha1.WorkflowStep
+	templates []v1alpha1.Template
+}
+
+func (m *mockWorkflowSource) Steps() ([]v1alpha1.WorkflowStep, error) {
+	return m.steps, nil
+}
+
+func (m *mockWorkflowSource) Templates() ([]v1alpha1.Template, error) {
+	return m.templates, nil
+}
+
+func TestWorkflowBuilder_BuildCronWorkflow(t *testing.T) {
+	backup := template.NewContainer("backup", "alpine:latest",
+		template.WithCommand("sh", "-c", "echo backup"))
+
+	t.Run("wraps built workflow in a schedule", func(t *testing.T) {
+		cwf, err := NewWorkflowBuilder("nightly-backup", "argo",
+			WithLabels(map[string]string{"team": "data"})).
+			Add(backup).
+			BuildCronWorkflow("0 2 * * *")
+		require.NoError(t, err)
+
+		assert.Equal(t, "nightly-backup", cwf.Name)
+		assert.Equal(t, "argo", cwf.Namespace)
+		assert.Equal(t, "data", cwf.Labels["team"])
+		assert.Equal(t, "0 2 * * *", cwf.Spec.Schedule)
+		assert.False(t, cwf.Spec.Suspend)
+		assert.Equal(t, "main", cwf.Spec.WorkflowSpec.Entrypoint)
+		assert.Equal(t, "argo-workflow", cwf.Spec.WorkflowSpec.ServiceAccountName)
+		require.NotNil(t, cwf.Spec.WorkflowMetadata)
+		assert.Equal(t, "data", cwf.Spec.WorkflowMetadata.Labels["team"])
+	})
+
+	t.Run("empty schedule is rejected", func(t *testing.T) {
+		cwf, err := NewWorkflowBuilder("nightly-backup", "argo").
+			Add(backup).
+			BuildCronWorkflow("  ")
+		require.Error(t, err)
+		assert.Nil(t, cwf)
+		assert.Contains(t, err.Error(), "cron schedule is required")
+	})
+
+	t.Run("build errors are returned", func(t *testing.T) {
+		cwf, err := NewWorkflowBuilder("nightly-backup", "argo").
+			AddWithDeps(backup, "missing").
+			BuildCronWorkflow("@hourly")
+		require.Error(t, err)
+		assert.Nil(t, cwf)
+	})
+}
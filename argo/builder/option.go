@@ -61,6 +61,24 @@ func WithRetryStrategy(retry *v1alpha1.RetryStrategy) Option {
 	}
 }
 
+// WithWorkflowRetry sets spec.retryStrategy, the workflow-wide retry policy that
+// Argo applies to every template without its own retryStrategy. Unlike
+// WithRetryStrategy, which copies the strategy into each generated template, the
+// policy stays on the workflow spec and also covers templates added by Argo at runtime.
+//
+// Example:
+//
+//	builder := NewWorkflowBuilder("my-workflow", "argo",
+//	    WithWorkflowRetry(&v1alpha1.RetryStrategy{
+//	        Limit:       intstr.FromInt(2),
+//	        RetryPolicy: v1alpha1.RetryPolicyOnError,
+//	    }))
+func WithWorkflowRetry(retry *v1alpha1.RetryStrategy) Option {
+	return func(b *WorkflowBuilder) {
+		b.workflowRetry = retry
+	}
+}
+
 // WithVolume adds a volume to the workflow.
 // Volumes can be mounted in workflow steps for persistent storage or configuration.
 //
@@ -84,6 +102,23 @@ func WithVolume(volume corev1.Volume) Option {
 	}
 }
 
+// WithParameter declares a workflow-level parameter with a default value.
+// Steps can reference it as {{workflow.parameters.<name>}}, and the value can be
+// overridden when the workflow is submitted.
+//
+// Example:
+//
+//	builder := NewWorkflowBuilder("my-workflow", "argo",
+//	    WithParameter("environment", "staging"))
+func WithParameter(name, defaultValue string) Option {
+	return func(b *WorkflowBuilder) {
+		b.parameters = append(b.parameters, v1alpha1.Parameter{
+			Name:  name,
+			Value: v1alpha1.AnyStringPtr(defaultValue),
+		})
+	}
+}
+
 // WithArchiveLogs enables or disables log archiving for the workflow.
 // When enabled, workflow logs are persisted after the workflow completes.
 //
@@ -129,6 +164,32 @@ func WithTTL(ttl *v1alpha1.TTLStrategy) Option {
 	}
 }
 
+// WithTTLStrategy sets how long the workflow is kept after it finishes, in seconds,
+// so completed workflows are garbage collected. A zero value leaves that field unset.
+//
+// Example:
+//
+//	// Keep successful workflows for 1 hour and failed ones for 1 day
+//	builder := NewWorkflowBuilder("my-workflow", "argo",
+//	    WithTTLStrategy(0, 3600, 86400))
+func WithTTLStrategy(secondsAfterCompletion, secondsAfterSuccess, secondsAfterFailure int32) Option {
+	return func(b *WorkflowBuilder) {
+		b.ttl = &v1alpha1.TTLStrategy{
+			SecondsAfterCompletion: nonZeroInt32(secondsAfterCompletion),
+			SecondsAfterSuccess:    nonZeroInt32(secondsAfterSuccess),
+			SecondsAfterFailure:    nonZeroInt32(secondsAfterFailure),
+		}
+	}
+}
+
+// nonZeroInt32 returns a pointer to v, or nil when v is zero.
+func nonZeroInt32(v int32) *int32 {
+	if v == 0 {
+		return nil
+	}
+	return &v
+}
+
 // WithActiveDeadlineSeconds sets the maximum duration for the workflow.
 // If the workflow runs longer than this, it will be terminated.
 //
@@ -9,6 +9,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 
+	"github.com/jasoet/pkg/v2/argo/builder/template"
 	"github.com/jasoet/pkg/v2/otel"
 )
 
@@ -25,11 +26,25 @@ func TestWithServiceAccount(t *testing.T) {
 	sa := "custom-service-account"
 	wb := NewWorkflowBuilder("test", "argo", WithServiceAccount(sa))
 
-	wf, err := wb.Build()
+	wf, err := wb.Add(template.NewNoop()).Build()
 	require.NoError(t, err)
 	assert.Equal(t, sa, wf.Spec.ServiceAccountName)
 }
 
+func TestWithParameter(t *testing.T) {
+	wb := NewWorkflowBuilder("test", "argo",
+		WithParameter("environment", "staging"),
+		WithParameter("replicas", "2"))
+
+	wf, err := wb.Add(template.NewNoop()).Build()
+	require.NoError(t, err)
+	require.Len(t, wf.Spec.Arguments.Parameters, 2)
+	assert.Equal(t, "environment", wf.Spec.Arguments.Parameters[0].Name)
+	assert.Equal(t, "staging", wf.Spec.Arguments.Parameters[0].Value.String())
+	assert.Equal(t, "replicas", wf.Spec.Arguments.Parameters[1].Name)
+	assert.Equal(t, "2", wf.Spec.Arguments.Parameters[1].Value.String())
+}
+
 func TestWithLabels(t *testing.T) {
 	labels := map[string]string{
 		"app":  "myapp",
@@ -39,7 +54,7 @@ func TestWithLabels(t *testing.T) {
 
 	wb := NewWorkflowBuilder("test", "argo", WithLabels(labels))
 
-	wf, err := wb.Build()
+	wf, err := wb.Add(template.NewNoop()).Build()
 	require.NoError(t, err)
 	assert.Equal(t, labels, wf.Labels)
 }
@@ -52,7 +67,7 @@ func TestWithAnnotations(t *testing.T) {
 
 	wb := NewWorkflowBuilder("test", "argo", WithAnnotations(annotations))
 
-	wf, err := wb.Build()
+	wf, err := wb.Add(template.NewNoop()).Build()
 	require.NoError(t, err)
 	assert.Equal(t, annotations, wf.Annotations)
 }
@@ -81,7 +96,7 @@ func TestWithVolume(t *testing.T) {
 
 	wb := NewWorkflowBuilder("test", "argo", WithVolume(volume))
 
-	wf, err := wb.Build()
+	wf, err := wb.Add(template.NewNoop()).Build()
 	require.NoError(t, err)
 	require.Len(t, wf.Spec.Volumes, 1)
 	assert.Equal(t, "data", wf.Spec.Volumes[0].Name)
@@ -91,7 +106,7 @@ func TestWithArchiveLogs(t *testing.T) {
 	t.Run("enable archive logs", func(t *testing.T) {
 		wb := NewWorkflowBuilder("test", "argo", WithArchiveLogs(true))
 
-		wf, err := wb.Build()
+		wf, err := wb.Add(template.NewNoop()).Build()
 		require.NoError(t, err)
 		require.NotNil(t, wf.Spec.ArchiveLogs)
 		assert.True(t, *wf.Spec.ArchiveLogs)
@@ -100,7 +115,7 @@ func TestWithArchiveLogs(t *testing.T) {
 	t.Run("disable archive logs", func(t *testing.T) {
 		wb := NewWorkflowBuilder("test", "argo", WithArchiveLogs(false))
 
-		wf, err := wb.Build()
+		wf, err := wb.Add(template.NewNoop()).Build()
 		require.NoError(t, err)
 		require.NotNil(t, wf.Spec.ArchiveLogs)
 		assert.False(t, *wf.Spec.ArchiveLogs)
@@ -114,7 +129,7 @@ func TestWithPodGC(t *testing.T) {
 
 	wb := NewWorkflowBuilder("test", "argo", WithPodGC(podGC))
 
-	wf, err := wb.Build()
+	wf, err := wb.Add(template.NewNoop()).Build()
 	require.NoError(t, err)
 	require.NotNil(t, wf.Spec.PodGC)
 	assert.Equal(t, v1alpha1.PodGCOnPodSuccess, wf.Spec.PodGC.Strategy)
@@ -128,7 +143,7 @@ func TestWithTTL(t *testing.T) {
 
 	wb := NewWorkflowBuilder("test", "argo", WithTTL(ttl))
 
-	wf, err := wb.Build()
+	wf, err := wb.Add(template.NewNoop()).Build()
 	require.NoError(t, err)
 	require.NotNil(t, wf.Spec.TTLStrategy)
 	require.NotNil(t, wf.Spec.TTLStrategy.SecondsAfterCompletion)
@@ -140,12 +155,57 @@ func TestWithActiveDeadlineSeconds(t *testing.T) {
 
 	wb := NewWorkflowBuilder("test", "argo", WithActiveDeadlineSeconds(deadline))
 
-	wf, err := wb.Build()
+	wf, err := wb.Add(template.NewNoop()).Build()
 	require.NoError(t, err)
 	require.NotNil(t, wf.Spec.ActiveDeadlineSeconds)
 	assert.Equal(t, deadline, *wf.Spec.ActiveDeadlineSeconds)
 }
 
+func TestWithTTLStrategy(t *testing.T) {
+	wb := NewWorkflowBuilder("test", "argo", WithTTLStrategy(600, 3600, 86400))
+
+	wf, err := wb.Add(template.NewNoop()).Build()
+	require.NoError(t, err)
+	require.NotNil(t, wf.Spec.TTLStrategy)
+	require.NotNil(t, wf.Spec.TTLStrategy.SecondsAfterCompletion)
+	require.NotNil(t, wf.Spec.TTLStrategy.SecondsAfterSuccess)
+	require.NotNil(t, wf.Spec.TTLStrategy.SecondsAfterFailure)
+	assert.Equal(t, int32(600), *wf.Spec.TTLStrategy.SecondsAfterCompletion)
+	assert.Equal(t, int32(3600), *wf.Spec.TTLStrategy.SecondsAfterSuccess)
+	assert.Equal(t, int32(86400), *wf.Spec.TTLStrategy.SecondsAfterFailure)
+
+	t.Run("zero leaves field unset", func(t *testing.T) {
+		wf, err := NewWorkflowBuilder("test", "argo", WithTTLStrategy(0, 3600, 0)).Add(template.NewNoop()).Build()
+		require.NoError(t, err)
+		require.NotNil(t, wf.Spec.TTLStrategy)
+		assert.Nil(t, wf.Spec.TTLStrategy.SecondsAfterCompletion)
+		assert.Equal(t, int32(3600), *wf.Spec.TTLStrategy.SecondsAfterSuccess)
+		assert.Nil(t, wf.Spec.TTLStrategy.SecondsAfterFailure)
+	})
+}
+
+func TestWithWorkflowRetry(t *testing.T) {
+	limit := intstr.FromInt(2)
+	retry := &v1alpha1.RetryStrategy{
+		Limit:       &limit,
+		RetryPolicy: v1alpha1.RetryPolicyOnError,
+	}
+
+	wb := NewWorkflowBuilder("test", "argo", WithWorkflowRetry(retry))
+	wb.AddTemplate(v1alpha1.Template{Name: "main", Container: &corev1.Container{Image: "alpine"}})
+
+	wf, err := wb.BuildWithEntrypoint("main")
+	require.NoError(t, err)
+	require.NotNil(t, wf.Spec.RetryStrategy)
+	assert.Equal(t, v1alpha1.RetryPolicyOnError, wf.Spec.RetryStrategy.RetryPolicy)
+	assert.Equal(t, 2, wf.Spec.RetryStrategy.Limit.IntValue())
+
+	// The workflow-level policy is not copied into templates
+	for _, tmpl := range wf.Spec.Templates {
+		assert.Nil(t, tmpl.RetryStrategy, "template %s", tmpl.Name)
+	}
+}
+
 func TestWithMetrics(t *testing.T) {
 	// Mock metrics provider
 	provider := &mockMetricsProvider{
@@ -161,7 +221,7 @@ func TestWithMetrics(t *testing.T) {
 
 	wb := NewWorkflowBuilder("test", "argo").WithMetrics(provider)
 
-	wf, err := wb.Build()
+	wf, err := wb.Add(template.NewNoop()).Build()
 	require.NoError(t, err)
 	require.NotNil(t, wf.Spec.Metrics)
 	require.Len(t, wf.Spec.Metrics.Prometheus, 1)
@@ -196,7 +256,7 @@ func TestMultipleOptions(t *testing.T) {
 		WithArchiveLogs(true),
 	)
 
-	wf, err := wb.Build()
+	wf, err := wb.Add(template.NewNoop()).Build()
 	require.NoError(t, err)
 
 	// Verify all options applied
@@ -218,7 +278,7 @@ func TestWithOTelConfig_InitializesInstrumentation(t *testing.T) {
 	assert.NotNil(t, wb.otelConfig)
 
 	// Build should work with OTel enabled
-	wf, err := wb.Build()
+	wf, err := wb.Add(template.NewNoop()).Build()
 	require.NoError(t, err)
 	assert.NotNil(t, wf)
 }
@@ -258,7 +318,7 @@ func TestOptionsAppliedToWorkflow(t *testing.T) {
 		WithActiveDeadlineSeconds(deadline),
 	)
 
-	wf, err := wb.Build()
+	wf, err := wb.Add(template.NewNoop()).Build()
 	require.NoError(t, err)
 
 	// Verify all settings
@@ -281,7 +341,7 @@ func TestDefaultServiceAccount(t *testing.T) {
 	// Without specifying service account, should use default
 	wb := NewWorkflowBuilder("test", "argo")
 
-	wf, err := wb.Build()
+	wf, err := wb.Add(template.NewNoop()).Build()
 	require.NoError(t, err)
 	assert.Equal(t, "argo-workflow", wf.Spec.ServiceAccountName, "should use default service account")
 }
@@ -289,7 +349,7 @@ func TestDefaultServiceAccount(t *testing.T) {
 func TestEmptyLabelsAndAnnotations(t *testing.T) {
 	wb := NewWorkflowBuilder("test", "argo")
 
-	wf, err := wb.Build()
+	wf, err := wb.Add(template.NewNoop()).Build()
 	require.NoError(t, err)
 	assert.Empty(t, wf.Labels)
 	assert.Empty(t, wf.Annotations)
@@ -315,7 +375,7 @@ func TestMultipleVolumes(t *testing.T) {
 		WithVolume(volume2),
 	)
 
-	wf, err := wb.Build()
+	wf, err := wb.Add(template.NewNoop()).Build()
 	require.NoError(t, err)
 	require.Len(t, wf.Spec.Volumes, 2)
 	assert.Equal(t, "data", wf.Spec.Volumes[0].Name)
@@ -40,6 +40,8 @@ type Container struct {
 	when            string
 	continueOn      *v1alpha1.ContinueOn
 	retryStrategy   *v1alpha1.RetryStrategy
+	sidecars        []v1alpha1.UserContainer
+	io              stepIO
 	otelConfig      *otel.Config
 }
 
@@ -217,6 +219,19 @@ func (c *Container) ContinueOn(continueOn *v1alpha1.ContinueOn) *Container {
 	return c
 }
 
+// Sidecar adds a container that runs alongside the main container for the
+// duration of the step, such as a database or proxy.
+//
+// Example:
+//
+//	container.Sidecar("postgres", "postgres:16-alpine",
+//	    template.WithSidecarEnv("POSTGRES_PASSWORD", "test"),
+//	    template.WithSidecarPort(5432))
+func (c *Container) Sidecar(name, image string, opts ...SidecarOption) *Container {
+	c.sidecars = append(c.sidecars, newSidecar(name, image, opts...))
+	return c
+}
+
 // WithRetry sets a retry strategy for this specific step.
 //
 // Example:
@@ -230,6 +245,50 @@ func (c *Container) WithRetry(retry *v1alpha1.RetryStrategy) *Container {
 	return c
 }
 
+// InputParameter declares an input parameter and binds value to it.
+// The value can reference outputs of earlier steps; inside the container use
+// {{inputs.parameters.<name>}}.
+//
+// Example:
+//
+//	container.InputParameter("version", "{{steps.build.outputs.parameters.version}}").
+//	    Args("--version", "{{inputs.parameters.version}}")
+func (c *Container) InputParameter(name, value string) *Container {
+	c.io.addInputParameter(name, value)
+	return c
+}
+
+// OutputParameter declares an output parameter whose value is read from the
+// file at path once the container exits.
+//
+// Example:
+//
+//	container.OutputParameter("version", "/tmp/version.txt")
+func (c *Container) OutputParameter(name, path string) *Container {
+	c.io.addOutputParameter(name, path)
+	return c
+}
+
+// InputArtifact places the artifact referenced by from at path inside the container.
+//
+// Example:
+//
+//	container.InputArtifact("binary", "/tmp/app", "{{steps.build.outputs.artifacts.binary}}")
+func (c *Container) InputArtifact(name, path, from string) *Container {
+	c.io.addInputArtifact(name, path, from)
+	return c
+}
+
+// OutputArtifact declares an output artifact collected from path.
+//
+// Example:
+//
+//	container.OutputArtifact("binary", "/workspace/bin/app")
+func (c *Container) OutputArtifact(name, path string) *Container {
+	c.io.addOutputArtifact(name, path)
+	return c
+}
+
 // Steps implements WorkflowSource interface.
 func (c *Container) Steps() ([]v1alpha1.WorkflowStep, error) {
 	ctx := context.Background()
@@ -255,6 +314,9 @@ func (c *Container) Steps() ([]v1alpha1.WorkflowStep, error) {
 		step.ContinueOn = c.continueOn
 	}
 
+	// Bind input parameters and artifacts
+	c.io.applyStep(&step)
+
 	return []v1alpha1.WorkflowStep{step}, nil
 }
 
@@ -326,6 +388,13 @@ func (c *Container) Templates() ([]v1alpha1.Template, error) {
 		template.RetryStrategy = c.retryStrategy
 	}
 
+	if len(c.sidecars) > 0 {
+		template.Sidecars = c.sidecars
+	}
+
+	// Declare inputs and outputs
+	c.io.applyTemplate(&template)
+
 	return []v1alpha1.Template{template}, nil
 }
 
@@ -407,3 +476,38 @@ func WithWhen(condition string) ContainerOption {
 		c.when = condition
 	}
 }
+
+// WithInputParameter declares an input parameter and binds value to it.
+func WithInputParameter(name, value string) ContainerOption {
+	return func(c *Container) {
+		c.io.addInputParameter(name, value)
+	}
+}
+
+// WithOutputParameter declares an output parameter read from the file at path.
+func WithOutputParameter(name, path string) ContainerOption {
+	return func(c *Container) {
+		c.io.addOutputParameter(name, path)
+	}
+}
+
+// WithInputArtifact places the artifact referenced by from at path.
+func WithInputArtifact(name, path, from string) ContainerOption {
+	return func(c *Container) {
+		c.io.addInputArtifact(name, path, from)
+	}
+}
+
+// WithOutputArtifact declares an output artifact collected from path.
+func WithOutputArtifact(name, path string) ContainerOption {
+	return func(c *Container) {
+		c.io.addOutputArtifact(name, path)
+	}
+}
+
+// WithSidecar adds a sidecar container that runs alongside the main container.
+func WithSidecar(name, image string, opts ...SidecarOption) ContainerOption {
+	return func(c *Container) {
+		c.sidecars = append(c.sidecars, newSidecar(name, image, opts...))
+	}
+}
@@ -0,0 +1,66 @@
+package template
+
+import (
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+)
+
+// stepIO holds the parameters and artifacts a step consumes and produces.
+// Inputs are declared on the template and bound on the step's arguments, so a
+// value such as "{{steps.generate.outputs.parameters.result}}" can be passed in
+// from an earlier step.
+type stepIO struct {
+	inputParameters  []v1alpha1.Parameter
+	inputArtifacts   []v1alpha1.Artifact
+	outputParameters []v1alpha1.Parameter
+	outputArtifacts  []v1alpha1.Artifact
+	argParameters    []v1alpha1.Parameter
+	argArtifacts     []v1alpha1.Artifact
+}
+
+// addInputParameter declares an input parameter and binds value to it.
+func (io *stepIO) addInputParameter(name, value string) {
+	io.inputParameters = append(io.inputParameters, v1alpha1.Parameter{Name: name})
+	io.argParameters = append(io.argParameters, v1alpha1.Parameter{
+		Name:  name,
+		Value: v1alpha1.AnyStringPtr(value),
+	})
+}
+
+// addInputArtifact declares an input artifact placed at path and binds from to it.
+func (io *stepIO) addInputArtifact(name, path, from string) {
+	io.inputArtifacts = append(io.inputArtifacts, v1alpha1.Artifact{Name: name, Path: path})
+	io.argArtifacts = append(io.argArtifacts, v1alpha1.Artifact{Name: name, From: from})
+}
+
+// addOutputParameter declares an output parameter read from the file at path.
+func (io *stepIO) addOutputParameter(name, path string) {
+	io.outputParameters = append(io.outputParameters, v1alpha1.Parameter{
+		Name:      name,
+		ValueFrom: &v1alpha1.ValueFrom{Path: path},
+	})
+}
+
+// addOutputArtifact declares an output artifact collected from path.
+func (io *stepIO) addOutputArtifact(name, path string) {
+	io.outputArtifacts = append(io.outputArtifacts, v1alpha1.Artifact{Name: name, Path: path})
+}
+
+// applyStep sets the step arguments that bind the declared inputs.
+func (io *stepIO) applyStep(step *v1alpha1.WorkflowStep) {
+	step.Arguments = v1alpha1.Arguments{
+		Parameters: io.argParameters,
+		Artifacts:  io.argArtifacts,
+	}
+}
+
+// applyTemplate sets the template inputs and outputs.
+func (io *stepIO) applyTemplate(template *v1alpha1.Template) {
+	template.Inputs = v1alpha1.Inputs{
+		Parameters: io.inputParameters,
+		Artifacts:  io.inputArtifacts,
+	}
+	template.Outputs = v1alpha1.Outputs{
+		Parameters: io.outputParameters,
+		Artifacts:  io.outputArtifacts,
+	}
+}
@@ -0,0 +1,253 @@
+package template
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+
+	"github.com/jasoet/pkg/v2/otel"
+)
+
+// Resource actions supported by NewResource.
+const (
+	ResourceActionCreate = "create"
+	ResourceActionApply  = "apply"
+	ResourceActionDelete = "delete"
+	ResourceActionPatch  = "patch"
+)
+
+// Resource is a WorkflowSource that creates, applies, patches or deletes a
+// Kubernetes object from within a workflow, like running kubectl against a manifest.
+//
+// Example:
+//
+//	cm := template.NewResource("create-config", template.ResourceActionCreate, `
+//	apiVersion: v1
+//	kind: ConfigMap
+//	metadata:
+//	  generateName: app-config-
+//	data:
+//	  env: production
+//	`)
+type Resource struct {
+	name              string
+	templateName      string
+	action            string
+	manifest          string
+	mergeStrategy     string
+	successCondition  string
+	failureCondition  string
+	setOwnerReference bool
+	when              string
+	continueOn        *v1alpha1.ContinueOn
+	otelConfig        *otel.Config
+}
+
+// NewResource creates a new resource workflow source.
+//
+// Parameters:
+//   - name: Step name
+//   - action: One of "create", "apply", "delete" or "patch"
+//   - manifest: Kubernetes manifest in YAML or JSON
+//   - opts: Optional configuration functions
+//
+// Example:
+//
+//	job := template.NewResource("submit-job", template.ResourceActionCreate, jobManifest,
+//	    template.WithResourceSuccessCondition("status.succeeded > 0"),
+//	    template.WithResourceFailureCondition("status.failed > 3"))
+func NewResource(name, action, manifest string, opts ...ResourceOption) *Resource {
+	r := &Resource{
+		name:         name,
+		templateName: name + "-template",
+		action:       action,
+		manifest:     manifest,
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
+
+// SuccessCondition sets a label selector style expression that marks the step
+// successful once it matches the resource.
+//
+// Example:
+//
+//	resource.SuccessCondition("status.succeeded > 0")
+func (r *Resource) SuccessCondition(cond string) *Resource {
+	r.successCondition = cond
+	return r
+}
+
+// FailureCondition sets a label selector style expression that marks the step
+// failed once it matches the resource.
+//
+// Example:
+//
+//	resource.FailureCondition("status.failed > 3")
+func (r *Resource) FailureCondition(cond string) *Resource {
+	r.failureCondition = cond
+	return r
+}
+
+// MergeStrategy sets the patch strategy for the patch action
+// ("strategic", "merge" or "json").
+//
+// Example:
+//
+//	resource.MergeStrategy("merge")
+func (r *Resource) MergeStrategy(strategy string) *Resource {
+	r.mergeStrategy = strategy
+	return r
+}
+
+// SetOwnerReference makes the workflow the owner of the created resource,
+// so it is garbage collected when the workflow is deleted.
+//
+// Example:
+//
+//	resource.SetOwnerReference(true)
+func (r *Resource) SetOwnerReference(set bool) *Resource {
+	r.setOwnerReference = set
+	return r
+}
+
+// When sets a conditional expression.
+//
+// Example:
+//
+//	resource.When("{{workflow.status}} == Succeeded")
+func (r *Resource) When(condition string) *Resource {
+	r.when = condition
+	return r
+}
+
+// ContinueOn sets the step to continue on specific conditions.
+//
+// Example:
+//
+//	resource.ContinueOn(&v1alpha1.ContinueOn{
+//	    Failed: true,  // continue even if the resource action fails
+//	})
+func (r *Resource) ContinueOn(continueOn *v1alpha1.ContinueOn) *Resource {
+	r.continueOn = continueOn
+	return r
+}
+
+// validate checks the action and manifest.
+func (r *Resource) validate() error {
+	switch r.action {
+	case ResourceActionCreate, ResourceActionApply, ResourceActionDelete, ResourceActionPatch:
+	default:
+		return fmt.Errorf("unsupported resource action %q for step %s", r.action, r.name)
+	}
+
+	if r.manifest == "" {
+		return fmt.Errorf("resource manifest is required for step %s", r.name)
+	}
+
+	return nil
+}
+
+// Steps implements WorkflowSource interface.
+func (r *Resource) Steps() ([]v1alpha1.WorkflowStep, error) {
+	ctx := context.Background()
+
+	logger := otel.NewLogHelper(ctx, r.otelConfig,
+		"github.com/jasoet/pkg/v2/argo/builder/template", "Resource.Steps")
+	logger.Debug("Generating resource steps",
+		otel.F("name", r.name),
+		otel.F("action", r.action))
+
+	if err := r.validate(); err != nil {
+		logger.Error(err, "Invalid resource step")
+		return nil, err
+	}
+
+	step := v1alpha1.WorkflowStep{
+		Name:     r.name,
+		Template: r.templateName,
+	}
+
+	if r.when != "" {
+		step.When = r.when
+	}
+
+	if r.continueOn != nil {
+		step.ContinueOn = r.continueOn
+	}
+
+	return []v1alpha1.WorkflowStep{step}, nil
+}
+
+// Templates implements WorkflowSource interface.
+func (r *Resource) Templates() ([]v1alpha1.Template, error) {
+	ctx := context.Background()
+
+	logger := otel.NewLogHelper(ctx, r.otelConfig,
+		"github.com/jasoet/pkg/v2/argo/builder/template", "Resource.Templates")
+	logger.Debug("Generating resource template",
+		otel.F("name", r.templateName),
+		otel.F("action", r.action))
+
+	if err := r.validate(); err != nil {
+		logger.Error(err, "Invalid resource template")
+		return nil, err
+	}
+
+	template := v1alpha1.Template{
+		Name: r.templateName,
+		Resource: &v1alpha1.ResourceTemplate{
+			Action:            r.action,
+			Manifest:          r.manifest,
+			MergeStrategy:     r.mergeStrategy,
+			SuccessCondition:  r.successCondition,
+			FailureCondition:  r.failureCondition,
+			SetOwnerReference: r.setOwnerReference,
+		},
+	}
+
+	return []v1alpha1.Template{template}, nil
+}
+
+// ResourceOption is a functional option for configuring Resource.
+type ResourceOption func(*Resource)
+
+// WithResourceSuccessCondition sets the success condition.
+func WithResourceSuccessCondition(cond string) ResourceOption {
+	return func(r *Resource) {
+		r.successCondition = cond
+	}
+}
+
+// WithResourceFailureCondition sets the failure condition.
+func WithResourceFailureCondition(cond string) ResourceOption {
+	return func(r *Resource) {
+		r.failureCondition = cond
+	}
+}
+
+// WithResourceMergeStrategy sets the patch merge strategy.
+func WithResourceMergeStrategy(strategy string) ResourceOption {
+	return func(r *Resource) {
+		r.mergeStrategy = strategy
+	}
+}
+
+// WithResourceOwnerReference sets whether the workflow owns the resource.
+func WithResourceOwnerReference(set bool) ResourceOption {
+	return func(r *Resource) {
+		r.setOwnerReference = set
+	}
+}
+
+// WithResourceOTelConfig enables OpenTelemetry instrumentation.
+func WithResourceOTelConfig(cfg *otel.Config) ResourceOption {
+	return func(r *Resource) {
+		r.otelConfig = cfg
+	}
+}
@@ -0,0 +1,101 @@
+package template
+
+import (
+	"testing"
+
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const configMapManifest = `apiVersion: v1
+kind: ConfigMap
+metadata:
+  generateName: app-config-
+data:
+  env: production
+`
+
+func TestNewResource(t *testing.T) {
+	res := NewResource("create-config", ResourceActionCreate, configMapManifest)
+
+	assert.Equal(t, "create-config", res.name)
+	assert.Equal(t, "create-config-template", res.templateName)
+	assert.Equal(t, "create", res.action)
+	assert.Equal(t, configMapManifest, res.manifest)
+}
+
+func TestResourceTemplates(t *testing.T) {
+	res := NewResource("submit-job", ResourceActionApply, configMapManifest,
+		WithResourceSuccessCondition("status.succeeded > 0"),
+		WithResourceFailureCondition("status.failed > 3"),
+		WithResourceOwnerReference(true))
+
+	steps, err := res.Steps()
+	require.NoError(t, err)
+	require.Len(t, steps, 1)
+	assert.Equal(t, "submit-job", steps[0].Name)
+	assert.Equal(t, "submit-job-template", steps[0].Template)
+
+	templates, err := res.Templates()
+	require.NoError(t, err)
+	require.Len(t, templates, 1)
+
+	tmpl := templates[0]
+	assert.Equal(t, "submit-job-template", tmpl.Name)
+	require.NotNil(t, tmpl.Resource)
+	assert.Equal(t, "apply", tmpl.Resource.Action)
+	assert.Equal(t, configMapManifest, tmpl.Resource.Manifest)
+	assert.Equal(t, "status.succeeded > 0", tmpl.Resource.SuccessCondition)
+	assert.Equal(t, "status.failed > 3", tmpl.Resource.FailureCondition)
+	assert.True(t, tmpl.Resource.SetOwnerReference)
+}
+
+func TestResourceFluent(t *testing.T) {
+	res := NewResource("patch-config", ResourceActionPatch, configMapManifest).
+		MergeStrategy("merge").
+		SuccessCondition("metadata.name").
+		FailureCondition("status.phase == Failed").
+		When("{{workflow.status}} == Succeeded").
+		ContinueOn(&v1alpha1.ContinueOn{Failed: true})
+
+	steps, err := res.Steps()
+	require.NoError(t, err)
+	assert.Equal(t, "{{workflow.status}} == Succeeded", steps[0].When)
+	require.NotNil(t, steps[0].ContinueOn)
+	assert.True(t, steps[0].ContinueOn.Failed)
+
+	templates, err := res.Templates()
+	require.NoError(t, err)
+	assert.Equal(t, "patch", templates[0].Resource.Action)
+	assert.Equal(t, "merge", templates[0].Resource.MergeStrategy)
+	assert.Equal(t, "metadata.name", templates[0].Resource.SuccessCondition)
+	assert.Equal(t, "status.phase == Failed", templates[0].Resource.FailureCondition)
+}
+
+func TestResourceValidation(t *testing.T) {
+	tests := []struct {
+		name     string
+		action   string
+		manifest string
+		errMsg   string
+	}{
+		{"unsupported action", "replace", configMapManifest, "unsupported resource action"},
+		{"empty action", "", configMapManifest, "unsupported resource action"},
+		{"missing manifest", ResourceActionDelete, "", "manifest is required"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := NewResource("invalid", tt.action, tt.manifest)
+
+			_, err := res.Steps()
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+
+			_, err = res.Templates()
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
@@ -36,6 +36,7 @@ type Script struct {
 	when          string
 	continueOn    *v1alpha1.ContinueOn
 	retryStrategy *v1alpha1.RetryStrategy
+	io            stepIO
 	otelConfig    *otel.Config
 }
 
@@ -216,6 +217,48 @@ func (s *Script) WithRetry(strategy *v1alpha1.RetryStrategy) *Script {
 	return s
 }
 
+// InputParameter declares an input parameter and binds value to it.
+// Inside the script use {{inputs.parameters.<name>}}.
+//
+// Example:
+//
+//	script.InputParameter("count", "{{steps.count.outputs.parameters.total}}")
+func (s *Script) InputParameter(name, value string) *Script {
+	s.io.addInputParameter(name, value)
+	return s
+}
+
+// OutputParameter declares an output parameter whose value is read from the
+// file at path once the script exits.
+//
+// Example:
+//
+//	script.OutputParameter("total", "/tmp/total.txt")
+func (s *Script) OutputParameter(name, path string) *Script {
+	s.io.addOutputParameter(name, path)
+	return s
+}
+
+// InputArtifact places the artifact referenced by from at path.
+//
+// Example:
+//
+//	script.InputArtifact("data", "/tmp/data.csv", "{{steps.fetch.outputs.artifacts.data}}")
+func (s *Script) InputArtifact(name, path, from string) *Script {
+	s.io.addInputArtifact(name, path, from)
+	return s
+}
+
+// OutputArtifact declares an output artifact collected from path.
+//
+// Example:
+//
+//	script.OutputArtifact("report", "/tmp/report.html")
+func (s *Script) OutputArtifact(name, path string) *Script {
+	s.io.addOutputArtifact(name, path)
+	return s
+}
+
 // Steps implements WorkflowSource interface.
 func (s *Script) Steps() ([]v1alpha1.WorkflowStep, error) {
 	ctx := context.Background()
@@ -239,6 +282,8 @@ func (s *Script) Steps() ([]v1alpha1.WorkflowStep, error) {
 		step.ContinueOn = s.continueOn
 	}
 
+	s.io.applyStep(&step)
+
 	return []v1alpha1.WorkflowStep{step}, nil
 }
 
@@ -289,6 +334,8 @@ func (s *Script) Templates() ([]v1alpha1.Template, error) {
 		template.RetryStrategy = s.retryStrategy
 	}
 
+	s.io.applyTemplate(&template)
+
 	return []v1alpha1.Template{template}, nil
 }
 
@@ -340,6 +387,34 @@ func WithScriptWorkingDir(dir string) ScriptOption {
 	}
 }
 
+// WithScriptInputParameter declares an input parameter and binds value to it.
+func WithScriptInputParameter(name, value string) ScriptOption {
+	return func(s *Script) {
+		s.io.addInputParameter(name, value)
+	}
+}
+
+// WithScriptOutputParameter declares an output parameter read from the file at path.
+func WithScriptOutputParameter(name, path string) ScriptOption {
+	return func(s *Script) {
+		s.io.addOutputParameter(name, path)
+	}
+}
+
+// WithScriptInputArtifact places the artifact referenced by from at path.
+func WithScriptInputArtifact(name, path, from string) ScriptOption {
+	return func(s *Script) {
+		s.io.addInputArtifact(name, path, from)
+	}
+}
+
+// WithScriptOutputArtifact declares an output artifact collected from path.
+func WithScriptOutputArtifact(name, path string) ScriptOption {
+	return func(s *Script) {
+		s.io.addOutputArtifact(name, path)
+	}
+}
+
 // buildResourceRequirements is a helper to build resource requirements.
 func buildResourceRequirements(cpuReq, cpuLim, memReq, memLim string) (corev1.ResourceRequirements, error) {
 	reqs := corev1.ResourceRequirements{
@@ -263,6 +263,30 @@ func TestScriptWithRetryStrategy(t *testing.T) {
 	assert.Equal(t, 3, tmpl.RetryStrategy.Limit.IntValue())
 }
 
+func TestScriptInputsOutputs(t *testing.T) {
+	script := NewScript("sum", "python",
+		WithScriptContent("print(1)"),
+		WithScriptInputParameter("count", "{{steps.count.outputs.parameters.total}}"),
+		WithScriptInputArtifact("data", "/tmp/data.csv", "{{steps.fetch.outputs.artifacts.data}}")).
+		OutputParameter("total", "/tmp/total.txt").
+		OutputArtifact("report", "/tmp/report.html")
+
+	steps, err := script.Steps()
+	require.NoError(t, err)
+	require.Len(t, steps[0].Arguments.Parameters, 1)
+	assert.Equal(t, "{{steps.count.outputs.parameters.total}}", steps[0].Arguments.Parameters[0].Value.String())
+	require.Len(t, steps[0].Arguments.Artifacts, 1)
+	assert.Equal(t, "data", steps[0].Arguments.Artifacts[0].Name)
+
+	templates, err := script.Templates()
+	require.NoError(t, err)
+	require.Len(t, templates, 1)
+	assert.Equal(t, "count", templates[0].Inputs.Parameters[0].Name)
+	assert.Equal(t, "/tmp/data.csv", templates[0].Inputs.Artifacts[0].Path)
+	assert.Equal(t, "/tmp/total.txt", templates[0].Outputs.Parameters[0].ValueFrom.Path)
+	assert.Equal(t, "/tmp/report.html", templates[0].Outputs.Artifacts[0].Path)
+}
+
 func TestScript_InvalidCPUQuantity(t *testing.T) {
 	script := NewScript("test", "python:3.9").CPU("not-valid-cpu")
 	_, err := script.Templates()
@@ -0,0 +1,67 @@
+package template
+
+import (
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// SidecarOption is a functional option for configuring a sidecar container.
+type SidecarOption func(*v1alpha1.UserContainer)
+
+// newSidecar creates a sidecar container with the given options applied.
+func newSidecar(name, image string, opts ...SidecarOption) v1alpha1.UserContainer {
+	sidecar := v1alpha1.UserContainer{
+		Container: corev1.Container{
+			Name:  name,
+			Image: image,
+		},
+	}
+
+	for _, opt := range opts {
+		opt(&sidecar)
+	}
+
+	return sidecar
+}
+
+// WithSidecarCommand sets the sidecar command (entrypoint override).
+func WithSidecarCommand(cmd ...string) SidecarOption {
+	return func(s *v1alpha1.UserContainer) {
+		s.Command = cmd
+	}
+}
+
+// WithSidecarArgs sets the sidecar arguments.
+func WithSidecarArgs(args ...string) SidecarOption {
+	return func(s *v1alpha1.UserContainer) {
+		s.Args = args
+	}
+}
+
+// WithSidecarEnv adds an environment variable to the sidecar.
+func WithSidecarEnv(name, value string) SidecarOption {
+	return func(s *v1alpha1.UserContainer) {
+		s.Env = append(s.Env, corev1.EnvVar{
+			Name:  name,
+			Value: value,
+		})
+	}
+}
+
+// WithSidecarPort exposes a TCP port on the sidecar.
+func WithSidecarPort(port int32) SidecarOption {
+	return func(s *v1alpha1.UserContainer) {
+		s.Ports = append(s.Ports, corev1.ContainerPort{
+			ContainerPort: port,
+			Protocol:      corev1.ProtocolTCP,
+		})
+	}
+}
+
+// WithSidecarMirrorVolumeMounts mounts the main container's volumes into the
+// sidecar at the same paths.
+func WithSidecarMirrorVolumeMounts(mirror bool) SidecarOption {
+	return func(s *v1alpha1.UserContainer) {
+		s.MirrorVolumeMounts = &mirror
+	}
+}
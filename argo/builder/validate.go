@@ -0,0 +1,166 @@
+package builder
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/distribution/reference"
+)
+
+// ValidationError is returned by Build when the assembled workflow would be
+// rejected by Argo. It lists every problem found rather than only the first.
+// Each problem can be inspected with errors.Is and errors.As.
+type ValidationError struct {
+	Problems []error
+}
+
+// Error implements the error interface.
+func (e *ValidationError) Error() string {
+	msgs := make([]string, len(e.Problems))
+	for i, p := range e.Problems {
+		msgs[i] = p.Error()
+	}
+	return "invalid workflow: " + strings.Join(msgs, "; ")
+}
+
+// Unwrap returns the individual problems.
+func (e *ValidationError) Unwrap() []error {
+	return e.Problems
+}
+
+// referencePattern matches step and task references in when conditions,
+// e.g. "{{steps.build.outputs.result}}" or "{{tasks.deploy.status}}".
+var referencePattern = regexp.MustCompile(`\b(steps|tasks)\.([a-zA-Z0-9][-a-zA-Z0-9_]*)`)
+
+// validateTemplates checks template names and image references. reserved holds
+// the names of templates generated by the build.
+func (b *WorkflowBuilder) validateTemplates(reserved ...string) []error {
+	var problems []error
+
+	for _, name := range b.templateConflicts {
+		problems = append(problems, fmt.Errorf("template %q is defined more than once with different specs", name))
+	}
+
+	for _, t := range b.templates {
+		for _, r := range reserved {
+			if t.Name == r {
+				problems = append(problems, fmt.Errorf("template name %q is reserved for the generated template", t.Name))
+			}
+		}
+
+		switch {
+		case t.Container != nil:
+			problems = append(problems, validateImage(t.Name, t.Container.Image)...)
+		case t.Script != nil:
+			problems = append(problems, validateImage(t.Name, t.Script.Image)...)
+		case t.ContainerSet != nil:
+			for _, c := range t.ContainerSet.Containers {
+				problems = append(problems, validateImage(t.Name+"/"+c.Name, c.Image)...)
+			}
+		}
+	}
+
+	return problems
+}
+
+// validateImage checks that image is a valid container image reference.
+// Parameterized images such as "{{inputs.parameters.image}}" are resolved at
+// runtime and are not checked.
+func validateImage(templateName, image string) []error {
+	if strings.TrimSpace(image) == "" {
+		return []error{fmt.Errorf("template %q has no image", templateName)}
+	}
+	if strings.Contains(image, "{{") {
+		return nil
+	}
+	if _, err := reference.ParseNormalizedNamed(image); err != nil {
+		return []error{fmt.Errorf("template %q has invalid image reference %q: %w", templateName, image, err)}
+	}
+	return nil
+}
+
+// validateSteps checks that step names in a steps template are unique, that
+// each step refers to a known template and that when conditions only refer to
+// steps of the same template.
+func (b *WorkflowBuilder) validateSteps(templateName string, groups []v1alpha1.ParallelSteps) []error {
+	var problems []error
+
+	names := make(map[string]bool)
+	for _, group := range groups {
+		for _, step := range group.Steps {
+			if names[step.Name] {
+				problems = append(problems, fmt.Errorf("duplicate step name %q in template %q", step.Name, templateName))
+			}
+			names[step.Name] = true
+		}
+	}
+
+	for _, group := range groups {
+		for _, step := range group.Steps {
+			problems = append(problems, b.validateTemplateRef("step", step.Name, step.Template, step.TemplateRef, step.Inline)...)
+			for _, ref := range whenReferences(step.When, "steps") {
+				if !names[ref] {
+					problems = append(problems, fmt.Errorf("step %q in template %q has a when condition referring to unknown step %q", step.Name, templateName, ref))
+				}
+			}
+		}
+	}
+
+	return problems
+}
+
+// validateDAG checks that each DAG task refers to a known template and that its
+// dependencies and when conditions refer to existing tasks.
+func (b *WorkflowBuilder) validateDAG(tasks []v1alpha1.DAGTask) []error {
+	var problems []error
+
+	names := make(map[string]bool, len(tasks))
+	for _, task := range tasks {
+		names[task.Name] = true
+	}
+
+	for _, task := range tasks {
+		problems = append(problems, b.validateTemplateRef("task", task.Name, task.Template, task.TemplateRef, task.Inline)...)
+		for _, dep := range task.Dependencies {
+			if !names[dep] {
+				problems = append(problems, fmt.Errorf("task %q depends on unknown task %q", task.Name, dep))
+			}
+		}
+		for _, ref := range whenReferences(task.When, "tasks") {
+			if !names[ref] {
+				problems = append(problems, fmt.Errorf("task %q has a when condition referring to unknown task %q", task.Name, ref))
+			}
+		}
+	}
+
+	return problems
+}
+
+// validateTemplateRef checks that a step or task runs something: a template
+// added to the builder, a template reference or an inline template.
+func (b *WorkflowBuilder) validateTemplateRef(kind, name, template string, templateRef *v1alpha1.TemplateRef, inline *v1alpha1.Template) []error {
+	if templateRef != nil || inline != nil {
+		return nil
+	}
+	if template == "" {
+		return []error{fmt.Errorf("%s %q has no template", kind, name)}
+	}
+	if _, ok := b.uniqueTemplates[template]; !ok {
+		return []error{fmt.Errorf("%s %q refers to unknown template %q", kind, name, template)}
+	}
+	return nil
+}
+
+// whenReferences returns the names of the steps or tasks (per kind) referred to
+// by a when condition, in order of appearance.
+func whenReferences(when, kind string) []string {
+	var refs []string
+	for _, m := range referencePattern.FindAllStringSubmatch(when, -1) {
+		if m[1] == kind {
+			refs = append(refs, m[2])
+		}
+	}
+	return refs
+}
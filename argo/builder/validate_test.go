@@ -0,0 +1,125 @@
+package builder
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/jasoet/pkg/v2/argo/builder/template"
+)
+
+func TestWorkflowBuilder_Validation(t *testing.T) {
+	t.Run("duplicate step names are rejected", func(t *testing.T) {
+		build := template.NewContainer("build", "golang:1.22", template.WithCommand("go", "build"))
+
+		wf, err := NewWorkflowBuilder("test", "argo").
+			Add(build).
+			Add(build).
+			Build()
+		require.Error(t, err)
+		assert.Nil(t, wf)
+		assert.Contains(t, err.Error(), `duplicate step name "build" in template "main"`)
+
+		var validationErr *ValidationError
+		require.True(t, errors.As(err, &validationErr))
+		assert.Len(t, validationErr.Problems, 1)
+	})
+
+	t.Run("same template name with different specs is rejected", func(t *testing.T) {
+		_, err := NewWorkflowBuilder("test", "argo").
+			AddParallel(&mockParallelSource{
+				parallelSteps: []v1alpha1.ParallelSteps{{Steps: []v1alpha1.WorkflowStep{
+					{Name: "unit", Template: "test"},
+				}}},
+				templates: []v1alpha1.Template{{Name: "test", Container: &corev1.Container{Image: "golang:1.22"}}},
+			}).
+			AddTemplate(v1alpha1.Template{Name: "test", Container: &corev1.Container{Image: "golang:1.23"}}).
+			Build()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `template "test" is defined more than once with different specs`)
+	})
+
+	t.Run("all problems are reported together", func(t *testing.T) {
+		_, err := NewWorkflowBuilder("test", "argo").
+			Add(&mockWorkflowSource{
+				steps: []v1alpha1.WorkflowStep{
+					{Name: "fetch", Template: "fetch"},
+					{Name: "compile", Template: "compile"},
+					{Name: "publish", Template: "missing", When: "{{steps.compiel.status}} == Succeeded"},
+				},
+				templates: []v1alpha1.Template{
+					{Name: "fetch", Container: &corev1.Container{}},
+					{Name: "compile", Script: &v1alpha1.ScriptTemplate{Container: corev1.Container{Image: "Golang:Latest"}}},
+				},
+			}).
+			Build()
+		require.Error(t, err)
+
+		var validationErr *ValidationError
+		require.True(t, errors.As(err, &validationErr))
+		assert.Len(t, validationErr.Problems, 4)
+		assert.Contains(t, err.Error(), `template "fetch" has no image`)
+		assert.Contains(t, err.Error(), `template "compile" has invalid image reference "Golang:Latest"`)
+		assert.Contains(t, err.Error(), `step "publish" refers to unknown template "missing"`)
+		assert.Contains(t, err.Error(), `has a when condition referring to unknown step "compiel"`)
+	})
+
+	t.Run("parameterized image is accepted", func(t *testing.T) {
+		deploy := template.NewContainer("deploy", "{{workflow.parameters.image}}")
+
+		_, err := NewWorkflowBuilder("test", "argo", WithParameter("image", "myapp:v1")).
+			Add(deploy).
+			Build()
+		assert.NoError(t, err)
+	})
+
+	t.Run("when condition may refer to earlier step", func(t *testing.T) {
+		check := template.NewContainer("check", "alpine:latest")
+		deploy := template.NewContainer("deploy", "alpine:latest",
+			template.WithWhen("{{steps.check.exitCode}} == 0"))
+
+		_, err := NewWorkflowBuilder("test", "argo").
+			Add(check).
+			Add(deploy).
+			Build()
+		assert.NoError(t, err)
+	})
+
+	t.Run("DAG when condition referring to unknown task is rejected", func(t *testing.T) {
+		build := template.NewContainer("build", "alpine:latest")
+		deploy := template.NewContainer("deploy", "alpine:latest",
+			template.WithWhen("{{tasks.test.status}} == Succeeded"))
+
+		_, err := NewWorkflowBuilder("test", "argo").
+			AddWithDeps(build).
+			AddWithDeps(deploy, "build").
+			Build()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `task "deploy" has a when condition referring to unknown task "test"`)
+	})
+
+	t.Run("template named after generated template is rejected", func(t *testing.T) {
+		_, err := NewWorkflowBuilder("test", "argo").
+			Add(template.NewNoop()).
+			AddTemplate(v1alpha1.Template{Name: "main", Container: &corev1.Container{Image: "alpine:latest"}}).
+			Build()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `template name "main" is reserved`)
+	})
+
+	t.Run("duplicate exit handler step names are rejected", func(t *testing.T) {
+		notify := template.NewContainer("notify", "alpine:latest")
+
+		_, err := NewWorkflowBuilder("test", "argo").
+			Add(template.NewNoop()).
+			AddExitHandler(notify).
+			AddExitHandler(notify).
+			Build()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `duplicate step name "notify" in template "exit-handler"`)
+	})
+}
@@ -0,0 +1,209 @@
+package argo
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/argoproj/argo-workflows/v3/pkg/apiclient/cronworkflow"
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/jasoet/pkg/v2/otel"
+)
+
+// mockCronWorkflowServiceClient is an in-memory cron workflow service keyed by
+// namespace/name.
+type mockCronWorkflowServiceClient struct {
+	cronWorkflows map[string]*v1alpha1.CronWorkflow
+	err           error
+}
+
+func newMockCronWorkflowServiceClient() *mockCronWorkflowServiceClient {
+	return &mockCronWorkflowServiceClient{cronWorkflows: make(map[string]*v1alpha1.CronWorkflow)}
+}
+
+func (m *mockCronWorkflowServiceClient) LintCronWorkflow(ctx context.Context, req *cronworkflow.LintCronWorkflowRequest, _ ...grpc.CallOption) (*v1alpha1.CronWorkflow, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockCronWorkflowServiceClient) CreateCronWorkflow(ctx context.Context, req *cronworkflow.CreateCronWorkflowRequest, _ ...grpc.CallOption) (*v1alpha1.CronWorkflow, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	created := req.CronWorkflow.DeepCopy()
+	created.Namespace = req.Namespace
+	created.UID = types.UID("uid-" + created.Name)
+	m.cronWorkflows[req.Namespace+"/"+created.Name] = created
+	return created.DeepCopy(), nil
+}
+
+func (m *mockCronWorkflowServiceClient) ListCronWorkflows(ctx context.Context, req *cronworkflow.ListCronWorkflowsRequest, _ ...grpc.CallOption) (*v1alpha1.CronWorkflowList, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	list := &v1alpha1.CronWorkflowList{}
+	for _, cwf := range m.cronWorkflows {
+		if cwf.Namespace != req.Namespace {
+			continue
+		}
+		if selector := req.ListOptions.LabelSelector; selector != "" && selector != "team="+cwf.Labels["team"] {
+			continue
+		}
+		list.Items = append(list.Items, *cwf.DeepCopy())
+	}
+	return list, nil
+}
+
+func (m *mockCronWorkflowServiceClient) GetCronWorkflow(ctx context.Context, req *cronworkflow.GetCronWorkflowRequest, _ ...grpc.CallOption) (*v1alpha1.CronWorkflow, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockCronWorkflowServiceClient) UpdateCronWorkflow(ctx context.Context, req *cronworkflow.UpdateCronWorkflowRequest, _ ...grpc.CallOption) (*v1alpha1.CronWorkflow, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockCronWorkflowServiceClient) DeleteCronWorkflow(ctx context.Context, req *cronworkflow.DeleteCronWorkflowRequest, _ ...grpc.CallOption) (*cronworkflow.CronWorkflowDeletedResponse, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockCronWorkflowServiceClient) ResumeCronWorkflow(ctx context.Context, req *cronworkflow.CronWorkflowResumeRequest, _ ...grpc.CallOption) (*v1alpha1.CronWorkflow, error) {
+	return m.setSuspend(req.Namespace, req.Name, false)
+}
+
+func (m *mockCronWorkflowServiceClient) SuspendCronWorkflow(ctx context.Context, req *cronworkflow.CronWorkflowSuspendRequest, _ ...grpc.CallOption) (*v1alpha1.CronWorkflow, error) {
+	return m.setSuspend(req.Namespace, req.Name, true)
+}
+
+func (m *mockCronWorkflowServiceClient) setSuspend(namespace, name string, suspend bool) (*v1alpha1.CronWorkflow, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	cwf, ok := m.cronWorkflows[namespace+"/"+name]
+	if !ok {
+		return nil, errors.New("cron workflow not found")
+	}
+	cwf.Spec.Suspend = suspend
+	return cwf.DeepCopy(), nil
+}
+
+func testCronWorkflow(name string, labels map[string]string) *v1alpha1.CronWorkflow {
+	return &v1alpha1.CronWorkflow{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "argo",
+			Labels:    labels,
+		},
+		Spec: v1alpha1.CronWorkflowSpec{
+			Schedule: "0 2 * * *",
+			WorkflowSpec: v1alpha1.WorkflowSpec{
+				Entrypoint: "main",
+			},
+		},
+	}
+}
+
+func TestSubmitCronWorkflow(t *testing.T) {
+	ctx := context.Background()
+	cfg := otel.NewConfig("test")
+
+	t.Run("successful submission", func(t *testing.T) {
+		cronClient := newMockCronWorkflowServiceClient()
+		client := &mockArgoClient{cronWorkflowServiceClient: cronClient}
+
+		created, err := SubmitCronWorkflow(ctx, client, testCronWorkflow("nightly-backup", nil), cfg)
+		require.NoError(t, err)
+		require.NotNil(t, created)
+		assert.Equal(t, "nightly-backup", created.Name)
+		assert.Equal(t, "argo", created.Namespace)
+		assert.Equal(t, "0 2 * * *", created.Spec.Schedule)
+		assert.Contains(t, cronClient.cronWorkflows, "argo/nightly-backup")
+	})
+
+	t.Run("submission failure", func(t *testing.T) {
+		cronClient := newMockCronWorkflowServiceClient()
+		cronClient.err = errors.New("already exists")
+		client := &mockArgoClient{cronWorkflowServiceClient: cronClient}
+
+		created, err := SubmitCronWorkflow(ctx, client, testCronWorkflow("nightly-backup", nil), nil)
+		require.Error(t, err)
+		assert.Nil(t, created)
+		assert.Contains(t, err.Error(), "failed to submit cron workflow")
+	})
+
+	t.Run("service client unavailable", func(t *testing.T) {
+		created, err := SubmitCronWorkflow(ctx, &mockArgoClient{}, testCronWorkflow("nightly-backup", nil), nil)
+		require.Error(t, err)
+		assert.Nil(t, created)
+		assert.Contains(t, err.Error(), "failed to create cron workflow service client")
+	})
+}
+
+func TestListCronWorkflows(t *testing.T) {
+	ctx := context.Background()
+	cronClient := newMockCronWorkflowServiceClient()
+	client := &mockArgoClient{cronWorkflowServiceClient: cronClient}
+
+	_, err := SubmitCronWorkflow(ctx, client, testCronWorkflow("backup", map[string]string{"team": "data"}), nil)
+	require.NoError(t, err)
+	_, err = SubmitCronWorkflow(ctx, client, testCronWorkflow("report", map[string]string{"team": "finance"}), nil)
+	require.NoError(t, err)
+
+	t.Run("all in namespace", func(t *testing.T) {
+		cronWorkflows, err := ListCronWorkflows(ctx, client, "argo", "", nil)
+		require.NoError(t, err)
+		assert.Len(t, cronWorkflows, 2)
+	})
+
+	t.Run("with label selector", func(t *testing.T) {
+		cronWorkflows, err := ListCronWorkflows(ctx, client, "argo", "team=data", nil)
+		require.NoError(t, err)
+		require.Len(t, cronWorkflows, 1)
+		assert.Equal(t, "backup", cronWorkflows[0].Name)
+	})
+
+	t.Run("list failure", func(t *testing.T) {
+		failing := newMockCronWorkflowServiceClient()
+		failing.err = errors.New("forbidden")
+
+		cronWorkflows, err := ListCronWorkflows(ctx, &mockArgoClient{cronWorkflowServiceClient: failing}, "argo", "", nil)
+		require.Error(t, err)
+		assert.Nil(t, cronWorkflows)
+		assert.Contains(t, err.Error(), "failed to list cron workflows")
+	})
+}
+
+func TestSuspendAndResumeCronWorkflow(t *testing.T) {
+	ctx := context.Background()
+	cronClient := newMockCronWorkflowServiceClient()
+	client := &mockArgoClient{cronWorkflowServiceClient: cronClient}
+
+	_, err := SubmitCronWorkflow(ctx, client, testCronWorkflow("nightly-backup", nil), nil)
+	require.NoError(t, err)
+
+	suspended, err := SuspendCronWorkflow(ctx, client, "argo", "nightly-backup", nil)
+	require.NoError(t, err)
+	assert.True(t, suspended.Spec.Suspend)
+	assert.True(t, cronClient.cronWorkflows["argo/nightly-backup"].Spec.Suspend)
+
+	resumed, err := ResumeCronWorkflow(ctx, client, "argo", "nightly-backup", nil)
+	require.NoError(t, err)
+	assert.False(t, resumed.Spec.Suspend)
+	assert.False(t, cronClient.cronWorkflows["argo/nightly-backup"].Spec.Suspend)
+
+	t.Run("suspend unknown cron workflow", func(t *testing.T) {
+		_, err := SuspendCronWorkflow(ctx, client, "argo", "missing", nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to suspend cron workflow")
+	})
+
+	t.Run("resume unknown cron workflow", func(t *testing.T) {
+		_, err := ResumeCronWorkflow(ctx, client, "argo", "missing", nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to resume cron workflow")
+	})
+}
@@ -0,0 +1,146 @@
+package argo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/argoproj/argo-workflows/v3/pkg/apiclient"
+	"github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
+	"go.opentelemetry.io/otel/trace"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/jasoet/pkg/v2/otel"
+)
+
+// ErrPodNotReady is returned by StreamWorkflowLogs when the requested pod has not
+// been scheduled or its container has not started yet. Callers can retry later.
+var ErrPodNotReady = errors.New("argo: pod not ready")
+
+// notReadyMessages are fragments of Kubernetes errors reported while a pod is
+// still being created.
+var notReadyMessages = []string{
+	"is waiting to start",
+	"ContainerCreating",
+	"PodInitializing",
+}
+
+// logOptions holds the configuration for StreamWorkflowLogs.
+type logOptions struct {
+	follow     bool
+	container  string
+	otelConfig *otel.Config
+}
+
+// LogOption configures StreamWorkflowLogs.
+type LogOption func(*logOptions)
+
+// WithLogFollow keeps the stream open and writes new lines as they are
+// produced, until the pod terminates or ctx is cancelled.
+func WithLogFollow(follow bool) LogOption {
+	return func(o *logOptions) {
+		o.follow = follow
+	}
+}
+
+// WithLogContainer selects the container to read logs from. Defaults to "main".
+func WithLogContainer(container string) LogOption {
+	return func(o *logOptions) {
+		o.container = container
+	}
+}
+
+// WithLogOTelConfig enables logging and tracing.
+func WithLogOTelConfig(cfg *otel.Config) LogOption {
+	return func(o *logOptions) {
+		o.otelConfig = cfg
+	}
+}
+
+// StreamWorkflowLogs writes the logs of a workflow pod to w, one line per log entry.
+// If podName is empty, logs of all pods in the workflow are streamed.
+// ErrPodNotReady is returned (wrapped) when the pod has not started yet.
+//
+// Example:
+//
+//	var buf bytes.Buffer
+//	err := argo.StreamWorkflowLogs(ctx, client, "argo", "deploy-abc123", "deploy-abc123-1234567890", &buf,
+//	    argo.WithLogFollow(true))
+//	if errors.Is(err, argo.ErrPodNotReady) {
+//	    // retry later
+//	}
+func StreamWorkflowLogs(ctx context.Context, client apiclient.Client, namespace, name, podName string, w io.Writer, opts ...LogOption) error {
+	o := &logOptions{container: "main"}
+	for _, opt := range opts {
+		opt(o)
+	}
+	cfg := o.otelConfig
+
+	// Start span
+	var span trace.Span
+	if cfg != nil && cfg.TracerProvider != nil {
+		tracer := cfg.TracerProvider.Tracer("github.com/jasoet/pkg/v2/argo")
+		ctx, span = tracer.Start(ctx, "argo.StreamWorkflowLogs")
+		defer span.End()
+	}
+
+	logger := otel.NewLogHelper(ctx, cfg, "github.com/jasoet/pkg/v2/argo", "argo.StreamWorkflowLogs")
+	logger.Debug("Streaming workflow logs",
+		otel.F("namespace", namespace),
+		otel.F("name", name),
+		otel.F("pod_name", podName),
+		otel.F("follow", o.follow))
+
+	wfClient := client.NewWorkflowServiceClient()
+	stream, err := wfClient.WorkflowLogs(ctx, &workflow.WorkflowLogRequest{
+		Namespace: namespace,
+		Name:      name,
+		PodName:   podName,
+		LogOptions: &corev1.PodLogOptions{
+			Container: o.container,
+			Follow:    o.follow,
+		},
+	})
+	if err != nil {
+		return logStreamError(logger, podName, err)
+	}
+
+	for {
+		entry, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return logStreamError(logger, podName, err)
+		}
+
+		if _, err := io.WriteString(w, entry.Content+"\n"); err != nil {
+			return fmt.Errorf("failed to write log line: %w", err)
+		}
+	}
+}
+
+// logStreamError maps pod-not-started errors to ErrPodNotReady.
+func logStreamError(logger *otel.LogHelper, podName string, err error) error {
+	if isPodNotReady(err) {
+		logger.Debug("Pod not ready", otel.F("pod_name", podName), otel.F("error", err.Error()))
+		return fmt.Errorf("%w: %s", ErrPodNotReady, err.Error())
+	}
+
+	logger.Error(err, "Failed to stream workflow logs", otel.F("pod_name", podName))
+	return fmt.Errorf("failed to stream workflow logs: %w", err)
+}
+
+// isPodNotReady reports whether err indicates that the pod or its container
+// has not started yet.
+func isPodNotReady(err error) bool {
+	msg := err.Error()
+	for _, fragment := range notReadyMessages {
+		if strings.Contains(msg, fragment) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,127 @@
+package argo
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/argoproj/argo-workflows/v3/pkg/apiclient/workflow"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+// mockLogStream implements workflow.WorkflowService_WorkflowLogsClient
+type mockLogStream struct {
+	grpc.ClientStream
+	entries []*workflow.LogEntry
+	err     error
+}
+
+func (m *mockLogStream) Recv() (*workflow.LogEntry, error) {
+	if len(m.entries) == 0 {
+		if m.err != nil {
+			return nil, m.err
+		}
+		return nil, io.EOF
+	}
+	entry := m.entries[0]
+	m.entries = m.entries[1:]
+	return entry, nil
+}
+
+func TestStreamWorkflowLogs(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("writes log lines in order", func(t *testing.T) {
+		var gotReq *workflow.WorkflowLogRequest
+		mockWfClient := &mockWorkflowServiceClient{
+			workflowLogsFunc: func(ctx context.Context, req *workflow.WorkflowLogRequest) (workflow.WorkflowService_WorkflowLogsClient, error) {
+				gotReq = req
+				return &mockLogStream{entries: []*workflow.LogEntry{
+					{PodName: "wf-pod", Content: "starting"},
+					{PodName: "wf-pod", Content: "processing"},
+					{PodName: "wf-pod", Content: "done"},
+				}}, nil
+			},
+		}
+		client := &mockArgoClient{workflowServiceClient: mockWfClient}
+
+		var buf bytes.Buffer
+		err := StreamWorkflowLogs(ctx, client, "argo", "wf", "wf-pod", &buf, WithLogFollow(true))
+		require.NoError(t, err)
+		assert.Equal(t, "starting\nprocessing\ndone\n", buf.String())
+
+		require.NotNil(t, gotReq)
+		assert.Equal(t, "argo", gotReq.Namespace)
+		assert.Equal(t, "wf", gotReq.Name)
+		assert.Equal(t, "wf-pod", gotReq.PodName)
+		require.NotNil(t, gotReq.LogOptions)
+		assert.True(t, gotReq.LogOptions.Follow)
+		assert.Equal(t, "main", gotReq.LogOptions.Container)
+	})
+
+	t.Run("custom container", func(t *testing.T) {
+		var gotReq *workflow.WorkflowLogRequest
+		mockWfClient := &mockWorkflowServiceClient{
+			workflowLogsFunc: func(ctx context.Context, req *workflow.WorkflowLogRequest) (workflow.WorkflowService_WorkflowLogsClient, error) {
+				gotReq = req
+				return &mockLogStream{}, nil
+			},
+		}
+		client := &mockArgoClient{workflowServiceClient: mockWfClient}
+
+		err := StreamWorkflowLogs(ctx, client, "argo", "wf", "", io.Discard, WithLogContainer("init"))
+		require.NoError(t, err)
+		assert.Equal(t, "init", gotReq.LogOptions.Container)
+		assert.False(t, gotReq.LogOptions.Follow)
+	})
+
+	t.Run("pod not ready", func(t *testing.T) {
+		mockWfClient := &mockWorkflowServiceClient{
+			workflowLogsFunc: func(ctx context.Context, req *workflow.WorkflowLogRequest) (workflow.WorkflowService_WorkflowLogsClient, error) {
+				return &mockLogStream{
+					err: errors.New(`container "main" in pod "wf-pod" is waiting to start: ContainerCreating`),
+				}, nil
+			},
+		}
+		client := &mockArgoClient{workflowServiceClient: mockWfClient}
+
+		err := StreamWorkflowLogs(ctx, client, "argo", "wf", "wf-pod", io.Discard)
+		require.Error(t, err)
+		assert.ErrorIs(t, err, ErrPodNotReady)
+	})
+
+	t.Run("request error", func(t *testing.T) {
+		mockWfClient := &mockWorkflowServiceClient{
+			workflowLogsFunc: func(ctx context.Context, req *workflow.WorkflowLogRequest) (workflow.WorkflowService_WorkflowLogsClient, error) {
+				return nil, errors.New("permission denied")
+			},
+		}
+		client := &mockArgoClient{workflowServiceClient: mockWfClient}
+
+		err := StreamWorkflowLogs(ctx, client, "argo", "wf", "wf-pod", io.Discard)
+		require.Error(t, err)
+		assert.NotErrorIs(t, err, ErrPodNotReady)
+		assert.Contains(t, err.Error(), "failed to stream workflow logs")
+	})
+
+	t.Run("lines before stream error are kept", func(t *testing.T) {
+		mockWfClient := &mockWorkflowServiceClient{
+			workflowLogsFunc: func(ctx context.Context, req *workflow.WorkflowLogRequest) (workflow.WorkflowService_WorkflowLogsClient, error) {
+				return &mockLogStream{
+					entries: []*workflow.LogEntry{{Content: "first"}},
+					err:     errors.New("stream reset"),
+				}, nil
+			},
+		}
+		client := &mockArgoClient{workflowServiceClient: mockWfClient}
+
+		var buf bytes.Buffer
+		err := StreamWorkflowLogs(ctx, client, "argo", "wf", "wf-pod", &buf)
+		require.Error(t, err)
+		assert.Equal(t, "first\n", buf.String())
+	})
+}
@@ -113,78 +113,159 @@ func SubmitAndWait(ctx context.Context, client apiclient.Client, wf *v1alpha1.Wo
 		return nil, err
 	}
 
-	logger.Info("Waiting for workflow completion",
+	// Wait for completion with polling
+	result, err := WaitForWorkflow(ctx, client, created.Namespace, created.Name, WaitOptions{
+		PollInterval: 5 * time.Second,
+		Timeout:      timeout,
+		OTelConfig:   cfg,
+	})
+	if err != nil {
+		if result == nil {
+			result = created
+		}
+		return result, err
+	}
+
+	duration := time.Since(startTime)
+	if result.Status.Phase != v1alpha1.WorkflowSucceeded {
+		err := fmt.Errorf("workflow failed with phase: %s, message: %s", result.Status.Phase, result.Status.Message)
+		logger.Error(err, "Workflow failed",
+			otel.F("workflow_name", created.Name),
+			otel.F("phase", string(result.Status.Phase)),
+			otel.F("duration", duration.String()))
+
+		if span != nil && span.IsRecording() {
+			span.SetAttributes(
+				attribute.String("workflow.status", "failed"),
+				attribute.String("workflow.phase", string(result.Status.Phase)),
+				attribute.Float64("workflow.duration_seconds", duration.Seconds()),
+			)
+		}
+
+		return result, err
+	}
+
+	logger.Info("Workflow succeeded",
 		otel.F("workflow_name", created.Name),
-		otel.F("timeout", timeout.String()))
+		otel.F("duration", duration.String()))
 
-	// Wait for completion with polling
-	ticker := time.NewTicker(5 * time.Second)
-	defer ticker.Stop()
+	if span != nil && span.IsRecording() {
+		span.SetAttributes(
+			attribute.String("workflow.status", "succeeded"),
+			attribute.Float64("workflow.duration_seconds", duration.Seconds()),
+		)
+	}
 
-	timeoutCtx, cancel := context.WithTimeout(ctx, timeout)
-	defer cancel()
+	return result, nil
+}
 
-	wfClient := client.NewWorkflowServiceClient()
+// WaitOptions configures WaitForWorkflow.
+type WaitOptions struct {
+	// PollInterval is the delay between status checks. Defaults to 5 seconds.
+	PollInterval time.Duration
 
-	for {
-		select {
-		case <-timeoutCtx.Done():
-			err := fmt.Errorf("timeout waiting for workflow: %s", created.Name)
-			logger.Error(err, "Workflow timed out",
-				otel.F("workflow_name", created.Name),
-				otel.F("duration", time.Since(startTime).String()))
-			return created, err
+	// Timeout bounds the overall wait. Zero means wait until ctx is done.
+	Timeout time.Duration
 
-		case <-ticker.C:
-			result, err := wfClient.GetWorkflow(timeoutCtx, &workflow.WorkflowGetRequest{
-				Namespace: created.Namespace,
-				Name:      created.Name,
-			})
-			if err != nil {
-				logger.Warn("Failed to get workflow status", otel.F("error", err.Error()))
-				continue
-			}
+	// OTelConfig enables logging and tracing. Optional.
+	OTelConfig *otel.Config
+}
 
-			// Check if workflow is complete
-			if result.Status.Phase == v1alpha1.WorkflowSucceeded {
-				duration := time.Since(startTime)
-				logger.Info("Workflow succeeded",
-					otel.F("workflow_name", created.Name),
-					otel.F("duration", duration.String()))
+// WaitForWorkflow blocks until the named workflow reaches a terminal phase
+// (Succeeded, Failed or Error) and returns the final workflow object.
+// A failed workflow is not an error; inspect Status.Phase on the result.
+// An error is returned only when the timeout expires or ctx is cancelled, in
+// which case the last observed workflow (if any) is returned alongside it.
+//
+// Transient errors while fetching the workflow are logged and retried on the
+// next poll.
+//
+// Example:
+//
+//	created, err := argo.SubmitWorkflow(ctx, client, wf, otelConfig)
+//	if err != nil {
+//	    return err
+//	}
+//
+//	final, err := argo.WaitForWorkflow(ctx, client, created.Namespace, created.Name, argo.WaitOptions{
+//	    PollInterval: 2 * time.Second,
+//	    Timeout:      10 * time.Minute,
+//	})
+//	if err != nil {
+//	    return err
+//	}
+//	fmt.Printf("Workflow finished with phase %s\n", final.Status.Phase)
+func WaitForWorkflow(ctx context.Context, client apiclient.Client, namespace, name string, opts WaitOptions) (*v1alpha1.Workflow, error) {
+	cfg := opts.OTelConfig
 
-				if span != nil && span.IsRecording() {
-					span.SetAttributes(
-						attribute.String("workflow.status", "succeeded"),
-						attribute.Float64("workflow.duration_seconds", duration.Seconds()),
-					)
-				}
+	// Start span
+	var span trace.Span
+	if cfg != nil && cfg.TracerProvider != nil {
+		tracer := cfg.TracerProvider.Tracer("github.com/jasoet/pkg/v2/argo")
+		ctx, span = tracer.Start(ctx, "argo.WaitForWorkflow")
+		defer span.End()
+	}
 
-				return result, nil
-			}
+	logger := otel.NewLogHelper(ctx, cfg, "github.com/jasoet/pkg/v2/argo", "argo.WaitForWorkflow")
 
-			if result.Status.Phase == v1alpha1.WorkflowFailed || result.Status.Phase == v1alpha1.WorkflowError {
-				duration := time.Since(startTime)
-				err := fmt.Errorf("workflow failed with phase: %s, message: %s", result.Status.Phase, result.Status.Message)
-				logger.Error(err, "Workflow failed",
-					otel.F("workflow_name", created.Name),
-					otel.F("phase", string(result.Status.Phase)),
-					otel.F("duration", duration.String()))
+	interval := opts.PollInterval
+	if interval <= 0 {
+		interval = 5 * time.Second
+	}
+
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+
+	logger.Info("Waiting for workflow completion",
+		otel.F("namespace", namespace),
+		otel.F("workflow_name", name),
+		otel.F("poll_interval", interval.String()),
+		otel.F("timeout", opts.Timeout.String()))
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	wfClient := client.NewWorkflowServiceClient()
+
+	var last *v1alpha1.Workflow
+	for {
+		result, err := wfClient.GetWorkflow(ctx, &workflow.WorkflowGetRequest{
+			Namespace: namespace,
+			Name:      name,
+		})
+		if err != nil {
+			logger.Warn("Failed to get workflow status", otel.F("error", err.Error()))
+		} else {
+			last = result
+
+			if result.Status.Phase.Completed() {
+				logger.Info("Workflow completed",
+					otel.F("workflow_name", name),
+					otel.F("phase", string(result.Status.Phase)))
 
 				if span != nil && span.IsRecording() {
-					span.SetAttributes(
-						attribute.String("workflow.status", "failed"),
-						attribute.String("workflow.phase", string(result.Status.Phase)),
-						attribute.Float64("workflow.duration_seconds", duration.Seconds()),
-					)
+					span.SetAttributes(attribute.String("workflow.phase", string(result.Status.Phase)))
 				}
 
-				return result, err
+				return result, nil
 			}
 
 			logger.Debug("Workflow still running",
-				otel.F("workflow_name", created.Name),
+				otel.F("workflow_name", name),
 				otel.F("phase", string(result.Status.Phase)))
 		}
+
+		select {
+		case <-ctx.Done():
+			err := fmt.Errorf("timeout waiting for workflow: %s: %w", name, ctx.Err())
+			logger.Error(err, "Workflow did not complete in time",
+				otel.F("workflow_name", name))
+			return last, err
+		case <-ticker.C:
+		}
 	}
 }
 
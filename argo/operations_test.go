@@ -27,6 +27,7 @@ type mockWorkflowServiceClient struct {
 	getWorkflowFunc    func(ctx context.Context, req *workflow.WorkflowGetRequest) (*v1alpha1.Workflow, error)
 	listWorkflowsFunc  func(ctx context.Context, req *workflow.WorkflowListRequest) (*v1alpha1.WorkflowList, error)
 	deleteWorkflowFunc func(ctx context.Context, req *workflow.WorkflowDeleteRequest) (*workflow.WorkflowDeleteResponse, error)
+	workflowLogsFunc   func(ctx context.Context, req *workflow.WorkflowLogRequest) (workflow.WorkflowService_WorkflowLogsClient, error)
 }
 
 func (m *mockWorkflowServiceClient) CreateWorkflow(ctx context.Context, req *workflow.WorkflowCreateRequest, _ ...grpc.CallOption) (*v1alpha1.Workflow, error) {
@@ -106,12 +107,16 @@ func (m *mockWorkflowServiceClient) PodLogs(ctx context.Context, req *workflow.W
 }
 
 func (m *mockWorkflowServiceClient) WorkflowLogs(ctx context.Context, req *workflow.WorkflowLogRequest, _ ...grpc.CallOption) (workflow.WorkflowService_WorkflowLogsClient, error) {
+	if m.workflowLogsFunc != nil {
+		return m.workflowLogsFunc(ctx, req)
+	}
 	return nil, errors.New("not implemented")
 }
 
 // Mock Argo client
 type mockArgoClient struct {
-	workflowServiceClient workflow.WorkflowServiceClient
+	workflowServiceClient     workflow.WorkflowServiceClient
+	cronWorkflowServiceClient cronworkflow.CronWorkflowServiceClient
 }
 
 func (m *mockArgoClient) NewWorkflowServiceClient() workflow.WorkflowServiceClient {
@@ -123,6 +128,9 @@ func (m *mockArgoClient) NewArchivedWorkflowServiceClient() (workflowarchive.Arc
 }
 
 func (m *mockArgoClient) NewCronWorkflowServiceClient() (cronworkflow.CronWorkflowServiceClient, error) {
+	if m.cronWorkflowServiceClient != nil {
+		return m.cronWorkflowServiceClient, nil
+	}
 	return nil, errors.New("not implemented")
 }
 
@@ -302,6 +310,109 @@ func TestSubmitAndWait(t *testing.T) {
 	})
 }
 
+func TestWaitForWorkflow(t *testing.T) {
+	ctx := context.Background()
+
+	newClient := func(phases ...v1alpha1.WorkflowPhase) (*mockArgoClient, *int) {
+		calls := 0
+		mockWfClient := &mockWorkflowServiceClient{
+			getWorkflowFunc: func(ctx context.Context, req *workflow.WorkflowGetRequest) (*v1alpha1.Workflow, error) {
+				phase := phases[min(calls, len(phases)-1)]
+				calls++
+				return &v1alpha1.Workflow{
+					ObjectMeta: metav1.ObjectMeta{Name: req.Name, Namespace: req.Namespace},
+					Status:     v1alpha1.WorkflowStatus{Phase: phase},
+				}, nil
+			},
+		}
+		return &mockArgoClient{workflowServiceClient: mockWfClient}, &calls
+	}
+
+	t.Run("returns once running workflow succeeds", func(t *testing.T) {
+		client, calls := newClient(v1alpha1.WorkflowRunning, v1alpha1.WorkflowRunning, v1alpha1.WorkflowSucceeded)
+
+		wf, err := WaitForWorkflow(ctx, client, "argo", "test-abc123", WaitOptions{
+			PollInterval: 10 * time.Millisecond,
+			Timeout:      5 * time.Second,
+		})
+		require.NoError(t, err)
+		require.NotNil(t, wf)
+		assert.Equal(t, "test-abc123", wf.Name)
+		assert.Equal(t, v1alpha1.WorkflowSucceeded, wf.Status.Phase)
+		assert.Equal(t, 3, *calls)
+	})
+
+	t.Run("failed phase is terminal without error", func(t *testing.T) {
+		client, _ := newClient(v1alpha1.WorkflowRunning, v1alpha1.WorkflowFailed)
+
+		wf, err := WaitForWorkflow(ctx, client, "argo", "test-failed", WaitOptions{
+			PollInterval: 10 * time.Millisecond,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, v1alpha1.WorkflowFailed, wf.Status.Phase)
+	})
+
+	t.Run("already completed returns immediately", func(t *testing.T) {
+		client, calls := newClient(v1alpha1.WorkflowError)
+
+		wf, err := WaitForWorkflow(ctx, client, "argo", "test-error", WaitOptions{
+			PollInterval: time.Hour,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, v1alpha1.WorkflowError, wf.Status.Phase)
+		assert.Equal(t, 1, *calls)
+	})
+
+	t.Run("timeout returns last observed workflow", func(t *testing.T) {
+		client, _ := newClient(v1alpha1.WorkflowRunning)
+
+		wf, err := WaitForWorkflow(ctx, client, "argo", "test-timeout", WaitOptions{
+			PollInterval: 10 * time.Millisecond,
+			Timeout:      50 * time.Millisecond,
+		})
+		require.Error(t, err)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Contains(t, err.Error(), "timeout")
+		require.NotNil(t, wf)
+		assert.Equal(t, v1alpha1.WorkflowRunning, wf.Status.Phase)
+	})
+
+	t.Run("context cancellation", func(t *testing.T) {
+		client, _ := newClient(v1alpha1.WorkflowRunning)
+
+		cancelCtx, cancel := context.WithCancel(ctx)
+		cancel()
+
+		_, err := WaitForWorkflow(cancelCtx, client, "argo", "test-cancel", WaitOptions{
+			PollInterval: 10 * time.Millisecond,
+		})
+		require.Error(t, err)
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("retries transient get errors", func(t *testing.T) {
+		calls := 0
+		mockWfClient := &mockWorkflowServiceClient{
+			getWorkflowFunc: func(ctx context.Context, req *workflow.WorkflowGetRequest) (*v1alpha1.Workflow, error) {
+				calls++
+				if calls == 1 {
+					return nil, errors.New("connection refused")
+				}
+				return &v1alpha1.Workflow{Status: v1alpha1.WorkflowStatus{Phase: v1alpha1.WorkflowSucceeded}}, nil
+			},
+		}
+		client := &mockArgoClient{workflowServiceClient: mockWfClient}
+
+		wf, err := WaitForWorkflow(ctx, client, "argo", "test-retry", WaitOptions{
+			PollInterval: 10 * time.Millisecond,
+			OTelConfig:   otel.NewConfig("test"),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, v1alpha1.WorkflowSucceeded, wf.Status.Phase)
+		assert.Equal(t, 2, calls)
+	})
+}
+
 func TestGetWorkflowStatus(t *testing.T) {
 	ctx := context.Background()
 	cfg := otel.NewConfig("test")
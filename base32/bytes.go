@@ -0,0 +1,97 @@
+package base32
+
+import "fmt"
+
+// EncodeBytes encodes an arbitrary byte slice to a Base32 string.
+//
+// Bytes are read as a big-endian bit stream and split into 5-bit groups; the
+// final group is zero-padded on the right. No padding characters are added, so
+// the output is exactly ceil(len(data)*8/5) characters long.
+//
+// Example:
+//
+//	base32.EncodeBytes([]byte{0xFF})        // "ZW"
+//	base32.EncodeBytes([]byte("hi"))        // "D1MG"
+//	base32.EncodeBytes(uuid[:])             // 26 characters
+//
+// Parameters:
+//   - data: The bytes to encode (may be empty)
+//
+// Returns:
+//   - The Base32-encoded string, or "" for empty input
+func EncodeBytes(data []byte) string {
+	if len(data) == 0 {
+		return ""
+	}
+
+	result := make([]byte, 0, (len(data)*8+4)/5)
+
+	var buffer uint16
+	bits := 0
+	for _, b := range data {
+		buffer = buffer<<8 | uint16(b)
+		bits += 8
+		for bits >= 5 {
+			bits -= 5
+			result = append(result, base32Alphabet[(buffer>>bits)&0x1F])
+		}
+	}
+
+	if bits > 0 {
+		result = append(result, base32Alphabet[(buffer<<(5-bits))&0x1F])
+	}
+
+	return string(result)
+}
+
+// DecodeBytes decodes a Base32 string produced by EncodeBytes back to bytes.
+//
+// The input is normalized with NormalizeBase32 first, so decoding is
+// case-insensitive, ignores dashes and whitespace, and corrects I→1, L→1, O→0.
+//
+// Returns an error if the input contains invalid characters or its length or
+// trailing padding bits could not have been produced by EncodeBytes.
+//
+// Example:
+//
+//	data, err := base32.DecodeBytes("D1MG")   // []byte("hi"), nil
+//	data, err := base32.DecodeBytes("d1-mg")  // []byte("hi"), nil
+//
+// Parameters:
+//   - encoded: The Base32-encoded string to decode
+//
+// Returns:
+//   - The decoded bytes (empty, non-nil slice for empty input)
+//   - An error if the input is malformed
+func DecodeBytes(encoded string) ([]byte, error) {
+	normalized := NormalizeBase32(encoded)
+
+	byteLen := len(normalized) * 5 / 8
+	if (byteLen*8+4)/5 != len(normalized) {
+		return nil, fmt.Errorf("invalid Base32 length %d", len(normalized))
+	}
+
+	result := make([]byte, 0, byteLen)
+
+	var buffer uint16
+	bits := 0
+	for i, char := range normalized {
+		value := base32CharToValue(char)
+		if value < 0 {
+			return nil, fmt.Errorf("invalid Base32 character '%c' at position %d", char, i)
+		}
+
+		buffer = buffer<<5 | uint16(value)
+		bits += 5
+		if bits >= 8 {
+			bits -= 8
+			result = append(result, byte(buffer>>bits))
+		}
+	}
+
+	if buffer&(1<<bits-1) != 0 {
+		return nil, fmt.Errorf("non-zero padding bits in final Base32 character")
+	}
+
+	return result, nil
+}
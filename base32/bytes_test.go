@@ -0,0 +1,80 @@
+package base32
+
+import (
+	"crypto/rand"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeBytes(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+		want string
+	}{
+		{"empty", []byte{}, ""},
+		{"nil", nil, ""},
+		{"single zero byte", []byte{0x00}, "00"},
+		{"single max byte", []byte{0xFF}, "ZW"},
+		{"ascii", []byte("hi"), "D1MG"},
+		{"five bytes fill eight chars", []byte{0xFF, 0xFF, 0xFF, 0xFF, 0xFF}, "ZZZZZZZZ"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, EncodeBytes(tt.data))
+		})
+	}
+}
+
+func TestEncodeDecodeBytes_RoundTrip(t *testing.T) {
+	for _, length := range []int{0, 1, 15, 16, 1024} {
+		t.Run(fmt.Sprintf("length %d", length), func(t *testing.T) {
+			data := make([]byte, length)
+			_, err := rand.Read(data)
+			require.NoError(t, err)
+
+			encoded := EncodeBytes(data)
+			assert.Len(t, encoded, (length*8+4)/5)
+
+			decoded, err := DecodeBytes(encoded)
+			require.NoError(t, err)
+			assert.Equal(t, data, decoded)
+		})
+	}
+}
+
+func TestDecodeBytes(t *testing.T) {
+	tests := []struct {
+		name    string
+		encoded string
+		want    []byte
+		wantErr bool
+	}{
+		{"empty", "", []byte{}, false},
+		{"uppercase", "D1MG", []byte("hi"), false},
+		{"lowercase", "d1mg", []byte("hi"), false},
+		{"embedded dashes", "D1-MG", []byte("hi"), false},
+		{"lowercase with dashes and spaces", "z-w ", []byte{0xFF}, false},
+		{"O corrected to 0", "OO", []byte{0x00}, false},
+		{"invalid character", "D1UG", nil, true},
+		{"invalid length", "D", nil, true},
+		{"non-zero padding bits", "ZZ", nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DecodeBytes(tt.encoded)
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
@@ -6,6 +6,17 @@ import "fmt"
 // x^10 + x^5 + x^4 + x^1 + 1 = 0x233
 const crc10Polynomial = 0x233
 
+// crcPolynomials maps a checksum length in Base32 characters to the CRC
+// polynomial of width 5*n bits used to compute it.
+var crcPolynomials = map[int]uint32{
+	1: 0x05,            // CRC-5-USB: x^5 + x^2 + 1
+	2: crc10Polynomial, // CRC-10
+	3: 0x4599,          // CRC-15-CAN: x^15 + x^14 + x^10 + x^8 + x^7 + x^4 + x^3 + 1
+}
+
+// MaxChecksumLength is the largest checksum length supported by the *N functions.
+const MaxChecksumLength = 3
+
 // CalculateChecksum computes a 2-character Base32 checksum using CRC-10.
 //
 // The checksum provides 99.9%+ error detection for:
@@ -30,42 +41,7 @@ const crc10Polynomial = 0x233
 //   - A 2-character Base32 checksum
 //   - An error if the input contains invalid characters
 func CalculateChecksum(data string) (string, error) {
-	if data == "" {
-		return "", fmt.Errorf("empty Base32 string")
-	}
-
-	crc := uint16(0)
-
-	// Process each character in the data
-	for i, char := range data {
-		value := base32CharToValue(char)
-		if value < 0 {
-			return "", fmt.Errorf("invalid Base32 character '%c' at position %d", char, i)
-		}
-
-		// XOR the value into the CRC (shifted left by 5 bits)
-		crc ^= uint16(value) << 5
-
-		// Process 5 bits (since Base32 = 5 bits per character)
-		for j := 0; j < 5; j++ {
-			if crc&0x200 != 0 { // Check if bit 9 is set
-				crc = (crc << 1) ^ crc10Polynomial
-			} else {
-				crc = crc << 1
-			}
-		}
-	}
-
-	// Keep only 10 bits
-	crc &= 0x3FF
-
-	// Convert 10 bits to 2 Base32 characters
-	// Upper 5 bits → first character
-	// Lower 5 bits → second character
-	char1 := base32ValueToChar(int((crc >> 5) & 0x1F))
-	char2 := base32ValueToChar(int(crc & 0x1F))
-
-	return string([]rune{char1, char2}), nil
+	return CalculateChecksumN(data, 2)
 }
 
 // ValidateChecksum verifies that the checksum in a string is correct.
@@ -171,3 +147,227 @@ func ExtractChecksum(input string) string {
 	}
 	return input[len(input)-2:]
 }
+
+// CalculateChecksumN computes an n-character Base32 checksum using a CRC of
+// width 5*n bits (CRC-5, CRC-10 or CRC-15).
+//
+// Longer checksums trade output length for stronger error detection. n=2 is the
+// CRC-10 checksum produced by CalculateChecksum.
+//
+// Example:
+//
+//	checksum, err := base32.CalculateChecksumN("ABC123", 3)  // 3 characters, nil
+//
+// Parameters:
+//   - data: The Base32 string to checksum (must contain only valid Base32 characters)
+//   - n: The checksum length in characters (1 to MaxChecksumLength)
+//
+// Returns:
+//   - An n-character Base32 checksum
+//   - An error if n is out of range or the input contains invalid characters
+func CalculateChecksumN(data string, n int) (string, error) {
+	polynomial, ok := crcPolynomials[n]
+	if !ok {
+		return "", fmt.Errorf("checksum length must be between 1 and %d, got %d", MaxChecksumLength, n)
+	}
+	if data == "" {
+		return "", fmt.Errorf("empty Base32 string")
+	}
+
+	width := 5 * n
+	topBit := uint32(1) << (width - 1)
+	mask := uint32(1)<<width - 1
+
+	crc := uint32(0)
+
+	// Process each character in the data
+	for i, char := range data {
+		value := base32CharToValue(char)
+		if value < 0 {
+			return "", fmt.Errorf("invalid Base32 character '%c' at position %d", char, i)
+		}
+
+		// XOR the value into the top 5 bits of the CRC
+		crc ^= uint32(value) << (width - 5)
+
+		// Process 5 bits (since Base32 = 5 bits per character)
+		for j := 0; j < 5; j++ {
+			if crc&topBit != 0 {
+				crc = (crc << 1) ^ polynomial
+			} else {
+				crc = crc << 1
+			}
+		}
+		crc &= mask
+	}
+
+	// Convert the CRC to n Base32 characters, most significant 5 bits first
+	checksum := make([]rune, n)
+	for i := n - 1; i >= 0; i-- {
+		checksum[i] = base32ValueToChar(int(crc & 0x1F))
+		crc >>= 5
+	}
+
+	return string(checksum), nil
+}
+
+// AppendChecksumN adds an n-character checksum to the end of the data.
+//
+// Example:
+//
+//	key, _ := base32.AppendChecksumN("ABCD1234EFGH", 3)  // "ABCD1234EFGH" + 3 chars
+//
+// Parameters:
+//   - data: The Base32 string to checksum (must contain only valid Base32 characters)
+//   - n: The checksum length in characters (1 to MaxChecksumLength)
+//
+// Returns:
+//   - The input string with an n-character checksum appended
+//   - An error if n is out of range or the input contains invalid characters
+func AppendChecksumN(data string, n int) (string, error) {
+	checksum, err := CalculateChecksumN(data, n)
+	if err != nil {
+		return "", err
+	}
+	return data + checksum, nil
+}
+
+// ValidateChecksumN verifies an n-character checksum appended with AppendChecksumN.
+//
+// Returns false if n is out of range, the input is too short, or it contains
+// invalid Base32 characters.
+//
+// Example:
+//
+//	valid := base32.ValidateChecksumN(key, 3)
+//
+// Parameters:
+//   - input: The string with checksum appended (minimum n+1 characters)
+//   - n: The checksum length in characters (1 to MaxChecksumLength)
+//
+// Returns:
+//   - true if the checksum is valid, false otherwise
+func ValidateChecksumN(input string, n int) bool {
+	if n < 1 || len(input) < n+1 {
+		return false
+	}
+
+	dataLen := len(input) - n
+	expectedChecksum, err := CalculateChecksumN(input[:dataLen], n)
+	if err != nil {
+		return false
+	}
+
+	// Compare checksums (case-insensitive)
+	return NormalizeBase32(input[dataLen:]) == NormalizeBase32(expectedChecksum)
+}
+
+// ChecksumAlgo computes the check characters appended to Base32 data. The
+// package provides ChecksumCRC10 (the default used by AppendChecksum) and
+// ChecksumLuhn; other algorithms can be plugged in by implementing the interface.
+type ChecksumAlgo interface {
+	// Checksum returns the check characters for data, or an error if data is
+	// empty or contains invalid Base32 characters.
+	Checksum(data string) (string, error)
+
+	// Length returns the number of check characters Checksum produces.
+	Length() int
+}
+
+var (
+	// ChecksumCRC10 is the 2-character CRC-10 checksum of CalculateChecksum.
+	ChecksumCRC10 ChecksumAlgo = crcChecksum{n: 2}
+
+	// ChecksumLuhn is the 1-character Luhn mod 32 checksum, the Luhn mod N
+	// algorithm over the Crockford Base32 alphabet. It detects all single
+	// character errors and most adjacent transpositions, for systems that
+	// expect a Luhn check character.
+	ChecksumLuhn ChecksumAlgo = luhnChecksum{}
+)
+
+// crcChecksum is the CRC checksum of CalculateChecksumN with n characters.
+type crcChecksum struct {
+	n int
+}
+
+func (c crcChecksum) Checksum(data string) (string, error) {
+	return CalculateChecksumN(data, c.n)
+}
+
+func (c crcChecksum) Length() int {
+	return c.n
+}
+
+// luhnChecksum implements the Luhn mod N algorithm with N = 32.
+type luhnChecksum struct{}
+
+func (luhnChecksum) Checksum(data string) (string, error) {
+	if data == "" {
+		return "", fmt.Errorf("empty Base32 string")
+	}
+
+	// Double every second value, starting from the rightmost character
+	factor := 2
+	sum := 0
+	for i := len(data) - 1; i >= 0; i-- {
+		value := base32CharToValue(rune(data[i]))
+		if value < 0 {
+			return "", fmt.Errorf("invalid Base32 character '%c' at position %d", data[i], i)
+		}
+
+		addend := factor * value
+		sum += addend/32 + addend%32
+		factor = 3 - factor
+	}
+
+	return string(base32ValueToChar((32 - sum%32) % 32)), nil
+}
+
+func (luhnChecksum) Length() int {
+	return 1
+}
+
+// AppendChecksumWith adds the checksum computed by algo to the end of the data.
+// AppendChecksumWith(data, ChecksumCRC10) is the same as AppendChecksum(data).
+//
+// Example:
+//
+//	code, err := base32.AppendChecksumWith("ABC123", base32.ChecksumLuhn)  // "ABC123" + 1 char
+func AppendChecksumWith(data string, algo ChecksumAlgo) (string, error) {
+	if algo == nil {
+		return "", fmt.Errorf("checksum algorithm is nil")
+	}
+	checksum, err := algo.Checksum(data)
+	if err != nil {
+		return "", err
+	}
+	return data + checksum, nil
+}
+
+// ValidateChecksumWith verifies a checksum appended with AppendChecksumWith
+// using the same algorithm.
+//
+// Returns false if algo is nil, the input is too short, or it contains invalid
+// Base32 characters.
+//
+// Example:
+//
+//	valid := base32.ValidateChecksumWith(code, base32.ChecksumLuhn)
+func ValidateChecksumWith(input string, algo ChecksumAlgo) bool {
+	if algo == nil {
+		return false
+	}
+	n := algo.Length()
+	if n < 1 || len(input) < n+1 {
+		return false
+	}
+
+	dataLen := len(input) - n
+	expectedChecksum, err := algo.Checksum(input[:dataLen])
+	if err != nil {
+		return false
+	}
+
+	// Compare checksums (case-insensitive)
+	return NormalizeBase32(input[dataLen:]) == NormalizeBase32(expectedChecksum)
+}
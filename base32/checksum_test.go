@@ -1,6 +1,8 @@
 package base32
 
 import (
+	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -320,3 +322,180 @@ func BenchmarkAppendChecksum(b *testing.B) {
 		_, _ = AppendChecksum(data)
 	}
 }
+
+func TestCalculateChecksumN(t *testing.T) {
+	for n := 1; n <= MaxChecksumLength; n++ {
+		t.Run(fmt.Sprintf("n=%d", n), func(t *testing.T) {
+			checksum, err := CalculateChecksumN("ABC123", n)
+			require.NoError(t, err)
+			assert.Len(t, checksum, n)
+			for _, char := range checksum {
+				assert.True(t, IsValidBase32Char(char))
+			}
+		})
+	}
+
+	t.Run("n=2 matches CalculateChecksum", func(t *testing.T) {
+		for _, data := range []string{"ABC123", "000000", "0123456789ABCDEFGHJKMNPQRSTVWXYZ"} {
+			expected, err := CalculateChecksum(data)
+			require.NoError(t, err)
+			got, err := CalculateChecksumN(data, 2)
+			require.NoError(t, err)
+			assert.Equal(t, expected, got)
+		}
+	})
+
+	t.Run("invalid length", func(t *testing.T) {
+		for _, n := range []int{-1, 0, MaxChecksumLength + 1} {
+			_, err := CalculateChecksumN("ABC123", n)
+			assert.Error(t, err)
+		}
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		_, err := CalculateChecksumN("", 1)
+		assert.Error(t, err)
+		_, err = CalculateChecksumN("A#C", 3)
+		assert.Error(t, err)
+	})
+}
+
+func TestAppendValidateChecksumN(t *testing.T) {
+	testData := []string{"ABC123", "000000", "HE110", "ZYXWV0", "ABCD1234EFGH"}
+
+	for n := 1; n <= MaxChecksumLength; n++ {
+		for _, data := range testData {
+			withChecksum, err := AppendChecksumN(data, n)
+			require.NoError(t, err)
+			assert.Len(t, withChecksum, len(data)+n)
+			assert.True(t, ValidateChecksumN(withChecksum, n), "n=%d data=%s", n, data)
+		}
+	}
+
+	_, err := AppendChecksumN("ABC123", 0)
+	assert.Error(t, err)
+
+	assert.False(t, ValidateChecksumN("ABC", 0))
+	assert.False(t, ValidateChecksumN("AB", 2))
+	assert.False(t, ValidateChecksumN("A#C123", 1))
+}
+
+func TestChecksumN_AdjacentDoubleErrors(t *testing.T) {
+	// A CRC detects every error burst no wider than itself. Two adjacent
+	// corrupted characters span 10 bits: CRC-10 (n=2) catches all of them,
+	// while CRC-5 (n=1) cannot.
+	data := "ABCD1234"
+	checksum1, err := CalculateChecksumN(data, 1)
+	require.NoError(t, err)
+	checksum2, err := CalculateChecksumN(data, 2)
+	require.NoError(t, err)
+
+	missedByN1 := 0
+	for i := 0; i+1 < len(data); i++ {
+		for a := 0; a < 32; a++ {
+			for b := 0; b < 32; b++ {
+				corrupted := []rune(data)
+				if base32CharToValue(corrupted[i]) == a || base32CharToValue(corrupted[i+1]) == b {
+					continue
+				}
+				corrupted[i] = base32ValueToChar(a)
+				corrupted[i+1] = base32ValueToChar(b)
+
+				if ValidateChecksumN(string(corrupted)+checksum1, 1) {
+					missedByN1++
+				}
+				assert.False(t, ValidateChecksumN(string(corrupted)+checksum2, 2),
+					"n=2 should detect double error %s", string(corrupted))
+			}
+		}
+	}
+
+	assert.Greater(t, missedByN1, 0, "n=1 should miss some adjacent double errors")
+}
+
+func TestChecksumAlgo(t *testing.T) {
+	algos := map[string]ChecksumAlgo{
+		"CRC10": ChecksumCRC10,
+		"Luhn":  ChecksumLuhn,
+	}
+	inputs := []string{"A", "ABC123", "000C1S", "0123456789ABCDEFGHJKMNPQRSTVWXYZ"}
+
+	for name, algo := range algos {
+		t.Run(name+" round trip", func(t *testing.T) {
+			for _, data := range inputs {
+				withChecksum, err := AppendChecksumWith(data, algo)
+				require.NoError(t, err)
+				assert.Len(t, withChecksum, len(data)+algo.Length())
+				assert.True(t, ValidateChecksumWith(withChecksum, algo), "checksum of %q should validate", data)
+
+				// Case-insensitive, like ValidateChecksum
+				assert.True(t, ValidateChecksumWith(strings.ToLower(withChecksum), algo))
+			}
+		})
+
+		t.Run(name+" detects single character errors", func(t *testing.T) {
+			withChecksum, err := AppendChecksumWith("ABC123", algo)
+			require.NoError(t, err)
+
+			for i := 0; i < len(withChecksum); i++ {
+				for _, replacement := range base32Alphabet {
+					if byte(replacement) == withChecksum[i] {
+						continue
+					}
+					corrupted := withChecksum[:i] + string(replacement) + withChecksum[i+1:]
+					assert.False(t, ValidateChecksumWith(corrupted, algo), "corruption %q should fail", corrupted)
+				}
+			}
+		})
+	}
+
+	t.Run("CRC10 matches the default checksum", func(t *testing.T) {
+		for _, data := range inputs {
+			expected, err := AppendChecksum(data)
+			require.NoError(t, err)
+			actual, err := AppendChecksumWith(data, ChecksumCRC10)
+			require.NoError(t, err)
+			assert.Equal(t, expected, actual)
+			assert.Equal(t, ValidateChecksum(expected), ValidateChecksumWith(expected, ChecksumCRC10))
+		}
+	})
+
+	t.Run("Luhn known values", func(t *testing.T) {
+		tests := map[string]string{
+			"1": "1Y", // 1*2 = 2, check = 32-2
+			"Z": "Z1", // 31*2 = 62 = 1*32+30, digit sum 31, check = 1
+		}
+		for data, expected := range tests {
+			actual, err := AppendChecksumWith(data, ChecksumLuhn)
+			require.NoError(t, err)
+			assert.Equal(t, expected, actual)
+		}
+	})
+
+	t.Run("checksum from one algorithm fails under the other", func(t *testing.T) {
+		for _, data := range []string{"ABC123", "000C1S", "HELL0W0RLD"} {
+			crc, err := AppendChecksumWith(data, ChecksumCRC10)
+			require.NoError(t, err)
+			luhn, err := AppendChecksumWith(data, ChecksumLuhn)
+			require.NoError(t, err)
+
+			assert.False(t, ValidateChecksumWith(crc, ChecksumLuhn), "CRC10 checksum %q should fail Luhn validation", crc)
+			assert.False(t, ValidateChecksumWith(luhn, ChecksumCRC10), "Luhn checksum %q should fail CRC10 validation", luhn)
+		}
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		for name, algo := range algos {
+			_, err := AppendChecksumWith("", algo)
+			assert.Error(t, err, name)
+			_, err = AppendChecksumWith("ABC-123", algo)
+			assert.Error(t, err, name)
+			assert.False(t, ValidateChecksumWith("A", algo), name)
+			assert.False(t, ValidateChecksumWith("AB-C1", algo), name)
+		}
+
+		_, err := AppendChecksumWith("ABC123", nil)
+		assert.Error(t, err)
+		assert.False(t, ValidateChecksumWith("ABC123XY", nil))
+	})
+}
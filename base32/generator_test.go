@@ -0,0 +1,140 @@
+package base32
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerator_NextAndDecode(t *testing.T) {
+	gen := NewGenerator("ORD-")
+
+	before := time.Now().Truncate(time.Millisecond)
+	id, err := gen.Next()
+	require.NoError(t, err)
+	after := time.Now()
+
+	assert.True(t, strings.HasPrefix(id, "ORD-"))
+	assert.Len(t, id, len("ORD-")+generatorBodyLength)
+
+	seq, ts, err := gen.Decode(id)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), seq)
+	assert.False(t, ts.Before(before))
+	assert.False(t, ts.After(after))
+
+	t.Run("lowercase and dashes", func(t *testing.T) {
+		body := strings.ToLower(id[len("ORD-"):])
+		decodedSeq, _, err := gen.Decode("ORD-" + body[:8] + "-" + body[8:])
+		require.NoError(t, err)
+		assert.Equal(t, seq, decodedSeq)
+	})
+}
+
+func TestGenerator_DecodeErrors(t *testing.T) {
+	gen := NewGenerator("ORD-")
+	id, err := gen.Next()
+	require.NoError(t, err)
+
+	corrupted := []byte(id)
+	last := len(corrupted) - 3
+	if corrupted[last] == '0' {
+		corrupted[last] = '1'
+	} else {
+		corrupted[last] = '0'
+	}
+
+	tests := []struct {
+		name string
+		id   string
+	}{
+		{"wrong prefix", "INV-" + id[len("ORD-"):]},
+		{"too short", id[:len(id)-1]},
+		{"bad checksum", string(corrupted)},
+		{"invalid characters", "ORD-" + strings.Repeat("U", generatorBodyLength)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := gen.Decode(tt.id)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestGenerator_ConcurrentUniqueness(t *testing.T) {
+	const (
+		goroutines = 20
+		perWorker  = 500
+		total      = goroutines * perWorker
+	)
+
+	gen := NewGenerator("ID")
+	ids := make(chan string, total)
+
+	var wg sync.WaitGroup
+	for i := 0; i < goroutines; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < perWorker; j++ {
+				id, err := gen.Next()
+				if !assert.NoError(t, err) {
+					return
+				}
+				ids <- id
+			}
+		}()
+	}
+	wg.Wait()
+	close(ids)
+
+	all := make([]string, 0, total)
+	seen := make(map[string]struct{}, total)
+	for id := range ids {
+		_, dup := seen[id]
+		require.False(t, dup, "duplicate ID %s", id)
+		seen[id] = struct{}{}
+		all = append(all, id)
+	}
+	require.Len(t, all, total)
+
+	// Lexicographic order must match generation order
+	sort.Strings(all)
+	var prevSeq uint64
+	var prevTS time.Time
+	for i, id := range all {
+		seq, ts, err := gen.Decode(id)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(i+1), seq)
+		assert.Greater(t, seq, prevSeq)
+		assert.False(t, ts.Before(prevTS))
+		prevSeq, prevTS = seq, ts
+	}
+}
+
+func TestGenerator_ClockMovesBackwards(t *testing.T) {
+	gen := NewGenerator("")
+	now := time.UnixMilli(1_700_000_000_000)
+	gen.now = func() time.Time { return now }
+
+	first, err := gen.Next()
+	require.NoError(t, err)
+
+	now = now.Add(-time.Hour)
+	second, err := gen.Next()
+	require.NoError(t, err)
+
+	assert.Less(t, first, second)
+
+	_, ts1, err := gen.Decode(first)
+	require.NoError(t, err)
+	_, ts2, err := gen.Decode(second)
+	require.NoError(t, err)
+	assert.Equal(t, ts1, ts2)
+}
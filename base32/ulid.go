@@ -0,0 +1,140 @@
+package base32
+
+import (
+	"crypto/rand"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+const (
+	// ulidTimestampLength holds a 48-bit Unix millisecond timestamp
+	ulidTimestampLength = 10
+	// ulidEntropyHalfLength holds 40 of the 80 random bits
+	ulidEntropyHalfLength = 8
+	// ulidLength is the encoded timestamp and 80 bits of entropy
+	ulidLength = ulidTimestampLength + 2*ulidEntropyHalfLength
+
+	// ulidMaxTimestamp is the largest 48-bit timestamp (year 10889)
+	ulidMaxTimestamp = 1<<48 - 1
+	// ulidEntropyHalfMax is the largest value of one 40-bit entropy half
+	ulidEntropyHalfMax = 1<<40 - 1
+)
+
+// ulidGenerator keeps the state needed to make ULIDs monotonic.
+type ulidGenerator struct {
+	mu      sync.Mutex
+	lastTS  uint64
+	entropy [2]uint64 // high and low 40 bits
+	now     func() time.Time
+	rand    io.Reader
+}
+
+// defaultULIDGenerator backs NewULID.
+var defaultULIDGenerator = &ulidGenerator{
+	now:  time.Now,
+	rand: rand.Reader,
+}
+
+// NewULID returns a 26-character, lexicographically sortable identifier in the
+// ULID layout:
+//
+//	[10 chars timestamp][16 chars entropy]
+//
+// The timestamp is Unix milliseconds and the entropy is 80 random bits from
+// crypto/rand. IDs are monotonic within the process: when several IDs are
+// generated in the same millisecond, the entropy of the previous ID is
+// incremented instead of drawn again, so every ID sorts strictly after the one
+// before it. If the wall clock moves backwards, the last timestamp is reused.
+//
+// NewULID is safe for concurrent use.
+//
+// Example:
+//
+//	id, err := base32.NewULID()   // "01J9Z3K8QH7X2V6T4N0M5R8C1D"
+//	ts, err := base32.ParseULID(id)
+//
+// Returns an error if random bytes cannot be read, or if more than 2^80 IDs are
+// requested in one millisecond.
+func NewULID() (string, error) {
+	return defaultULIDGenerator.next()
+}
+
+// next returns the next ULID of g.
+func (g *ulidGenerator) next() (string, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	ts := uint64(g.now().UnixMilli())
+	if ts < g.lastTS {
+		ts = g.lastTS
+	}
+	if ts > ulidMaxTimestamp {
+		return "", fmt.Errorf("timestamp %d exceeds the ULID range", ts)
+	}
+
+	if ts == g.lastTS {
+		if g.entropy[1] < ulidEntropyHalfMax {
+			g.entropy[1]++
+		} else if g.entropy[0] < ulidEntropyHalfMax {
+			g.entropy[0]++
+			g.entropy[1] = 0
+		} else {
+			return "", fmt.Errorf("ULID entropy exhausted for millisecond %d", ts)
+		}
+	} else {
+		var buf [10]byte
+		if _, err := io.ReadFull(g.rand, buf[:]); err != nil {
+			return "", fmt.Errorf("read entropy: %w", err)
+		}
+		g.entropy[0] = uint64(buf[0])<<32 | uint64(buf[1])<<24 | uint64(buf[2])<<16 | uint64(buf[3])<<8 | uint64(buf[4])
+		g.entropy[1] = uint64(buf[5])<<32 | uint64(buf[6])<<24 | uint64(buf[7])<<16 | uint64(buf[8])<<8 | uint64(buf[9])
+	}
+	g.lastTS = ts
+
+	encodedTS, err := EncodeBase32(ts, ulidTimestampLength)
+	if err != nil {
+		return "", fmt.Errorf("encode timestamp: %w", err)
+	}
+	encodedHigh, err := EncodeBase32(g.entropy[0], ulidEntropyHalfLength)
+	if err != nil {
+		return "", fmt.Errorf("encode entropy: %w", err)
+	}
+	encodedLow, err := EncodeBase32(g.entropy[1], ulidEntropyHalfLength)
+	if err != nil {
+		return "", fmt.Errorf("encode entropy: %w", err)
+	}
+
+	return encodedTS + encodedHigh + encodedLow, nil
+}
+
+// ParseULID returns the timestamp encoded in a ULID, with millisecond precision.
+//
+// The input is normalized with NormalizeBase32, so lowercase input and dashes
+// are accepted.
+//
+// Returns an error if the length is wrong, the input contains invalid
+// characters, or the timestamp exceeds 48 bits.
+func ParseULID(s string) (time.Time, error) {
+	id := NormalizeBase32(s)
+	if len(id) != ulidLength {
+		return time.Time{}, fmt.Errorf("invalid ULID length: expected %d characters, got %d", ulidLength, len(id))
+	}
+
+	millis, err := DecodeBase32(id[:ulidTimestampLength])
+	if err != nil {
+		return time.Time{}, fmt.Errorf("decode timestamp: %w", err)
+	}
+	if millis > ulidMaxTimestamp {
+		return time.Time{}, fmt.Errorf("ULID timestamp overflows 48 bits: %q", s)
+	}
+
+	for _, half := range []string{id[ulidTimestampLength : ulidTimestampLength+ulidEntropyHalfLength], id[ulidTimestampLength+ulidEntropyHalfLength:]} {
+		if _, err := DecodeBase32(half); err != nil {
+			return time.Time{}, fmt.Errorf("decode entropy: %w", err)
+		}
+	}
+
+	return time.UnixMilli(int64(millis)), nil
+}
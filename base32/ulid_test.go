@@ -0,0 +1,178 @@
+package base32
+
+import (
+	"bytes"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewULID_ParseULID(t *testing.T) {
+	before := time.Now().Truncate(time.Millisecond)
+	id, err := NewULID()
+	require.NoError(t, err)
+	after := time.Now()
+
+	assert.Len(t, id, ulidLength)
+	for _, c := range id {
+		assert.True(t, IsValidBase32Char(c), "unexpected character %q", c)
+	}
+
+	ts, err := ParseULID(id)
+	require.NoError(t, err)
+	assert.False(t, ts.Before(before))
+	assert.False(t, ts.After(after))
+
+	t.Run("lowercase and dashes", func(t *testing.T) {
+		lower := strings.ToLower(id)
+		decoded, err := ParseULID(lower[:10] + "-" + lower[10:])
+		require.NoError(t, err)
+		assert.Equal(t, ts, decoded)
+	})
+}
+
+func TestNewULID_StrictlyIncreasing(t *testing.T) {
+	const n = 100000
+
+	ids := make([]string, n)
+	start := time.Now().Truncate(time.Millisecond)
+	for i := range ids {
+		id, err := NewULID()
+		require.NoError(t, err)
+		ids[i] = id
+	}
+	end := time.Now()
+
+	for i := 1; i < n; i++ {
+		require.Less(t, ids[i-1], ids[i], "ID %d is not greater than its predecessor", i)
+	}
+
+	for _, id := range []string{ids[0], ids[n/2], ids[n-1]} {
+		ts, err := ParseULID(id)
+		require.NoError(t, err)
+		assert.False(t, ts.Before(start))
+		assert.False(t, ts.After(end))
+	}
+}
+
+func TestNewULID_Concurrent(t *testing.T) {
+	const workers, perWorker = 8, 1000
+
+	var mu sync.Mutex
+	seen := make(map[string]struct{}, workers*perWorker)
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < perWorker; i++ {
+				id, err := NewULID()
+				assert.NoError(t, err)
+				mu.Lock()
+				seen[id] = struct{}{}
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Len(t, seen, workers*perWorker)
+}
+
+func TestULIDGenerator_SameMillisecond(t *testing.T) {
+	now := time.UnixMilli(1700000000000)
+	gen := &ulidGenerator{
+		now:  func() time.Time { return now },
+		rand: bytes.NewReader(bytes.Repeat([]byte{0x00, 0x00, 0x00, 0x00, 0x00, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF}, 2)),
+	}
+
+	first, err := gen.next()
+	require.NoError(t, err)
+	assert.Equal(t, "00000000ZZZZZZZZ", first[ulidTimestampLength:])
+
+	// The low half carries into the high half
+	second, err := gen.next()
+	require.NoError(t, err)
+	assert.Equal(t, "0000000100000000", second[ulidTimestampLength:])
+	assert.Less(t, first, second)
+
+	// A new millisecond draws fresh entropy
+	now = now.Add(time.Millisecond)
+	third, err := gen.next()
+	require.NoError(t, err)
+	assert.Equal(t, "00000000ZZZZZZZZ", third[ulidTimestampLength:])
+	assert.Less(t, second, third)
+
+	ts, err := ParseULID(third)
+	require.NoError(t, err)
+	assert.Equal(t, now, ts)
+}
+
+func TestULIDGenerator_ClockMovesBackwards(t *testing.T) {
+	now := time.UnixMilli(1700000000000)
+	gen := &ulidGenerator{
+		now:  func() time.Time { return now },
+		rand: bytes.NewReader(make([]byte, 20)),
+	}
+
+	first, err := gen.next()
+	require.NoError(t, err)
+
+	now = now.Add(-time.Second)
+	second, err := gen.next()
+	require.NoError(t, err)
+	assert.Less(t, first, second)
+
+	ts, err := ParseULID(second)
+	require.NoError(t, err)
+	assert.Equal(t, time.UnixMilli(1700000000000), ts)
+}
+
+func TestULIDGenerator_EntropyExhausted(t *testing.T) {
+	gen := &ulidGenerator{
+		now:  func() time.Time { return time.UnixMilli(1700000000000) },
+		rand: bytes.NewReader(bytes.Repeat([]byte{0xFF}, 10)),
+	}
+
+	_, err := gen.next()
+	require.NoError(t, err)
+
+	_, err = gen.next()
+	assert.ErrorContains(t, err, "entropy exhausted")
+}
+
+func TestULIDGenerator_EntropyReadError(t *testing.T) {
+	gen := &ulidGenerator{
+		now:  time.Now,
+		rand: bytes.NewReader(nil),
+	}
+
+	_, err := gen.next()
+	assert.ErrorContains(t, err, "read entropy")
+}
+
+func TestParseULID_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		id   string
+		want string
+	}{
+		{"empty", "", "invalid ULID length"},
+		{"too short", "01J9Z3K8QH", "invalid ULID length"},
+		{"too long", "01J9Z3K8QH7X2V6T4N0M5R8C1DX", "invalid ULID length"},
+		{"invalid character", "01J9Z3K8QH7X2V6T4N0M5R8C1*", "decode entropy"},
+		{"timestamp overflow", "8ZZZZZZZZZ0000000000000000", "overflows 48 bits"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseULID(tt.id)
+			assert.ErrorContains(t, err, tt.want)
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package concurrent
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// Batch runs functions submitted one at a time, for work that is discovered
+// while it runs rather than known up front as with ExecuteConcurrently. It works
+// like a sync.WaitGroup that collects results: call Go for each piece of work,
+// then Wait once for all of them.
+//
+// All functions receive a shared context that is canceled when any of them
+// returns an error or panics. Functions submitted after that are not started.
+//
+// Example:
+//
+//	batch := concurrent.NewBatch[*Page](ctx, 8)
+//	for _, url := range urls {
+//	    batch.Go(url, func(ctx context.Context) (*Page, error) {
+//	        return fetch(ctx, url)
+//	    })
+//	}
+//	pages, err := batch.Wait()
+type Batch[T any] struct {
+	group *errgroup.Group
+	ctx   context.Context
+
+	mu        sync.Mutex
+	keys      []string
+	submitted map[string]bool
+	results   map[string]T
+}
+
+// NewBatch creates a Batch whose functions run with a context derived from ctx.
+// A positive limit caps how many functions run at once; Go then blocks until a
+// slot is free. Zero or negative means no limit.
+func NewBatch[T any](ctx context.Context, limit int) *Batch[T] {
+	group, groupCtx := errgroup.WithContext(ctx)
+	if limit > 0 {
+		group.SetLimit(limit)
+	}
+	return &Batch[T]{
+		group:     group,
+		ctx:       groupCtx,
+		submitted: make(map[string]bool),
+		results:   make(map[string]T),
+	}
+}
+
+// Go starts fn in a new goroutine, storing its result under key. A nil function,
+// a key that was already submitted or a recovered panic fails the batch like an
+// error returned by fn. Go must not be called after Wait.
+func (b *Batch[T]) Go(key string, fn Func[T]) {
+	b.mu.Lock()
+	duplicate := b.submitted[key]
+	if !duplicate {
+		b.submitted[key] = true
+		b.keys = append(b.keys, key)
+	}
+	b.mu.Unlock()
+
+	b.group.Go(func() (err error) {
+		switch {
+		case fn == nil:
+			return fmt.Errorf("nil function provided for key %q", key)
+		case duplicate:
+			return fmt.Errorf("duplicate key %q", key)
+		}
+
+		// Do not start work once the batch has failed
+		if err := b.ctx.Err(); err != nil {
+			return err
+		}
+
+		defer func() {
+			if r := recover(); r != nil {
+				err = panicError(key, r)
+			}
+		}()
+
+		value, err := fn(b.ctx)
+		if err != nil {
+			return err
+		}
+
+		b.mu.Lock()
+		b.results[key] = value
+		b.mu.Unlock()
+		return nil
+	})
+}
+
+// Wait blocks until all submitted functions have returned and returns their
+// results indexed by key, or the first error.
+func (b *Batch[T]) Wait() (map[string]T, error) {
+	if err := b.group.Wait(); err != nil {
+		return nil, err
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	results := make(map[string]T, len(b.results))
+	for key, value := range b.results {
+		results[key] = value
+	}
+	return results, nil
+}
+
+// Keys returns the submitted keys in submission order, e.g. to read the results
+// of Wait in the order the work was discovered.
+func (b *Batch[T]) Keys() []string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return append([]string(nil), b.keys...)
+}
@@ -0,0 +1,152 @@
+package concurrent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatch(t *testing.T) {
+	t.Run("collects results of functions submitted in a loop", func(t *testing.T) {
+		batch := NewBatch[int](context.Background(), 0)
+		for i := 0; i < 20; i++ {
+			batch.Go(fmt.Sprintf("item-%d", i), func(ctx context.Context) (int, error) {
+				return i * i, nil
+			})
+		}
+
+		results, err := batch.Wait()
+		require.NoError(t, err)
+		require.Len(t, results, 20)
+		for i := 0; i < 20; i++ {
+			assert.Equal(t, i*i, results[fmt.Sprintf("item-%d", i)])
+		}
+
+		keys := batch.Keys()
+		require.Len(t, keys, 20)
+		assert.Equal(t, "item-0", keys[0])
+		assert.Equal(t, "item-19", keys[19])
+	})
+
+	t.Run("first error cancels remaining work", func(t *testing.T) {
+		errBoom := errors.New("boom")
+		var canceled, started atomic.Int32
+
+		batch := NewBatch[string](context.Background(), 0)
+		for i := 0; i < 5; i++ {
+			batch.Go(fmt.Sprintf("slow-%d", i), func(ctx context.Context) (string, error) {
+				started.Add(1)
+				select {
+				case <-ctx.Done():
+					canceled.Add(1)
+					return "", ctx.Err()
+				case <-time.After(5 * time.Second):
+					return "slow", nil
+				}
+			})
+		}
+		batch.Go("failing", func(ctx context.Context) (string, error) {
+			return "", errBoom
+		})
+
+		start := time.Now()
+		results, err := batch.Wait()
+		assert.ErrorIs(t, err, errBoom)
+		assert.Nil(t, results)
+		assert.Less(t, time.Since(start), time.Second, "remaining work should be canceled")
+		assert.Equal(t, started.Load(), canceled.Load())
+	})
+
+	t.Run("work submitted after failure is not started", func(t *testing.T) {
+		errBoom := errors.New("boom")
+		batch := NewBatch[int](context.Background(), 0)
+
+		failed := make(chan struct{})
+		batch.Go("failing", func(ctx context.Context) (int, error) {
+			defer close(failed)
+			return 0, errBoom
+		})
+		<-failed
+		// Wait until the group has canceled the shared context
+		require.Eventually(t, func() bool { return batch.ctx.Err() != nil }, time.Second, time.Millisecond)
+
+		var ran atomic.Bool
+		batch.Go("late", func(ctx context.Context) (int, error) {
+			ran.Store(true)
+			return 1, nil
+		})
+
+		_, err := batch.Wait()
+		assert.ErrorIs(t, err, errBoom)
+		assert.False(t, ran.Load())
+	})
+
+	t.Run("limit caps concurrent functions", func(t *testing.T) {
+		var running, maxRunning atomic.Int32
+
+		batch := NewBatch[int](context.Background(), 2)
+		for i := 0; i < 8; i++ {
+			batch.Go(fmt.Sprintf("item-%d", i), func(ctx context.Context) (int, error) {
+				n := running.Add(1)
+				defer running.Add(-1)
+				for {
+					current := maxRunning.Load()
+					if n <= current || maxRunning.CompareAndSwap(current, n) {
+						break
+					}
+				}
+				time.Sleep(10 * time.Millisecond)
+				return i, nil
+			})
+		}
+
+		results, err := batch.Wait()
+		require.NoError(t, err)
+		assert.Len(t, results, 8)
+		assert.LessOrEqual(t, maxRunning.Load(), int32(2))
+	})
+
+	t.Run("panic is recovered as error", func(t *testing.T) {
+		batch := NewBatch[int](context.Background(), 0)
+		batch.Go("panicky", func(ctx context.Context) (int, error) {
+			panic("boom")
+		})
+
+		_, err := batch.Wait()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `panic in "panicky": boom`)
+	})
+
+	t.Run("nil function and duplicate key fail the batch", func(t *testing.T) {
+		batch := NewBatch[int](context.Background(), 0)
+		batch.Go("nil", nil)
+		_, err := batch.Wait()
+		assert.ErrorContains(t, err, `nil function provided for key "nil"`)
+
+		batch = NewBatch[int](context.Background(), 0)
+		fn := func(ctx context.Context) (int, error) { return 1, nil }
+		batch.Go("same", fn)
+		batch.Go("same", fn)
+		_, err = batch.Wait()
+		assert.ErrorContains(t, err, `duplicate key "same"`)
+	})
+
+	t.Run("parent cancellation stops the batch", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		batch := NewBatch[int](ctx, 0)
+		batch.Go("waiting", func(ctx context.Context) (int, error) {
+			<-ctx.Done()
+			return 0, ctx.Err()
+		})
+		cancel()
+
+		_, err := batch.Wait()
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
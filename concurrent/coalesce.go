@@ -0,0 +1,86 @@
+package concurrent
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// Coalescer deduplicates concurrent calls that share a key: while a call for a
+// key is in flight, further Do calls with that key wait for it and receive the
+// same result instead of running their own function. Once the call completes,
+// the next Do for the key runs the function again (results are not cached).
+//
+// The zero value is not usable; create one with NewCoalescer.
+type Coalescer[T any] struct {
+	mu    sync.Mutex
+	calls map[string]*coalescedCall[T]
+}
+
+// coalescedCall is an in-flight or completed call shared by all callers of a key.
+type coalescedCall[T any] struct {
+	done  chan struct{}
+	value T
+	err   error
+}
+
+// NewCoalescer creates a Coalescer for functions returning T.
+//
+// Example:
+//
+//	stats := concurrent.NewCoalescer[*Stats]()
+//	// Concurrent requests for the same dashboard share one query
+//	result, err := stats.Do(ctx, dashboardID, func(ctx context.Context) (*Stats, error) {
+//	    return repo.ComputeStats(ctx, dashboardID)
+//	})
+func NewCoalescer[T any]() *Coalescer[T] {
+	return &Coalescer[T]{calls: make(map[string]*coalescedCall[T])}
+}
+
+// Do runs fn for key unless a call for key is already in flight, in which case it
+// waits for that call and returns its result. fn runs with the context of the
+// caller that started it. A waiting caller whose own context is done stops
+// waiting and returns the context error; the shared call keeps running.
+// A panic in fn is recovered and returned as an error to every caller.
+func (c *Coalescer[T]) Do(ctx context.Context, key string, fn Func[T]) (T, error) {
+	var zero T
+	if fn == nil {
+		return zero, fmt.Errorf("nil function provided for key %q", key)
+	}
+
+	c.mu.Lock()
+	if call, ok := c.calls[key]; ok {
+		c.mu.Unlock()
+
+		select {
+		case <-call.done:
+			return call.value, call.err
+		case <-ctx.Done():
+			return zero, ctx.Err()
+		}
+	}
+
+	call := &coalescedCall[T]{done: make(chan struct{})}
+	c.calls[key] = call
+	c.mu.Unlock()
+
+	c.run(ctx, key, call, fn)
+	return call.value, call.err
+}
+
+// run executes fn, publishes its result and removes the call so the next Do for
+// key starts a fresh execution.
+func (c *Coalescer[T]) run(ctx context.Context, key string, call *coalescedCall[T], fn Func[T]) {
+	defer func() {
+		if r := recover(); r != nil {
+			call.err = panicError(key, r)
+		}
+
+		c.mu.Lock()
+		delete(c.calls, key)
+		c.mu.Unlock()
+		close(call.done)
+	}()
+
+	call.value, call.err = fn(ctx)
+}
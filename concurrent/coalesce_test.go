@@ -0,0 +1,134 @@
+package concurrent
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCoalescer_Do(t *testing.T) {
+	t.Run("concurrent calls share one execution", func(t *testing.T) {
+		c := NewCoalescer[string]()
+
+		var calls int32
+		release := make(chan struct{})
+		fn := func(ctx context.Context) (string, error) {
+			atomic.AddInt32(&calls, 1)
+			<-release
+			return "result", nil
+		}
+
+		const callers = 50
+		var ready, done sync.WaitGroup
+		ready.Add(callers)
+		done.Add(callers)
+
+		results := make([]string, callers)
+		errs := make([]error, callers)
+		for i := 0; i < callers; i++ {
+			go func(i int) {
+				defer done.Done()
+				ready.Done()
+				results[i], errs[i] = c.Do(context.Background(), "dashboard-1", fn)
+			}(i)
+		}
+
+		// Give every caller time to join the in-flight call before it completes
+		ready.Wait()
+		time.Sleep(50 * time.Millisecond)
+		close(release)
+		done.Wait()
+
+		assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+		for i := 0; i < callers; i++ {
+			require.NoError(t, errs[i])
+			assert.Equal(t, "result", results[i])
+		}
+	})
+
+	t.Run("different keys run independently", func(t *testing.T) {
+		c := NewCoalescer[int]()
+
+		var calls int32
+		fn := func(ctx context.Context) (int, error) {
+			return int(atomic.AddInt32(&calls, 1)), nil
+		}
+
+		_, err := c.Do(context.Background(), "a", fn)
+		require.NoError(t, err)
+		_, err = c.Do(context.Background(), "b", fn)
+		require.NoError(t, err)
+
+		// Completed calls are not cached
+		_, err = c.Do(context.Background(), "a", fn)
+		require.NoError(t, err)
+
+		assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+	})
+
+	t.Run("error is shared", func(t *testing.T) {
+		c := NewCoalescer[string]()
+		errBoom := errors.New("boom")
+
+		_, err := c.Do(context.Background(), "key", func(ctx context.Context) (string, error) {
+			return "", errBoom
+		})
+		assert.ErrorIs(t, err, errBoom)
+	})
+
+	t.Run("panic is recovered", func(t *testing.T) {
+		c := NewCoalescer[string]()
+
+		_, err := c.Do(context.Background(), "key", func(ctx context.Context) (string, error) {
+			panic("exploded")
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `panic in "key": exploded`)
+
+		// The key is released after a panic
+		result, err := c.Do(context.Background(), "key", func(ctx context.Context) (string, error) {
+			return "recovered", nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "recovered", result)
+	})
+
+	t.Run("waiting caller honors its context", func(t *testing.T) {
+		c := NewCoalescer[string]()
+
+		started := make(chan struct{})
+		release := make(chan struct{})
+		go func() {
+			_, _ = c.Do(context.Background(), "key", func(ctx context.Context) (string, error) {
+				close(started)
+				<-release
+				return "late", nil
+			})
+		}()
+		<-started
+		defer close(release)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		_, err := c.Do(ctx, "key", func(ctx context.Context) (string, error) {
+			t.Error("waiting caller should not run its own function")
+			return "", nil
+		})
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("nil function", func(t *testing.T) {
+		c := NewCoalescer[string]()
+
+		_, err := c.Do(context.Background(), "key", nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `nil function provided for key "key"`)
+	})
+}
@@ -48,11 +48,7 @@ func ExecuteConcurrently[T any](ctx context.Context, funcs map[string]Func[T]) (
 			defer wg.Done()
 			defer func() {
 				if r := recover(); r != nil {
-					if err, ok := r.(error); ok {
-						resultCh <- result{key: key, err: fmt.Errorf("panic in %q: %w", key, err)}
-					} else {
-						resultCh <- result{key: key, err: fmt.Errorf("panic in %q: %v", key, r)}
-					}
+					resultCh <- result{key: key, err: panicError(key, r)}
 					cancel()
 				}
 			}()
@@ -92,6 +88,69 @@ func ExecuteConcurrently[T any](ctx context.Context, funcs map[string]Func[T]) (
 	return results, nil
 }
 
+// ExecuteConcurrentlyAll executes multiple functions concurrently and runs every
+// function to completion, regardless of failures in the others.
+//
+// Unlike ExecuteConcurrently, an error never cancels the shared context, so all
+// functions get the chance to finish. Successful results are returned in the
+// first map and failures in the second, both indexed by the provided keys.
+// A nil function or a recovered panic is reported as an error for its key.
+func ExecuteConcurrentlyAll[T any](ctx context.Context, funcs map[string]Func[T]) (map[string]T, map[string]error) {
+	type result struct {
+		key   string
+		value T
+		err   error
+	}
+
+	var wg sync.WaitGroup
+	resultCh := make(chan result, len(funcs))
+
+	for key, fn := range funcs {
+		if fn == nil {
+			resultCh <- result{key: key, err: fmt.Errorf("nil function provided for key %q", key)}
+			continue
+		}
+
+		wg.Add(1)
+		go func(key string, fn Func[T]) {
+			defer wg.Done()
+			defer func() {
+				if r := recover(); r != nil {
+					resultCh <- result{key: key, err: panicError(key, r)}
+				}
+			}()
+
+			value, err := fn(ctx)
+			resultCh <- result{key: key, value: value, err: err}
+		}(key, fn)
+	}
+
+	go func() {
+		wg.Wait()
+		close(resultCh)
+	}()
+
+	results := make(map[string]T)
+	errs := make(map[string]error)
+	for res := range resultCh {
+		if res.err != nil {
+			errs[res.key] = res.err
+		} else {
+			results[res.key] = res.value
+		}
+	}
+
+	return results, errs
+}
+
+// panicError converts a recovered panic value into an error for the given key.
+func panicError(key string, r any) error {
+	if err, ok := r.(error); ok {
+		return fmt.Errorf("panic in %q: %w", key, err)
+	}
+	return fmt.Errorf("panic in %q: %v", key, r)
+}
+
 // isContextErr reports whether the error is a context cancellation or deadline error.
 func isContextErr(err error) bool {
 	return errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
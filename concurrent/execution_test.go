@@ -412,3 +412,67 @@ func TestExecuteConcurrentlyTyped(t *testing.T) {
 		assert.Equal(t, TestDTO{}, result)
 	})
 }
+
+func TestExecuteConcurrentlyAll(t *testing.T) {
+	t.Run("mixed success and failure", func(t *testing.T) {
+		errB := errors.New("b failed")
+		errD := errors.New("d failed")
+		funcs := map[string]Func[int]{
+			"a": func(ctx context.Context) (int, error) {
+				return 1, nil
+			},
+			"b": func(ctx context.Context) (int, error) {
+				return 0, errB
+			},
+			"c": func(ctx context.Context) (int, error) {
+				// Finishes after the failures to ensure it is not dropped
+				time.Sleep(50 * time.Millisecond)
+				return 3, ctx.Err()
+			},
+			"d": func(ctx context.Context) (int, error) {
+				return 0, errD
+			},
+		}
+
+		results, errs := ExecuteConcurrentlyAll(context.Background(), funcs)
+		assert.Equal(t, map[string]int{"a": 1, "c": 3}, results)
+		assert.Len(t, errs, 2)
+		assert.ErrorIs(t, errs["b"], errB)
+		assert.ErrorIs(t, errs["d"], errD)
+	})
+
+	t.Run("all functions succeed", func(t *testing.T) {
+		funcs := map[string]Func[string]{
+			"x": func(ctx context.Context) (string, error) { return "x", nil },
+			"y": func(ctx context.Context) (string, error) { return "y", nil },
+		}
+
+		results, errs := ExecuteConcurrentlyAll(context.Background(), funcs)
+		assert.Equal(t, map[string]string{"x": "x", "y": "y"}, results)
+		assert.Empty(t, errs)
+	})
+
+	t.Run("panic and nil function are reported per key", func(t *testing.T) {
+		funcs := map[string]Func[int]{
+			"ok": func(ctx context.Context) (int, error) {
+				return 42, nil
+			},
+			"panics": func(ctx context.Context) (int, error) {
+				panic("boom")
+			},
+			"nil": nil,
+		}
+
+		results, errs := ExecuteConcurrentlyAll(context.Background(), funcs)
+		assert.Equal(t, map[string]int{"ok": 42}, results)
+		assert.Len(t, errs, 2)
+		assert.Contains(t, errs["panics"].Error(), `panic in "panics": boom`)
+		assert.Contains(t, errs["nil"].Error(), `nil function provided for key "nil"`)
+	})
+
+	t.Run("empty function map", func(t *testing.T) {
+		results, errs := ExecuteConcurrentlyAll(context.Background(), map[string]Func[int]{})
+		assert.Empty(t, results)
+		assert.Empty(t, errs)
+	})
+}
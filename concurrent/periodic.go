@@ -0,0 +1,129 @@
+package concurrent
+
+import (
+	"context"
+	"math/rand/v2"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/jasoet/pkg/v2/logging"
+)
+
+// PeriodicOption configures RunPeriodic.
+type PeriodicOption func(*periodicConfig)
+
+type periodicConfig struct {
+	immediate     bool
+	jitter        time.Duration
+	skipIfRunning bool
+}
+
+// WithImmediateRun runs fn once as soon as RunPeriodic starts, instead of
+// waiting for the first interval to elapse.
+func WithImmediateRun() PeriodicOption {
+	return func(c *periodicConfig) { c.immediate = true }
+}
+
+// WithJitter adds a random delay in [0, max) to every interval, so replicas
+// started together do not run in lockstep. A non-positive max disables jitter.
+func WithJitter(max time.Duration) PeriodicOption {
+	return func(c *periodicConfig) { c.jitter = max }
+}
+
+// WithSkipIfRunning skips a tick while the previous run of fn has not returned,
+// so runs never overlap. Without it, a slow run overlaps with the next one.
+func WithSkipIfRunning() PeriodicOption {
+	return func(c *periodicConfig) { c.skipIfRunning = true }
+}
+
+// RunPeriodic calls fn every interval until ctx is done, then waits for runs in
+// flight to return. Each run gets its own goroutine and receives ctx, so a
+// canceled context also stops work in progress.
+//
+// Errors returned by fn are logged through the logging package and do not stop
+// the loop; a panic in fn is recovered and logged as an error. Context errors
+// after ctx is done are not logged. RunPeriodic panics if interval is not
+// positive, like time.NewTicker.
+//
+// Example:
+//
+//	go concurrent.RunPeriodic(ctx, time.Hour, func(ctx context.Context) error {
+//	    _, err := db.PurgeOlderThan[Event](ctx, pool, "created_at", time.Now().AddDate(0, 0, -30), 500)
+//	    return err
+//	}, concurrent.WithImmediateRun(), concurrent.WithSkipIfRunning())
+func RunPeriodic(ctx context.Context, interval time.Duration, fn func(context.Context) error, opts ...PeriodicOption) {
+	if interval <= 0 {
+		panic("concurrent: non-positive interval for RunPeriodic")
+	}
+
+	var cfg periodicConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	logger := logging.ContextLogger(ctx, "concurrent.periodic")
+
+	var (
+		wg      sync.WaitGroup
+		running atomic.Bool
+	)
+	defer wg.Wait()
+
+	run := func() {
+		if cfg.skipIfRunning && !running.CompareAndSwap(false, true) {
+			logger.Debug().Msg("Previous run still in flight, skipping tick")
+			return
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if cfg.skipIfRunning {
+				defer running.Store(false)
+			}
+
+			if err := runPeriodicFunc(ctx, fn); err != nil {
+				if ctx.Err() != nil && isContextErr(err) {
+					return
+				}
+				logger.Error().Err(err).Msg("Periodic run failed")
+			}
+		}()
+	}
+
+	if cfg.immediate {
+		run()
+	}
+
+	timer := time.NewTimer(nextPeriod(interval, cfg.jitter))
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+			run()
+			timer.Reset(nextPeriod(interval, cfg.jitter))
+		}
+	}
+}
+
+// runPeriodicFunc calls fn, turning a panic into an error.
+func runPeriodicFunc(ctx context.Context, fn func(context.Context) error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = panicError("periodic", r)
+		}
+	}()
+	return fn(ctx)
+}
+
+// nextPeriod returns interval plus a random jitter in [0, jitter).
+func nextPeriod(interval, jitter time.Duration) time.Duration {
+	if jitter <= 0 {
+		return interval
+	}
+	return interval + rand.N(jitter)
+}
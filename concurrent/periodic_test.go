@@ -0,0 +1,157 @@
+package concurrent
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// runPeriodicInBackground starts RunPeriodic and returns a channel closed when it returns.
+func runPeriodicInBackground(ctx context.Context, interval time.Duration, fn func(context.Context) error, opts ...PeriodicOption) <-chan struct{} {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		RunPeriodic(ctx, interval, fn, opts...)
+	}()
+	return done
+}
+
+func TestRunPeriodic(t *testing.T) {
+	t.Run("runs about once per interval", func(t *testing.T) {
+		var runs atomic.Int32
+		ctx, cancel := context.WithTimeout(context.Background(), 210*time.Millisecond)
+		defer cancel()
+
+		RunPeriodic(ctx, 20*time.Millisecond, func(ctx context.Context) error {
+			runs.Add(1)
+			return nil
+		})
+
+		// 10 ticks fit in the window; allow for scheduling delays
+		assert.GreaterOrEqual(t, runs.Load(), int32(7))
+		assert.LessOrEqual(t, runs.Load(), int32(10))
+	})
+
+	t.Run("immediate run happens before the first tick", func(t *testing.T) {
+		ran := make(chan struct{}, 1)
+		ctx, cancel := context.WithCancel(context.Background())
+		done := runPeriodicInBackground(ctx, time.Hour, func(ctx context.Context) error {
+			ran <- struct{}{}
+			return nil
+		}, WithImmediateRun())
+
+		select {
+		case <-ran:
+		case <-time.After(time.Second):
+			t.Fatal("expected an immediate run")
+		}
+		cancel()
+		<-done
+	})
+
+	t.Run("stops promptly on cancellation and waits for runs in flight", func(t *testing.T) {
+		var finished atomic.Bool
+		started := make(chan struct{})
+		ctx, cancel := context.WithCancel(context.Background())
+		done := runPeriodicInBackground(ctx, time.Hour, func(ctx context.Context) error {
+			close(started)
+			<-ctx.Done()
+			time.Sleep(20 * time.Millisecond)
+			finished.Store(true)
+			return ctx.Err()
+		}, WithImmediateRun())
+
+		<-started
+		start := time.Now()
+		cancel()
+
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Fatal("RunPeriodic did not stop after cancellation")
+		}
+		assert.Less(t, time.Since(start), 500*time.Millisecond)
+		assert.True(t, finished.Load(), "RunPeriodic should wait for the run in flight")
+	})
+
+	t.Run("errors and panics do not stop the loop", func(t *testing.T) {
+		var runs atomic.Int32
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		done := runPeriodicInBackground(ctx, 5*time.Millisecond, func(ctx context.Context) error {
+			switch runs.Add(1) {
+			case 1:
+				return errors.New("boom")
+			case 2:
+				panic("boom")
+			}
+			return nil
+		})
+
+		require.Eventually(t, func() bool { return runs.Load() >= 4 }, time.Second, time.Millisecond)
+		cancel()
+		<-done
+	})
+
+	t.Run("skip if running prevents overlapping runs", func(t *testing.T) {
+		var running, maxRunning, runs atomic.Int32
+		ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+		defer cancel()
+
+		RunPeriodic(ctx, 5*time.Millisecond, func(ctx context.Context) error {
+			runs.Add(1)
+			n := running.Add(1)
+			defer running.Add(-1)
+			if n > maxRunning.Load() {
+				maxRunning.Store(n)
+			}
+			time.Sleep(30 * time.Millisecond)
+			return nil
+		}, WithSkipIfRunning())
+
+		assert.Equal(t, int32(1), maxRunning.Load())
+		// A 30ms run on a 5ms interval leaves room for far fewer than 40 runs
+		assert.Less(t, runs.Load(), int32(10))
+	})
+
+	t.Run("runs overlap without skip if running", func(t *testing.T) {
+		var running, maxRunning atomic.Int32
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+
+		RunPeriodic(ctx, 5*time.Millisecond, func(ctx context.Context) error {
+			n := running.Add(1)
+			defer running.Add(-1)
+			for {
+				current := maxRunning.Load()
+				if n <= current || maxRunning.CompareAndSwap(current, n) {
+					break
+				}
+			}
+			time.Sleep(30 * time.Millisecond)
+			return nil
+		})
+
+		assert.Greater(t, maxRunning.Load(), int32(1))
+	})
+
+	t.Run("jitter delays ticks within bounds", func(t *testing.T) {
+		for i := 0; i < 100; i++ {
+			d := nextPeriod(10*time.Millisecond, 5*time.Millisecond)
+			assert.GreaterOrEqual(t, d, 10*time.Millisecond)
+			assert.Less(t, d, 15*time.Millisecond)
+		}
+		assert.Equal(t, 10*time.Millisecond, nextPeriod(10*time.Millisecond, 0))
+	})
+
+	t.Run("non-positive interval panics", func(t *testing.T) {
+		assert.Panics(t, func() {
+			RunPeriodic(context.Background(), 0, func(ctx context.Context) error { return nil })
+		})
+	})
+}
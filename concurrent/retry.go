@@ -0,0 +1,58 @@
+package concurrent
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// WithRetry wraps fn so that it is retried on error, up to attempts times in total.
+//
+// The wait between attempts grows exponentially, starting at backoff and doubling
+// after each failed attempt. A non-positive backoff retries immediately. Context
+// cancellation is checked between attempts; if the context is done while waiting,
+// the context error is returned wrapped together with the attempt count.
+//
+// If all attempts fail, the error from the last attempt is returned.
+// An attempts value below 1 is treated as a single attempt.
+func WithRetry[T any](fn Func[T], attempts int, backoff time.Duration) Func[T] {
+	if attempts < 1 {
+		attempts = 1
+	}
+
+	return func(ctx context.Context) (T, error) {
+		var zero T
+		var lastErr error
+		wait := backoff
+
+		for attempt := 1; attempt <= attempts; attempt++ {
+			value, err := fn(ctx)
+			if err == nil {
+				return value, nil
+			}
+			lastErr = err
+
+			if attempt == attempts {
+				break
+			}
+
+			if wait <= 0 {
+				if ctx.Err() != nil {
+					return zero, fmt.Errorf("retry canceled after %d attempts: %w", attempt, ctx.Err())
+				}
+				continue
+			}
+
+			timer := time.NewTimer(wait)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return zero, fmt.Errorf("retry canceled after %d attempts: %w", attempt, ctx.Err())
+			case <-timer.C:
+			}
+			wait *= 2
+		}
+
+		return zero, lastErr
+	}
+}
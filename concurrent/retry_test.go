@@ -0,0 +1,87 @@
+package concurrent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithRetry(t *testing.T) {
+	t.Run("fails twice then succeeds", func(t *testing.T) {
+		var calls int32
+		fn := func(ctx context.Context) (string, error) {
+			if atomic.AddInt32(&calls, 1) <= 2 {
+				return "", errors.New("transient error")
+			}
+			return "ok", nil
+		}
+
+		result, err := WithRetry(fn, 3, time.Millisecond)(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, "ok", result)
+		assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+	})
+
+	t.Run("always fails returns last error", func(t *testing.T) {
+		var calls int32
+		fn := func(ctx context.Context) (int, error) {
+			n := atomic.AddInt32(&calls, 1)
+			return 0, fmt.Errorf("failure %d", n)
+		}
+
+		result, err := WithRetry(fn, 4, time.Millisecond)(context.Background())
+		require.Error(t, err)
+		assert.Equal(t, "failure 4", err.Error())
+		assert.Equal(t, 0, result)
+		assert.Equal(t, int32(4), atomic.LoadInt32(&calls))
+	})
+
+	t.Run("attempts below one runs once", func(t *testing.T) {
+		var calls int32
+		fn := func(ctx context.Context) (int, error) {
+			atomic.AddInt32(&calls, 1)
+			return 0, errors.New("failure")
+		}
+
+		_, err := WithRetry(fn, 0, time.Millisecond)(context.Background())
+		assert.Error(t, err)
+		assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	})
+
+	t.Run("context canceled between attempts", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		var calls int32
+		fn := func(ctx context.Context) (int, error) {
+			atomic.AddInt32(&calls, 1)
+			cancel()
+			return 0, errors.New("failure")
+		}
+
+		_, err := WithRetry(fn, 5, time.Second)(ctx)
+		require.Error(t, err)
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	})
+
+	t.Run("composes with ExecuteConcurrently", func(t *testing.T) {
+		var calls int32
+		funcs := map[string]Func[int]{
+			"flaky": WithRetry(func(ctx context.Context) (int, error) {
+				if atomic.AddInt32(&calls, 1) == 1 {
+					return 0, errors.New("transient error")
+				}
+				return 7, nil
+			}, 2, time.Millisecond),
+		}
+
+		results, err := ExecuteConcurrently(context.Background(), funcs)
+		require.NoError(t, err)
+		assert.Equal(t, 7, results["flaky"])
+	})
+}
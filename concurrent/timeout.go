@@ -0,0 +1,76 @@
+package concurrent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// errFuncTimeout is the cancellation cause set by WithTimeout, used to tell its
+// own deadline apart from the parent context being done.
+var errFuncTimeout = errors.New("function timeout")
+
+// WithTimeout wraps fn so that each call is bounded by d, independently of the
+// other functions in a batch. fn receives a child context that expires after d.
+//
+// If d elapses first, the wrapper returns immediately with an error wrapping
+// context.DeadlineExceeded, even if fn ignores its context; fn then keeps running
+// in the background until it returns and its result is discarded. If the parent
+// context is done first, its error is returned. A panic in fn is re-raised in
+// the caller, so ExecuteConcurrently still recovers it as an error.
+//
+// A non-positive d returns fn unchanged.
+//
+// Example:
+//
+//	funcs := map[string]concurrent.Func[*Profile]{
+//	    "primary":  concurrent.WithTimeout(fetchPrimary, 200*time.Millisecond),
+//	    "fallback": concurrent.WithTimeout(fetchFallback, time.Second),
+//	}
+func WithTimeout[T any](fn Func[T], d time.Duration) Func[T] {
+	if d <= 0 {
+		return fn
+	}
+
+	return func(ctx context.Context) (T, error) {
+		var zero T
+
+		ctx, cancel := context.WithTimeoutCause(ctx, d, errFuncTimeout)
+		defer cancel()
+
+		type result struct {
+			value    T
+			err      error
+			panicked bool
+			panicVal any
+		}
+
+		// Buffered so fn can finish after the wrapper has returned
+		resultCh := make(chan result, 1)
+		go func() {
+			var res result
+			defer func() {
+				if r := recover(); r != nil {
+					res = result{panicked: true, panicVal: r}
+				}
+				resultCh <- res
+			}()
+
+			res.value, res.err = fn(ctx)
+		}()
+
+		select {
+		case res := <-resultCh:
+			if res.panicked {
+				panic(res.panicVal)
+			}
+			return res.value, res.err
+		case <-ctx.Done():
+			if errors.Is(context.Cause(ctx), errFuncTimeout) {
+				return zero, fmt.Errorf("function timed out after %s: %w", d, context.DeadlineExceeded)
+			}
+			return zero, ctx.Err()
+		}
+	}
+}
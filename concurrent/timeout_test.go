@@ -0,0 +1,95 @@
+package concurrent
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithTimeout(t *testing.T) {
+	t.Run("slow function times out while fast sibling succeeds", func(t *testing.T) {
+		slow := func(ctx context.Context) (string, error) {
+			time.Sleep(200 * time.Millisecond) // ignores ctx
+			return "slow", nil
+		}
+		fast := func(ctx context.Context) (string, error) {
+			return "fast", nil
+		}
+
+		start := time.Now()
+		results, errs := ExecuteConcurrentlyAll(context.Background(), map[string]Func[string]{
+			"slow": WithTimeout(slow, 20*time.Millisecond),
+			"fast": WithTimeout(fast, time.Second),
+		})
+
+		assert.Less(t, time.Since(start), 150*time.Millisecond, "timeout should not wait for the slow function")
+		assert.Equal(t, map[string]string{"fast": "fast"}, results)
+		require.Len(t, errs, 1)
+		assert.ErrorIs(t, errs["slow"], context.DeadlineExceeded)
+		assert.Contains(t, errs["slow"].Error(), "timed out after 20ms")
+	})
+
+	t.Run("function sees child context deadline", func(t *testing.T) {
+		fn := func(ctx context.Context) (int, error) {
+			deadline, ok := ctx.Deadline()
+			assert.True(t, ok)
+			assert.WithinDuration(t, time.Now().Add(time.Second), deadline, 100*time.Millisecond)
+			return 42, nil
+		}
+
+		value, err := WithTimeout(fn, time.Second)(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 42, value)
+	})
+
+	t.Run("returns function error", func(t *testing.T) {
+		errBoom := errors.New("boom")
+		fn := func(ctx context.Context) (int, error) {
+			return 0, errBoom
+		}
+
+		_, err := WithTimeout(fn, time.Second)(context.Background())
+		assert.ErrorIs(t, err, errBoom)
+	})
+
+	t.Run("parent cancellation is not reported as timeout", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		fn := func(ctx context.Context) (int, error) {
+			cancel()
+			time.Sleep(50 * time.Millisecond)
+			return 1, nil
+		}
+
+		_, err := WithTimeout(fn, time.Second)(ctx)
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.NotErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("panic is recovered by ExecuteConcurrently", func(t *testing.T) {
+		fn := func(ctx context.Context) (int, error) {
+			panic("boom")
+		}
+
+		_, err := ExecuteConcurrently(context.Background(), map[string]Func[int]{
+			"panicky": WithTimeout(fn, time.Second),
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `panic in "panicky": boom`)
+	})
+
+	t.Run("non-positive timeout leaves function unchanged", func(t *testing.T) {
+		fn := func(ctx context.Context) (int, error) {
+			_, ok := ctx.Deadline()
+			assert.False(t, ok)
+			return 7, nil
+		}
+
+		value, err := WithTimeout(fn, 0)(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 7, value)
+	})
+}
@@ -0,0 +1,89 @@
+package config
+
+import (
+	"encoding"
+	"reflect"
+	"strings"
+)
+
+// textUnmarshalerType is used to treat types such as time.Time as single values
+// rather than structs to recurse into.
+var textUnmarshalerType = reflect.TypeFor[encoding.TextUnmarshaler]()
+
+// EnvVars lists the environment variables that override the fields of T when it
+// is loaded with LoadString or LoadStringWithConfig using the same prefix, in
+// field declaration order. Use it to generate documentation or --help output.
+//
+// Names follow the loaders' mapping: the upper-cased prefix (default "ENV") and
+// the `mapstructure` key path joined with underscores. Fields without a tag use
+// the Go field name, fields tagged "-" and unexported fields are skipped, and
+// ",squash" fields are flattened into their parent. Nested structs and struct
+// pointers are listed field by field; maps, slices and types implementing
+// encoding.TextUnmarshaler (e.g. time.Time) are listed as a single variable.
+//
+// The loaders use viper's AutomaticEnv, which only overrides keys present in
+// the YAML, so a variable takes effect only if its key is in the loaded config.
+// For example, MYAPP_SERVER_PORT is ignored when the YAML has no server.port;
+// add the key with a default value to make it overridable.
+//
+// Example:
+//
+//	type AppConfig struct {
+//	    Name   string `mapstructure:"name"`
+//	    Server struct {
+//	        Port int `mapstructure:"port"`
+//	    } `mapstructure:"server"`
+//	}
+//
+//	config.EnvVars[AppConfig]("myapp") // [MYAPP_NAME MYAPP_SERVER_PORT]
+func EnvVars[T any](prefix string) []string {
+	if strings.TrimSpace(prefix) == "" {
+		prefix = "ENV"
+	}
+
+	var names []string
+	collectEnvVars(reflect.TypeFor[T](), strings.ToUpper(prefix), &names)
+	return names
+}
+
+// collectEnvVars appends the variable names for the fields of t under path.
+func collectEnvVars(t reflect.Type, path string, names *[]string) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct || reflect.PointerTo(t).Implements(textUnmarshalerType) {
+		*names = append(*names, path)
+		return
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, opts, _ := strings.Cut(field.Tag.Get("mapstructure"), ",")
+		if name == "-" || hasTagOption(opts, "remain") {
+			continue
+		}
+		if hasTagOption(opts, "squash") {
+			// Squashed structs may be unexported; their exported fields are promoted.
+			collectEnvVars(field.Type, path, names)
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		collectEnvVars(field.Type, path+"_"+strings.ToUpper(name), names)
+	}
+}
+
+// hasTagOption reports whether the comma-separated tag options contain option.
+func hasTagOption(opts, option string) bool {
+	for _, opt := range strings.Split(opts, ",") {
+		if opt == option {
+			return true
+		}
+	}
+	return false
+}
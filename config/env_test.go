@@ -0,0 +1,80 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type envTLSConfig struct {
+	CertFile string `mapstructure:"certFile"`
+	KeyFile  string `mapstructure:"key_file"`
+}
+
+type envCommonConfig struct {
+	LogLevel string `mapstructure:"logLevel"`
+}
+
+type envAppConfig struct {
+	envCommonConfig `mapstructure:",squash"`
+
+	Name   string `mapstructure:"name"`
+	Server struct {
+		Port    int           `mapstructure:"port"`
+		Timeout time.Duration `mapstructure:"timeout"`
+		TLS     *envTLSConfig `mapstructure:"tls"`
+	} `mapstructure:"server"`
+	Database struct {
+		Primary struct {
+			Host string `mapstructure:"host"`
+		} `mapstructure:"primary"`
+	} `mapstructure:"database"`
+	Tags      []string          `mapstructure:"tags"`
+	Labels    map[string]string `mapstructure:"labels"`
+	StartedAt time.Time         `mapstructure:"startedAt"`
+	Untagged  int
+	Ignored   string `mapstructure:"-"`
+	internal  string //nolint:unused
+}
+
+func TestEnvVars(t *testing.T) {
+	t.Run("nested struct", func(t *testing.T) {
+		assert.Equal(t, []string{
+			"MYAPP_LOGLEVEL",
+			"MYAPP_NAME",
+			"MYAPP_SERVER_PORT",
+			"MYAPP_SERVER_TIMEOUT",
+			"MYAPP_SERVER_TLS_CERTFILE",
+			"MYAPP_SERVER_TLS_KEY_FILE",
+			"MYAPP_DATABASE_PRIMARY_HOST",
+			"MYAPP_TAGS",
+			"MYAPP_LABELS",
+			"MYAPP_STARTEDAT",
+			"MYAPP_UNTAGGED",
+		}, EnvVars[envAppConfig]("myapp"))
+	})
+
+	t.Run("default prefix", func(t *testing.T) {
+		assert.Equal(t, []string{"ENV_NAME", "ENV_VERSION", "ENV_NESTED_VALUE"}, EnvVars[TestConfig](""))
+	})
+
+	t.Run("pointer type", func(t *testing.T) {
+		assert.Equal(t, []string{"APP_CERTFILE", "APP_KEY_FILE"}, EnvVars[*envTLSConfig]("APP"))
+	})
+
+	t.Run("names override LoadString", func(t *testing.T) {
+		names := EnvVars[envAppConfig]("ENVVARS_TEST")
+		require.Contains(t, names, "ENVVARS_TEST_DATABASE_PRIMARY_HOST")
+		t.Setenv("ENVVARS_TEST_DATABASE_PRIMARY_HOST", "db.internal")
+
+		cfg, err := LoadString[envAppConfig](`
+database:
+  primary:
+    host: localhost
+`, "ENVVARS_TEST")
+		require.NoError(t, err)
+		assert.Equal(t, "db.internal", cfg.Database.Primary.Host)
+	})
+}
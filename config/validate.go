@@ -0,0 +1,123 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// validate is shared by all callers; validator caches struct metadata and is safe
+// for concurrent use.
+var validate = newValidator()
+
+// newValidator creates a validator that reports fields by their config key
+// (mapstructure tag, then yaml tag, then Go field name).
+func newValidator() *validator.Validate {
+	v := validator.New(validator.WithRequiredStructEnabled())
+	v.RegisterTagNameFunc(func(field reflect.StructField) string {
+		for _, tag := range []string{"mapstructure", "yaml"} {
+			name, _, _ := strings.Cut(field.Tag.Get(tag), ",")
+			if name == "-" {
+				return ""
+			}
+			if name != "" {
+				return name
+			}
+		}
+		return field.Name
+	})
+	return v
+}
+
+// RegisterValidation adds a custom validation tag to the shared validator used
+// by Validate. Registration is not safe to run concurrently with validation, so
+// register tags during program start-up (e.g. in init), before loading or
+// validating any config.
+//
+// Example:
+//
+//	err := config.RegisterValidation("cron", func(fl validator.FieldLevel) bool {
+//	    _, err := cron.ParseStandard(fl.Field().String())
+//	    return err == nil
+//	})
+//
+//	type JobConfig struct {
+//	    Schedule string `mapstructure:"schedule" validate:"required,cron"`
+//	}
+func RegisterValidation(tag string, fn validator.Func) error {
+	if err := validate.RegisterValidation(tag, fn); err != nil {
+		return fmt.Errorf("config: failed to register validation %q: %w", tag, err)
+	}
+	return nil
+}
+
+// ValidationError is returned by Validate when one or more fields violate their
+// validate tags. Errors holds the individual field errors.
+type ValidationError struct {
+	Errors validator.ValidationErrors
+}
+
+// Error lists every failed field, e.g.
+// "config: validation failed: server.port failed 'max=65535' (got 70000); name is required".
+func (e *ValidationError) Error() string {
+	msgs := make([]string, 0, len(e.Errors))
+	for _, fe := range e.Errors {
+		msgs = append(msgs, formatFieldError(fe))
+	}
+	return "config: validation failed: " + strings.Join(msgs, "; ")
+}
+
+// Unwrap returns the underlying validator errors.
+func (e *ValidationError) Unwrap() error {
+	return e.Errors
+}
+
+// Validate checks a struct against its `validate` tags and reports all failing
+// fields at once. Use it for configs built in code, or after LoadString when the
+// struct carries validation rules.
+//
+// Example:
+//
+//	type ServerConfig struct {
+//	    Host string `mapstructure:"host" validate:"required,hostname"`
+//	    Port int    `mapstructure:"port" validate:"required,min=1,max=65535"`
+//	}
+//
+//	cfg := ServerConfig{Host: "localhost", Port: 8080}
+//	if err := config.Validate(cfg); err != nil {
+//	    return err // config: validation failed: port failed 'max=65535' (got 70000)
+//	}
+func Validate(v any) error {
+	err := validate.Struct(v)
+	if err == nil {
+		return nil
+	}
+
+	var fieldErrs validator.ValidationErrors
+	if errors.As(err, &fieldErrs) {
+		return &ValidationError{Errors: fieldErrs}
+	}
+	return fmt.Errorf("config: failed to validate %T: %w", v, err)
+}
+
+// formatFieldError renders one field error using the field's config key path.
+func formatFieldError(fe validator.FieldError) string {
+	// Drop the top-level struct name: "ServerConfig.tls.cert" -> "tls.cert"
+	field := fe.Namespace()
+	if _, rest, ok := strings.Cut(field, "."); ok {
+		field = rest
+	}
+
+	if fe.Tag() == "required" {
+		return field + " is required"
+	}
+
+	rule := fe.Tag()
+	if fe.Param() != "" {
+		rule += "=" + fe.Param()
+	}
+	return fmt.Sprintf("%s failed '%s' (got %v)", field, rule, fe.Value())
+}
@@ -0,0 +1,87 @@
+package config
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type validatedTLS struct {
+	CertFile string `yaml:"certFile" validate:"required"`
+}
+
+type validatedConfig struct {
+	Name    string       `mapstructure:"name" validate:"required"`
+	Port    int          `mapstructure:"port" validate:"min=1,max=65535"`
+	Mode    string       `mapstructure:"mode" validate:"oneof=dev prod"`
+	TLS     validatedTLS `mapstructure:"tls"`
+	Comment string
+}
+
+func TestValidate(t *testing.T) {
+	t.Run("valid struct", func(t *testing.T) {
+		cfg := validatedConfig{
+			Name: "api",
+			Port: 8080,
+			Mode: "prod",
+			TLS:  validatedTLS{CertFile: "/etc/tls/cert.pem"},
+		}
+		assert.NoError(t, Validate(cfg))
+		assert.NoError(t, Validate(&cfg))
+	})
+
+	t.Run("multiple violations", func(t *testing.T) {
+		cfg := validatedConfig{Port: 70000, Mode: "staging"}
+
+		err := Validate(cfg)
+		require.Error(t, err)
+		assert.Equal(t,
+			"config: validation failed: name is required; port failed 'max=65535' (got 70000); "+
+				"mode failed 'oneof=dev prod' (got staging); tls.certFile is required",
+			err.Error())
+
+		var validationErr *ValidationError
+		require.True(t, errors.As(err, &validationErr))
+		assert.Len(t, validationErr.Errors, 4)
+
+		var fieldErrs validator.ValidationErrors
+		assert.True(t, errors.As(err, &fieldErrs), "underlying validator errors should be reachable")
+	})
+
+	t.Run("loaded config", func(t *testing.T) {
+		cfg, err := LoadString[validatedConfig]("name: api\nport: 0\nmode: dev\ntls:\n  certFile: cert.pem\n")
+		require.NoError(t, err)
+
+		err = Validate(cfg)
+		assert.EqualError(t, err, "config: validation failed: port failed 'min=1' (got 0)")
+	})
+
+	t.Run("not a struct", func(t *testing.T) {
+		err := Validate("name")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "config: failed to validate string")
+	})
+}
+
+func TestRegisterValidation(t *testing.T) {
+	err := RegisterValidation("even", func(fl validator.FieldLevel) bool {
+		return fl.Field().Int()%2 == 0
+	})
+	require.NoError(t, err)
+
+	type workers struct {
+		Count int `mapstructure:"count" validate:"even"`
+	}
+
+	assert.NoError(t, Validate(workers{Count: 4}))
+	assert.EqualError(t, Validate(workers{Count: 3}), "config: validation failed: count failed 'even' (got 3)")
+
+	t.Run("empty tag", func(t *testing.T) {
+		err := RegisterValidation("", func(validator.FieldLevel) bool { return true })
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `config: failed to register validation ""`)
+	})
+}
@@ -0,0 +1,55 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"github.com/jasoet/pkg/v2/logging"
+)
+
+// DefaultBulkInsertBatchSize is the batch size BulkInsert uses when batchSize is zero.
+const DefaultBulkInsertBatchSize = 1000
+
+// BulkInsert inserts rows in batches of batchSize using a single transaction.
+// If any batch fails, every batch is rolled back and inserted is 0.
+// A batchSize of zero uses DefaultBulkInsertBatchSize.
+//
+// Example:
+//
+//	inserted, err := db.BulkInsert(ctx, pool, events, 500)
+//	if err != nil {
+//	    return fmt.Errorf("failed to ingest events: %w", err)
+//	}
+func BulkInsert[T any](ctx context.Context, db *gorm.DB, rows []T, batchSize int) (inserted int, err error) {
+	if batchSize < 0 {
+		return 0, fmt.Errorf("batch size must not be negative: %d", batchSize)
+	}
+	if batchSize == 0 {
+		batchSize = DefaultBulkInsertBatchSize
+	}
+	if len(rows) == 0 {
+		return 0, nil
+	}
+
+	logger := logging.ContextLogger(ctx, "db.bulk")
+	logger.Debug().Int("rows", len(rows)).Int("batch_size", batchSize).Msg("Starting bulk insert")
+
+	var affected int64
+	err = db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.CreateInBatches(&rows, batchSize)
+		if result.Error != nil {
+			return result.Error
+		}
+		affected = result.RowsAffected
+		return nil
+	})
+	if err != nil {
+		logger.Error().Err(err).Int("rows", len(rows)).Msg("Bulk insert failed, rolled back")
+		return 0, fmt.Errorf("failed to bulk insert: %w", err)
+	}
+
+	logger.Debug().Int64("inserted", affected).Msg("Bulk insert completed")
+	return int(affected), nil
+}
@@ -0,0 +1,28 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type bulkRow struct {
+	ID   int
+	Name string
+}
+
+func TestBulkInsert_NegativeBatchSize(t *testing.T) {
+	inserted, err := BulkInsert(context.Background(), nil, []bulkRow{{ID: 1}}, -1)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "batch size must not be negative")
+	assert.Equal(t, 0, inserted)
+}
+
+func TestBulkInsert_EmptyRows(t *testing.T) {
+	// Empty input returns before touching the database
+	inserted, err := BulkInsert[bulkRow](context.Background(), nil, nil, 500)
+	require.NoError(t, err)
+	assert.Equal(t, 0, inserted)
+}
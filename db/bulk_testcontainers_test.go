@@ -0,0 +1,76 @@
+//go:build integration
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBulkInsertWithTestcontainers(t *testing.T) {
+	container, config := setupPostgresContainer(t)
+	defer func() {
+		if err := container.Terminate(context.Background()); err != nil {
+			t.Logf("Failed to terminate container: %v", err)
+		}
+	}()
+
+	db, err := config.Pool()
+	require.NoError(t, err, "Failed to connect to database")
+
+	ctx := context.Background()
+
+	newCustomers := func(prefix string, n int) []Customer {
+		customers := make([]Customer, n)
+		for i := range customers {
+			customers[i] = Customer{
+				ID:        fmt.Sprintf("00000000-0000-4000-8000-%s%07d", prefix, i),
+				FirstName: "Bulk",
+				LastName:  fmt.Sprintf("Customer %d", i),
+				Email:     fmt.Sprintf("bulk-%s-%d@example.com", prefix, i),
+			}
+		}
+		return customers
+	}
+
+	countByPrefix := func(prefix string) int64 {
+		var count int64
+		err := db.Model(&Customer{}).Where("email LIKE ?", "bulk-"+prefix+"-%").Count(&count).Error
+		require.NoError(t, err, "Count query should succeed")
+		return count
+	}
+
+	t.Run("InsertsAllRowsInBatches", func(t *testing.T) {
+		customers := newCustomers("00001", 2500)
+
+		inserted, err := BulkInsert(ctx, db, customers, 500)
+		require.NoError(t, err)
+		assert.Equal(t, 2500, inserted)
+		assert.Equal(t, int64(2500), countByPrefix("00001"))
+	})
+
+	t.Run("DefaultBatchSize", func(t *testing.T) {
+		customers := newCustomers("00002", 10)
+
+		inserted, err := BulkInsert(ctx, db, customers, 0)
+		require.NoError(t, err)
+		assert.Equal(t, 10, inserted)
+		assert.Equal(t, int64(10), countByPrefix("00002"))
+	})
+
+	t.Run("DuplicateKeyRollsBackAllBatches", func(t *testing.T) {
+		customers := newCustomers("00003", 2500)
+		// Duplicate an email in a later batch so earlier batches have already been written
+		customers[2200].Email = customers[100].Email
+
+		inserted, err := BulkInsert(ctx, db, customers, 500)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to bulk insert")
+		assert.Equal(t, 0, inserted)
+		assert.Equal(t, int64(0), countByPrefix("00003"), "No rows should remain after rollback")
+	})
+}
@@ -0,0 +1,84 @@
+package db
+
+import (
+	"fmt"
+	"sync"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// captureDSN is never connected to; CaptureSQL only needs a dialector.
+const captureDSN = "host=localhost user=capture dbname=capture sslmode=disable"
+
+// CaptureSQL runs fn against a PostgreSQL-dialect GORM session in DryRun mode and
+// returns the SQL of every statement it issues, in order, with bound values
+// inlined (as gorm's ToSQL renders them). No database is contacted, so it is
+// meant for unit tests of query-building code.
+//
+// Queries return no rows. Scan, Row and Rows fail with gorm.ErrDryRunModeUnsupported
+// (use Find to read Raw queries), and explicit transactions (Begin, Transaction)
+// need a connection and are not supported. Statements captured before fn returns
+// an error are returned with that error.
+//
+// Example:
+//
+//	statements, err := db.CaptureSQL(func(tx *gorm.DB) error {
+//	    var users []User
+//	    return tx.Where("age > ?", 18).Find(&users).Error
+//	})
+//	// statements[0] == `SELECT * FROM "users" WHERE age > 18`
+func CaptureSQL(fn func(tx *gorm.DB) error) ([]string, error) {
+	gormDB, err := gorm.Open(postgres.Open(captureDSN), &gorm.Config{
+		DryRun:                 true,
+		DisableAutomaticPing:   true,
+		SkipDefaultTransaction: true,
+		Logger:                 logger.Default.LogMode(logger.Silent),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open dry-run session: %w", err)
+	}
+	if sqlDB, err := gormDB.DB(); err == nil {
+		defer func() { _ = sqlDB.Close() }()
+	}
+
+	var (
+		mu         sync.Mutex
+		statements []string
+	)
+	capture := func(tx *gorm.DB) {
+		if tx.Error != nil || tx.Statement.SQL.Len() == 0 {
+			return
+		}
+		sql := tx.Dialector.Explain(tx.Statement.SQL.String(), tx.Statement.Vars...)
+
+		mu.Lock()
+		statements = append(statements, sql)
+		mu.Unlock()
+	}
+
+	cb := gormDB.Callback()
+	processors := []struct {
+		name     string
+		register func(name string, fn func(*gorm.DB)) error
+	}{
+		{"create", cb.Create().After("*").Register},
+		{"query", cb.Query().After("*").Register},
+		{"update", cb.Update().After("*").Register},
+		{"delete", cb.Delete().After("*").Register},
+		{"row", cb.Row().After("*").Register},
+		{"raw", cb.Raw().After("*").Register},
+	}
+	for _, p := range processors {
+		if err := p.register("pkg:capture_sql", capture); err != nil {
+			return nil, fmt.Errorf("failed to register %s capture callback: %w", p.name, err)
+		}
+	}
+
+	if err := fn(gormDB); err != nil {
+		return statements, err
+	}
+
+	return statements, nil
+}
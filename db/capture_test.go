@@ -0,0 +1,88 @@
+package db
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+type captureUser struct {
+	ID   uint
+	Name string
+	Age  int
+}
+
+func TestCaptureSQL(t *testing.T) {
+	t.Run("where find", func(t *testing.T) {
+		statements, err := CaptureSQL(func(tx *gorm.DB) error {
+			var users []captureUser
+			return tx.Where("age > ?", 18).Where("name = ?", "alice").Find(&users).Error
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			`SELECT * FROM "capture_users" WHERE age > 18 AND name = 'alice'`,
+		}, statements)
+	})
+
+	t.Run("multiple statements in order", func(t *testing.T) {
+		statements, err := CaptureSQL(func(tx *gorm.DB) error {
+			if err := tx.Create(&captureUser{Name: "bob", Age: 30}).Error; err != nil {
+				return err
+			}
+			if err := tx.Model(&captureUser{}).Where("id = ?", 1).Update("age", 31).Error; err != nil {
+				return err
+			}
+			if err := tx.Where("id = ?", 1).Delete(&captureUser{}).Error; err != nil {
+				return err
+			}
+			var count int64
+			return tx.Model(&captureUser{}).Count(&count).Error
+		})
+		require.NoError(t, err)
+		require.Len(t, statements, 4)
+		assert.Contains(t, statements[0], `INSERT INTO "capture_users" ("name","age") VALUES ('bob',30)`)
+		assert.Equal(t, `UPDATE "capture_users" SET "age"=31 WHERE id = 1`, statements[1])
+		assert.Equal(t, `DELETE FROM "capture_users" WHERE id = 1`, statements[2])
+		assert.Equal(t, `SELECT count(*) FROM "capture_users"`, statements[3])
+	})
+
+	t.Run("raw and exec", func(t *testing.T) {
+		statements, err := CaptureSQL(func(tx *gorm.DB) error {
+			var names []string
+			if err := tx.Raw("SELECT name FROM users WHERE age > ?", 18).Find(&names).Error; err != nil {
+				return err
+			}
+			return tx.Exec("UPDATE users SET active = ? WHERE id = ?", false, 7).Error
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"SELECT name FROM users WHERE age > 18",
+			"UPDATE users SET active = false WHERE id = 7",
+		}, statements)
+	})
+
+	t.Run("scan is unsupported in dry run", func(t *testing.T) {
+		statements, err := CaptureSQL(func(tx *gorm.DB) error {
+			var names []string
+			return tx.Raw("SELECT name FROM users").Scan(&names).Error
+		})
+		assert.ErrorIs(t, err, gorm.ErrDryRunModeUnsupported)
+		assert.Equal(t, []string{"SELECT name FROM users"}, statements)
+	})
+
+	t.Run("callback error is returned with captured statements", func(t *testing.T) {
+		errBoom := errors.New("boom")
+		statements, err := CaptureSQL(func(tx *gorm.DB) error {
+			var users []captureUser
+			if err := tx.Find(&users).Error; err != nil {
+				return err
+			}
+			return errBoom
+		})
+		assert.ErrorIs(t, err, errBoom)
+		assert.Equal(t, []string{`SELECT * FROM "capture_users"`}, statements)
+	})
+}
@@ -6,7 +6,10 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"maps"
+	"net/url"
 	"os"
+	"slices"
 	"strings"
 	"time"
 
@@ -64,9 +67,33 @@ type ConnectionConfig struct {
 	// SSLMode configures TLS for the connection.
 	// PostgreSQL: "disable", "require", "verify-ca", "verify-full" (default: "require")
 	// MSSQL: "disable", "true", "false" (default: "require")
-	// MySQL: handled via DSN parameters (this field is ignored for MySQL)
+	// MySQL: "disable", "prefer", "require", "verify-ca", "verify-full"
+	// (default: no TLS, as with the driver)
 	SSLMode string `yaml:"sslMode" mapstructure:"sslMode"`
 
+	// SSLRootCert is the path to a PEM CA certificate used to verify the server,
+	// e.g. the CA bundle of a managed cloud database. Empty uses the system roots.
+	SSLRootCert string `yaml:"sslRootCert" mapstructure:"sslRootCert"`
+
+	// SSLCert and SSLKey are the paths to a PEM client certificate and key for
+	// mutual TLS. Both must be set together. Not supported for MSSQL.
+	SSLCert string `yaml:"sslCert" mapstructure:"sslCert"`
+	SSLKey  string `yaml:"sslKey" mapstructure:"sslKey"`
+
+	// Params holds extra driver parameters added to the DSN after the generated
+	// ones, in key order, e.g. {"application_name": "billing"} for PostgreSQL or
+	// {"charset": "utf8mb4"} for MySQL.
+	Params map[string]string `yaml:"params" mapstructure:"params"`
+
+	// DefaultQueryTimeout bounds each query whose context has no deadline.
+	// Zero means no default; queries run until the caller's context is done.
+	DefaultQueryTimeout time.Duration `yaml:"defaultQueryTimeout" mapstructure:"defaultQueryTimeout"`
+
+	// SlowQueryThreshold reports statements that run longer than this with a WARN
+	// log and, when tracing is enabled, a "db.slow_query" event on the statement span.
+	// Zero disables slow query reporting.
+	SlowQueryThreshold time.Duration `yaml:"slowQueryThreshold" mapstructure:"slowQueryThreshold"`
+
 	// GormLogLevel sets the GORM logger verbosity (1=Silent, 2=Error, 3=Warn, 4=Info).
 	// Default: 1 (Silent)
 	GormLogLevel int `yaml:"gormLogLevel" mapstructure:"gormLogLevel"`
@@ -124,6 +151,28 @@ func (c *ConnectionConfig) Validate() error {
 	if c.DBType == Postgresql && c.SSLMode != "" && !validPostgresSSL[c.SSLMode] {
 		return fmt.Errorf("invalid SSLMode %q for PostgreSQL", c.SSLMode)
 	}
+	validMysqlSSL := map[string]bool{
+		"disable": true, "prefer": true, "require": true,
+		"verify-ca": true, "verify-full": true,
+	}
+	if c.DBType == Mysql && c.SSLMode != "" && !validMysqlSSL[c.SSLMode] {
+		return fmt.Errorf("invalid SSLMode %q for MySQL", c.SSLMode)
+	}
+	if (c.SSLCert == "") != (c.SSLKey == "") {
+		return fmt.Errorf("SSLCert and SSLKey must be set together")
+	}
+	if c.DBType == MSSQL && c.SSLCert != "" {
+		return fmt.Errorf("client certificates (SSLCert/SSLKey) are not supported for MSSQL")
+	}
+	if c.SSLMode == "disable" && (c.SSLRootCert != "" || c.SSLCert != "") {
+		return fmt.Errorf("SSL certificates cannot be used with SSLMode %q", c.SSLMode)
+	}
+	if c.DefaultQueryTimeout < 0 {
+		return fmt.Errorf("DefaultQueryTimeout must not be negative, got %s", c.DefaultQueryTimeout)
+	}
+	if c.SlowQueryThreshold < 0 {
+		return fmt.Errorf("SlowQueryThreshold must not be negative, got %s", c.SlowQueryThreshold)
+	}
 	if c.MaxIdleConns > c.MaxOpenConns {
 		return fmt.Errorf("MaxIdleConns (%d) cannot exceed MaxOpenConns (%d)", c.MaxIdleConns, c.MaxOpenConns)
 	}
@@ -140,20 +189,61 @@ func (c *ConnectionConfig) dsn() string {
 	switch c.DBType {
 	case Mysql:
 		timeoutStr := fmt.Sprintf("%ds", timeout/time.Second)
-		return fmt.Sprintf("%s:%s@tcp(%s:%d)/%s?parseTime=true&timeout=%s",
+		dsn := fmt.Sprintf("%s:%s@tcp(%s:%d)/%s?parseTime=true&timeout=%s",
 			c.Username, c.Password, c.Host, c.Port, c.DBName, timeoutStr)
+		if tlsParam := c.mysqlTLSParam(); tlsParam != "" {
+			dsn += "&tls=" + url.QueryEscape(tlsParam)
+		}
+		return dsn + c.queryParams()
 	case Postgresql:
-		return fmt.Sprintf("user=%s password=%s host=%s port=%d dbname=%s sslmode=%s connect_timeout=%d",
+		dsn := fmt.Sprintf("user=%s password=%s host=%s port=%d dbname=%s sslmode=%s connect_timeout=%d",
 			c.Username, c.Password, c.Host, c.Port, c.DBName, sslMode, int(timeout.Seconds()))
+		files := []struct{ key, path string }{
+			{"sslrootcert", c.SSLRootCert},
+			{"sslcert", c.SSLCert},
+			{"sslkey", c.SSLKey},
+		}
+		for _, f := range files {
+			if f.path != "" {
+				dsn += " " + f.key + "=" + quotePostgresValue(f.path)
+			}
+		}
+		for _, key := range slices.Sorted(maps.Keys(c.Params)) {
+			dsn += " " + key + "=" + quotePostgresValue(c.Params[key])
+		}
+		return dsn
 	case MSSQL:
 		timeoutStr := fmt.Sprintf("%ds", timeout/time.Second)
-		return fmt.Sprintf("sqlserver://%s:%s@%s:%d?database=%s&connectTimeout=%s&encrypt=%s",
+		dsn := fmt.Sprintf("sqlserver://%s:%s@%s:%d?database=%s&connectTimeout=%s&encrypt=%s",
 			c.Username, c.Password, c.Host, c.Port, c.DBName, timeoutStr, sslMode)
+		if c.SSLRootCert != "" {
+			dsn += "&certificate=" + url.QueryEscape(c.SSLRootCert)
+		}
+		return dsn + c.queryParams()
 	default:
 		return ""
 	}
 }
 
+// queryParams encodes Params as URL query parameters, each prefixed with "&".
+func (c *ConnectionConfig) queryParams() string {
+	var b strings.Builder
+	for _, key := range slices.Sorted(maps.Keys(c.Params)) {
+		b.WriteString("&" + url.QueryEscape(key) + "=" + url.QueryEscape(c.Params[key]))
+	}
+	return b.String()
+}
+
+// quotePostgresValue quotes a keyword/value connection string value when it is
+// empty or contains spaces, quotes or backslashes.
+func quotePostgresValue(v string) string {
+	if v != "" && !strings.ContainsAny(v, ` '\`) {
+		return v
+	}
+	r := strings.NewReplacer(`\`, `\\`, `'`, `\'`)
+	return "'" + r.Replace(v) + "'"
+}
+
 // RedactedDsn returns the DSN with the password replaced by "***",
 // safe for use in logs and error messages.
 func (c *ConnectionConfig) RedactedDsn() string {
@@ -167,12 +257,19 @@ func (c *ConnectionConfig) RedactedDsn() string {
 // Pool creates a new GORM database connection pool.
 //
 // It validates the DSN, opens the connection, configures pool parameters,
-// pings to verify connectivity, and optionally installs OTel instrumentation.
+// pings to verify connectivity, installs the default query timeout and slow
+// query reporting if set, and optionally installs OTel instrumentation.
 func (c *ConnectionConfig) Pool() (*gorm.DB, error) {
 	if err := c.Validate(); err != nil {
 		return nil, fmt.Errorf("invalid config: %w", err)
 	}
 
+	if c.DBType == Mysql && c.mysqlCustomTLS() {
+		if err := c.registerMysqlTLS(); err != nil {
+			return nil, fmt.Errorf("failed to configure TLS: %w", err)
+		}
+	}
+
 	dsn := c.dsn()
 
 	var dialector gorm.Dialector
@@ -215,6 +312,21 @@ func (c *ConnectionConfig) Pool() (*gorm.DB, error) {
 		return nil, fmt.Errorf("failed to ping database at %s:%d/%s: %w", c.Host, c.Port, c.DBName, err)
 	}
 
+	if c.DefaultQueryTimeout > 0 {
+		if err := registerQueryTimeout(db, c.DefaultQueryTimeout); err != nil {
+			_ = sqlDB.Close()
+			return nil, err
+		}
+	}
+
+	// Registered before otelgorm so slow query events land on the statement span
+	if c.SlowQueryThreshold > 0 {
+		if err := registerSlowQueryLog(db, c.SlowQueryThreshold, c.OTelConfig); err != nil {
+			_ = sqlDB.Close()
+			return nil, err
+		}
+	}
+
 	// Install OpenTelemetry instrumentation if configured
 	if c.OTelConfig != nil && c.OTelConfig.IsTracingEnabled() {
 		// Configure otelgorm plugin options
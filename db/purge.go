@@ -0,0 +1,82 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/jasoet/pkg/v2/logging"
+)
+
+// DefaultPurgeBatchSize is the batch size PurgeOlderThan uses when batchSize is zero.
+const DefaultPurgeBatchSize = 1000
+
+// PurgeOlderThan permanently deletes rows of T whose timestamp column is before
+// cutoff, including rows that were already soft-deleted. Rows are deleted by
+// primary key in batches of batchSize, each in its own statement, so a large
+// purge does not hold locks for its whole duration. On error, rows deleted by
+// earlier batches stay deleted and are included in the returned count.
+// A batchSize of zero uses DefaultPurgeBatchSize.
+//
+// Example:
+//
+//	cutoff := time.Now().AddDate(0, 0, -cfg.RetentionDays)
+//	deleted, err := db.PurgeOlderThan[Event](ctx, pool, "created_at", cutoff, 500)
+func PurgeOlderThan[T any](ctx context.Context, db *gorm.DB, column string, cutoff time.Time, batchSize int) (deleted int64, err error) {
+	if batchSize < 0 {
+		return 0, fmt.Errorf("batch size must not be negative: %d", batchSize)
+	}
+	if batchSize == 0 {
+		batchSize = DefaultPurgeBatchSize
+	}
+	if column == "" {
+		return 0, errors.New("column is required")
+	}
+
+	stmt := &gorm.Statement{DB: db}
+	if err := stmt.Parse(new(T)); err != nil {
+		return 0, fmt.Errorf("failed to parse model: %w", err)
+	}
+	if len(stmt.Schema.PrimaryFieldDBNames) == 0 {
+		return 0, fmt.Errorf("model %s has no primary key", stmt.Schema.Name)
+	}
+
+	logger := logging.ContextLogger(ctx, "db.purge")
+	logger.Debug().Str("table", stmt.Schema.Table).Str("column", column).Time("cutoff", cutoff).
+		Int("batch_size", batchSize).Msg("Starting purge")
+
+	tx := db.WithContext(ctx)
+	for {
+		var batch []T
+		err := tx.Unscoped().Select(stmt.Schema.PrimaryFieldDBNames).
+			Where(clause.Lt{Column: clause.Column{Name: column}, Value: cutoff}).
+			Limit(batchSize).
+			Find(&batch).Error
+		if err != nil {
+			logger.Error().Err(err).Int64("deleted", deleted).Msg("Purge failed")
+			return deleted, fmt.Errorf("failed to select rows to purge: %w", err)
+		}
+		if len(batch) == 0 {
+			break
+		}
+
+		result := tx.Unscoped().Delete(&batch)
+		if result.Error != nil {
+			logger.Error().Err(result.Error).Int64("deleted", deleted).Msg("Purge failed")
+			return deleted, fmt.Errorf("failed to purge rows: %w", result.Error)
+		}
+		deleted += result.RowsAffected
+
+		// A batch that deletes nothing would otherwise be selected again forever
+		if len(batch) < batchSize || result.RowsAffected == 0 {
+			break
+		}
+	}
+
+	logger.Debug().Int64("deleted", deleted).Msg("Purge completed")
+	return deleted, nil
+}
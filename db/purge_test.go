@@ -0,0 +1,100 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+type purgeEvent struct {
+	ID         uint `gorm:"primaryKey"`
+	Name       string
+	OccurredAt time.Time
+	DeletedAt  gorm.DeletedAt
+}
+
+func newPurgeTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&purgeEvent{}))
+
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	// Each connection to :memory: is a separate database
+	sqlDB.SetMaxOpenConns(1)
+	t.Cleanup(func() { _ = sqlDB.Close() })
+	return db
+}
+
+func TestPurgeOlderThan(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	cutoff := now.AddDate(0, 0, -30)
+
+	t.Run("deletes only rows older than cutoff in batches", func(t *testing.T) {
+		db := newPurgeTestDB(t)
+
+		var events []purgeEvent
+		for i := 0; i < 25; i++ {
+			// Days 0..24 before the cutoff are old, days 1..10 after it are kept
+			events = append(events, purgeEvent{Name: fmt.Sprintf("old-%02d", i), OccurredAt: cutoff.AddDate(0, 0, -i).Add(-time.Second)})
+		}
+		for i := 1; i <= 10; i++ {
+			events = append(events, purgeEvent{Name: fmt.Sprintf("new-%02d", i), OccurredAt: cutoff.AddDate(0, 0, i)})
+		}
+		events = append(events, purgeEvent{Name: "at-cutoff", OccurredAt: cutoff})
+		require.NoError(t, db.Create(&events).Error)
+
+		// Soft-deleted old rows are purged as well
+		require.NoError(t, db.Where("name = ?", "old-00").Delete(&purgeEvent{}).Error)
+
+		deleted, err := PurgeOlderThan[purgeEvent](ctx, db, "occurred_at", cutoff, 10)
+		require.NoError(t, err)
+		assert.Equal(t, int64(25), deleted)
+
+		var remaining []purgeEvent
+		require.NoError(t, db.Unscoped().Order("occurred_at").Find(&remaining).Error)
+		require.Len(t, remaining, 11)
+		assert.Equal(t, "at-cutoff", remaining[0].Name)
+		for _, event := range remaining {
+			assert.False(t, event.OccurredAt.Before(cutoff), "row %s should remain", event.Name)
+		}
+	})
+
+	t.Run("nothing to purge", func(t *testing.T) {
+		db := newPurgeTestDB(t)
+		require.NoError(t, db.Create(&purgeEvent{Name: "recent", OccurredAt: now}).Error)
+
+		deleted, err := PurgeOlderThan[purgeEvent](ctx, db, "occurred_at", cutoff, 0)
+		require.NoError(t, err)
+		assert.Zero(t, deleted)
+
+		var count int64
+		require.NoError(t, db.Model(&purgeEvent{}).Count(&count).Error)
+		assert.Equal(t, int64(1), count)
+	})
+
+	t.Run("invalid arguments", func(t *testing.T) {
+		_, err := PurgeOlderThan[purgeEvent](ctx, nil, "occurred_at", cutoff, -1)
+		assert.ErrorContains(t, err, "batch size must not be negative")
+
+		_, err = PurgeOlderThan[purgeEvent](ctx, nil, "", cutoff, 10)
+		assert.ErrorContains(t, err, "column is required")
+	})
+
+	t.Run("unknown column", func(t *testing.T) {
+		db := newPurgeTestDB(t)
+		_, err := PurgeOlderThan[purgeEvent](ctx, db, "missing_at", cutoff, 10)
+		assert.ErrorContains(t, err, "failed to select rows to purge")
+	})
+}
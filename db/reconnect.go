@@ -0,0 +1,113 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/jasoet/pkg/v2/logging"
+)
+
+const (
+	// reconnectAttempts bounds how many new pools EnsureConnected tries to open.
+	reconnectAttempts = 3
+	// reconnectInitialBackoff is the wait before the second reconnect attempt; it
+	// doubles after each failed attempt.
+	reconnectInitialBackoff = 100 * time.Millisecond
+)
+
+// EnsureConnected pings db and returns it if the database is reachable. If the
+// ping fails, db is closed and a new pool is opened with Pool, retrying up to 3
+// times with a growing backoff (100ms, then 200ms). It is meant to be called
+// from the poll loop of long-running workers, so a network blip that left the
+// pool with dead connections does not fail every later query.
+//
+// The returned *gorm.DB replaces db, which must no longer be used once a new
+// pool was opened. A nil db is treated as disconnected. Each ping is bounded by
+// the connection Timeout; cancelling ctx stops the reconnect attempts. A ping
+// that fails because ctx is done says nothing about the database, so db is then
+// returned open and unchanged along with ctx.Err().
+//
+// Example:
+//
+//	for {
+//	    pool, err = cfg.EnsureConnected(ctx, pool)
+//	    if err != nil {
+//	        log.Error().Err(err).Msg("database unavailable, retrying next poll")
+//	    } else {
+//	        processBatch(ctx, pool)
+//	    }
+//	    time.Sleep(pollInterval)
+//	}
+func (c *ConnectionConfig) EnsureConnected(ctx context.Context, db *gorm.DB) (*gorm.DB, error) {
+	return c.ensureConnected(ctx, db, c.Pool)
+}
+
+// ensureConnected implements EnsureConnected with open creating the new pool.
+func (c *ConnectionConfig) ensureConnected(ctx context.Context, db *gorm.DB, open func() (*gorm.DB, error)) (*gorm.DB, error) {
+	logger := logging.ContextLogger(ctx, "db.reconnect")
+
+	if db != nil {
+		err := c.ping(ctx, db)
+		if err == nil {
+			return db, nil
+		}
+		// Closing a shared, healthy pool because the caller gave up would break
+		// every other holder of db
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return db, fmt.Errorf("database ping canceled: %w", ctxErr)
+		}
+		logger.Warn().Err(err).Str("host", c.Host).Str("database", c.DBName).Msg("Database ping failed, reconnecting")
+
+		if sqlDB, err := db.DB(); err == nil {
+			_ = sqlDB.Close()
+		}
+	}
+
+	wait := reconnectInitialBackoff
+	var lastErr error
+	for attempt := 1; attempt <= reconnectAttempts; attempt++ {
+		if attempt == 1 {
+			if err := ctx.Err(); err != nil {
+				return nil, fmt.Errorf("reconnect canceled: %w", err)
+			}
+		} else {
+			timer := time.NewTimer(wait)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return nil, fmt.Errorf("reconnect canceled after %d attempts: %w", attempt-1, ctx.Err())
+			case <-timer.C:
+			}
+			wait *= 2
+		}
+
+		newDB, err := open()
+		if err == nil {
+			logger.Info().Int("attempts", attempt).Str("host", c.Host).Str("database", c.DBName).Msg("Reconnected to database")
+			return newDB, nil
+		}
+		lastErr = err
+		logger.Warn().Err(err).Int("attempt", attempt).Msg("Database reconnect attempt failed")
+	}
+
+	logger.Error().Err(lastErr).Int("attempts", reconnectAttempts).Msg("Failed to reconnect to database")
+	return nil, fmt.Errorf("failed to reconnect after %d attempts: %w", reconnectAttempts, lastErr)
+}
+
+// ping checks the connection of db within the connection Timeout.
+func (c *ConnectionConfig) ping(ctx context.Context, db *gorm.DB) error {
+	sqlDB, err := db.DB()
+	if err != nil {
+		return fmt.Errorf("failed to get underlying sql.DB: %w", err)
+	}
+
+	pingCtx, cancel := context.WithTimeout(ctx, c.effectiveTimeout())
+	defer cancel()
+	if err := sqlDB.PingContext(pingCtx); err != nil {
+		return fmt.Errorf("failed to ping database: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,175 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func sqliteOpener(t *testing.T, opens *int) func() (*gorm.DB, error) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "reconnect.db")
+	return func() (*gorm.DB, error) {
+		*opens++
+		db, err := gorm.Open(sqlite.Open(path), &gorm.Config{
+			Logger: logger.Default.LogMode(logger.Silent),
+		})
+		if err != nil {
+			return nil, err
+		}
+		sqlDB, err := db.DB()
+		if err != nil {
+			return nil, err
+		}
+		t.Cleanup(func() { _ = sqlDB.Close() })
+		return db, sqlDB.Ping()
+	}
+}
+
+func TestEnsureConnected(t *testing.T) {
+	ctx := context.Background()
+	config := &ConnectionConfig{Host: "localhost", DBName: "test", Timeout: time.Second}
+
+	t.Run("healthy pool is returned unchanged", func(t *testing.T) {
+		var opens int
+		open := sqliteOpener(t, &opens)
+		db, err := open()
+		require.NoError(t, err)
+
+		got, err := config.ensureConnected(ctx, db, open)
+		require.NoError(t, err)
+		assert.Same(t, db, got)
+		assert.Equal(t, 1, opens, "no new pool should be opened")
+	})
+
+	t.Run("closed pool is detected and reopened", func(t *testing.T) {
+		var opens int
+		open := sqliteOpener(t, &opens)
+		db, err := open()
+		require.NoError(t, err)
+		require.NoError(t, db.Exec("CREATE TABLE items (id INTEGER PRIMARY KEY)").Error)
+		require.NoError(t, db.Exec("INSERT INTO items (id) VALUES (1)").Error)
+
+		sqlDB, err := db.DB()
+		require.NoError(t, err)
+		require.NoError(t, sqlDB.Close())
+
+		got, err := config.ensureConnected(ctx, db, open)
+		require.NoError(t, err)
+		assert.NotSame(t, db, got)
+		assert.Equal(t, 2, opens)
+
+		var count int64
+		require.NoError(t, got.Raw("SELECT COUNT(*) FROM items").Scan(&count).Error)
+		assert.Equal(t, int64(1), count)
+	})
+
+	t.Run("nil pool is opened", func(t *testing.T) {
+		var opens int
+		got, err := config.ensureConnected(ctx, nil, sqliteOpener(t, &opens))
+		require.NoError(t, err)
+		assert.NotNil(t, got)
+		assert.Equal(t, 1, opens)
+	})
+
+	t.Run("reconnect is bounded", func(t *testing.T) {
+		errDown := errors.New("database down")
+		var opens int
+		open := func() (*gorm.DB, error) {
+			opens++
+			return nil, errDown
+		}
+
+		start := time.Now()
+		got, err := config.ensureConnected(ctx, nil, open)
+		assert.Nil(t, got)
+		assert.ErrorIs(t, err, errDown)
+		assert.Contains(t, err.Error(), "failed to reconnect after 3 attempts")
+		assert.Equal(t, reconnectAttempts, opens)
+		assert.GreaterOrEqual(t, time.Since(start), reconnectInitialBackoff*3)
+	})
+
+	t.Run("cancellation stops reconnect attempts", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		var opens int
+		open := func() (*gorm.DB, error) {
+			opens++
+			cancel()
+			return nil, errors.New("database down")
+		}
+
+		_, err := config.ensureConnected(ctx, nil, open)
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Equal(t, 1, opens)
+	})
+
+	t.Run("canceled context does not close a healthy pool", func(t *testing.T) {
+		var opens int
+		open := sqliteOpener(t, &opens)
+		db, err := open()
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		got, err := config.ensureConnected(ctx, db, open)
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Same(t, db, got)
+		assert.Equal(t, 1, opens, "no new pool should be opened")
+		assert.NoError(t, db.Exec("SELECT 1").Error, "pool should still be open")
+	})
+
+	t.Run("canceled context does not open a pool", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		var opens int
+		got, err := config.ensureConnected(ctx, nil, sqliteOpener(t, &opens))
+		assert.Nil(t, got)
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Equal(t, 0, opens)
+	})
+}
+
+func TestConnectionConfig_EnsureConnected_Unreachable(t *testing.T) {
+	// Reserve a local port and close it so connections are refused immediately
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	port := ln.Addr().(*net.TCPAddr).Port
+	require.NoError(t, ln.Close())
+
+	config := &ConnectionConfig{
+		DBType:       Postgresql,
+		Host:         "127.0.0.1",
+		Port:         port,
+		Username:     "test",
+		Password:     "test",
+		DBName:       "test",
+		SSLMode:      "disable",
+		Timeout:      time.Second,
+		MaxIdleConns: 1,
+		MaxOpenConns: 2,
+	}
+
+	// A pool whose connections are gone is detected by the ping
+	var opens int
+	db, err := sqliteOpener(t, &opens)()
+	require.NoError(t, err)
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	require.NoError(t, sqlDB.Close())
+
+	got, err := config.EnsureConnected(context.Background(), db)
+	assert.Nil(t, got)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to reconnect")
+}
@@ -0,0 +1,116 @@
+package db
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"gorm.io/gorm"
+)
+
+// ErrConnectionNotRegistered is returned by Registry.Get for an unknown name.
+var ErrConnectionNotRegistered = errors.New("connection not registered")
+
+// Registry manages named connection pools for applications that talk to more
+// than one database. Pools are opened lazily on the first Get and cached.
+// A Registry is safe for concurrent use.
+//
+// Example:
+//
+//	registry := db.NewRegistry()
+//	_ = registry.Register("primary", primaryConfig)
+//	_ = registry.Register("analytics", analyticsConfig)
+//	defer registry.CloseAll()
+//
+//	analytics, err := registry.Get("analytics")
+type Registry struct {
+	mu      sync.Mutex
+	configs map[string]*ConnectionConfig
+	pools   map[string]*gorm.DB
+
+	// open creates the pool for a config; replaced in tests
+	open func(*ConnectionConfig) (*gorm.DB, error)
+}
+
+// NewRegistry creates an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		configs: make(map[string]*ConnectionConfig),
+		pools:   make(map[string]*gorm.DB),
+		open:    (*ConnectionConfig).Pool,
+	}
+}
+
+// Register adds a named connection config. The config is validated immediately,
+// but the pool is not opened until the first Get. Registering a name twice is an error.
+func (r *Registry) Register(name string, cfg *ConnectionConfig) error {
+	if name == "" {
+		return fmt.Errorf("connection name is required")
+	}
+	if cfg == nil {
+		return fmt.Errorf("connection config for %q is nil", name)
+	}
+	if err := cfg.Validate(); err != nil {
+		return fmt.Errorf("invalid config for connection %q: %w", name, err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.configs[name]; exists {
+		return fmt.Errorf("connection %q is already registered", name)
+	}
+	r.configs[name] = cfg
+	return nil
+}
+
+// Get returns the pool for name, opening it on first use. Later calls return the
+// same *gorm.DB. ErrConnectionNotRegistered is returned (wrapped) for unknown names.
+func (r *Registry) Get(name string) (*gorm.DB, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if pool, ok := r.pools[name]; ok {
+		return pool, nil
+	}
+
+	cfg, ok := r.configs[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrConnectionNotRegistered, name)
+	}
+
+	pool, err := r.open(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open connection %q: %w", name, err)
+	}
+	r.pools[name] = pool
+	return pool, nil
+}
+
+// CloseAll closes every opened pool and returns the joined close errors.
+// Configs stay registered, so a later Get opens a fresh pool.
+func (r *Registry) CloseAll() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	names := make([]string, 0, len(r.pools))
+	for name := range r.pools {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var errs []error
+	for _, name := range names {
+		sqlDB, err := r.pools[name].DB()
+		if err == nil {
+			err = sqlDB.Close()
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to close connection %q: %w", name, err))
+		}
+		delete(r.pools, name)
+	}
+
+	return errors.Join(errs...)
+}
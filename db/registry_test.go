@@ -0,0 +1,110 @@
+package db
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+func registryTestConfig(dbName string) *ConnectionConfig {
+	return &ConnectionConfig{
+		DBType:       Postgresql,
+		Host:         "127.0.0.1",
+		Port:         5432,
+		Username:     "user",
+		DBName:       dbName,
+		SSLMode:      "disable",
+		MaxIdleConns: 1,
+		MaxOpenConns: 2,
+	}
+}
+
+// newTestRegistry returns a Registry whose pools are created without connecting,
+// recording how many times each config was opened.
+func newTestRegistry() (*Registry, map[string]int) {
+	opened := make(map[string]int)
+	r := NewRegistry()
+	r.open = func(c *ConnectionConfig) (*gorm.DB, error) {
+		opened[c.DBName]++
+		return gorm.Open(postgres.Open(c.dsn()), &gorm.Config{DisableAutomaticPing: true})
+	}
+	return r, opened
+}
+
+func TestRegistry_GetCachesPools(t *testing.T) {
+	r, opened := newTestRegistry()
+	require.NoError(t, r.Register("primary", registryTestConfig("app")))
+	require.NoError(t, r.Register("analytics", registryTestConfig("analytics")))
+	assert.Empty(t, opened, "pools should open lazily")
+
+	primary, err := r.Get("primary")
+	require.NoError(t, err)
+	analytics, err := r.Get("analytics")
+	require.NoError(t, err)
+	assert.NotSame(t, primary, analytics)
+
+	again, err := r.Get("primary")
+	require.NoError(t, err)
+	assert.Same(t, primary, again)
+	assert.Equal(t, map[string]int{"app": 1, "analytics": 1}, opened)
+}
+
+func TestRegistry_CloseAll(t *testing.T) {
+	r, opened := newTestRegistry()
+	require.NoError(t, r.Register("primary", registryTestConfig("app")))
+	require.NoError(t, r.Register("analytics", registryTestConfig("analytics")))
+
+	primary, err := r.Get("primary")
+	require.NoError(t, err)
+	analytics, err := r.Get("analytics")
+	require.NoError(t, err)
+
+	require.NoError(t, r.CloseAll())
+
+	for _, pool := range []*gorm.DB{primary, analytics} {
+		sqlDB, err := pool.DB()
+		require.NoError(t, err)
+		assert.ErrorContains(t, sqlDB.Ping(), "sql: database is closed")
+	}
+
+	// Configs stay registered; the next Get opens a fresh pool
+	reopened, err := r.Get("primary")
+	require.NoError(t, err)
+	assert.NotSame(t, primary, reopened)
+	assert.Equal(t, 2, opened["app"])
+	require.NoError(t, r.CloseAll())
+}
+
+func TestRegistry_Errors(t *testing.T) {
+	r, _ := newTestRegistry()
+
+	err := r.Register("", registryTestConfig("app"))
+	assert.ErrorContains(t, err, "connection name is required")
+
+	err = r.Register("primary", nil)
+	assert.ErrorContains(t, err, `connection config for "primary" is nil`)
+
+	invalid := registryTestConfig("app")
+	invalid.Host = ""
+	err = r.Register("primary", invalid)
+	assert.ErrorContains(t, err, `invalid config for connection "primary": host is required`)
+
+	require.NoError(t, r.Register("primary", registryTestConfig("app")))
+	err = r.Register("primary", registryTestConfig("other"))
+	assert.ErrorContains(t, err, `connection "primary" is already registered`)
+
+	_, err = r.Get("missing")
+	assert.True(t, errors.Is(err, ErrConnectionNotRegistered))
+
+	r.open = func(*ConnectionConfig) (*gorm.DB, error) {
+		return nil, errors.New("connection refused")
+	}
+	_, err = r.Get("primary")
+	assert.ErrorContains(t, err, `failed to open connection "primary": connection refused`)
+
+	assert.NoError(t, r.CloseAll(), "closing a registry with no open pools is a no-op")
+}
@@ -0,0 +1,47 @@
+//go:build integration
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistryWithTestcontainers(t *testing.T) {
+	container, config := setupPostgresContainer(t)
+	defer func() {
+		if err := container.Terminate(context.Background()); err != nil {
+			t.Logf("Failed to terminate container: %v", err)
+		}
+	}()
+
+	analyticsConfig := *config
+	analyticsConfig.MaxOpenConns = 5
+
+	registry := NewRegistry()
+	require.NoError(t, registry.Register("primary", config))
+	require.NoError(t, registry.Register("analytics", &analyticsConfig))
+
+	primary, err := registry.Get("primary")
+	require.NoError(t, err)
+	analytics, err := registry.Get("analytics")
+	require.NoError(t, err)
+
+	var one int
+	require.NoError(t, primary.Raw("SELECT 1").Scan(&one).Error)
+	assert.Equal(t, 1, one)
+	require.NoError(t, analytics.Raw("SELECT 1").Scan(&one).Error)
+
+	cached, err := registry.Get("primary")
+	require.NoError(t, err)
+	assert.Same(t, primary, cached)
+
+	require.NoError(t, registry.CloseAll())
+
+	sqlDB, err := primary.DB()
+	require.NoError(t, err)
+	assert.Error(t, sqlDB.Ping(), "pool should be closed")
+}
@@ -0,0 +1,129 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/jasoet/pkg/v2/logging"
+)
+
+const (
+	// DefaultPageLimit is the page size Paginate uses when limit is not positive.
+	DefaultPageLimit = 20
+	// MaxPageLimit caps the page size accepted by Paginate.
+	MaxPageLimit = 100
+)
+
+// Repository provides generic CRUD and pagination for the model T, which must
+// be a GORM model with a primary key.
+//
+// Example:
+//
+//	users := db.NewRepository[User](pool)
+//	page, total, err := users.Paginate(ctx, 2, 50, "status = ?", "active")
+type Repository[T any] struct {
+	db *gorm.DB
+}
+
+// NewRepository creates a Repository for T backed by db.
+func NewRepository[T any](db *gorm.DB) *Repository[T] {
+	return &Repository[T]{db: db}
+}
+
+// Create inserts entity, filling in generated fields such as the primary key.
+func (r *Repository[T]) Create(ctx context.Context, entity *T) error {
+	if err := r.db.WithContext(ctx).Create(entity).Error; err != nil {
+		return fmt.Errorf("failed to create record: %w", err)
+	}
+	return nil
+}
+
+// FindByID returns the record with the given primary key. A missing record is
+// reported as an error wrapping gorm.ErrRecordNotFound.
+func (r *Repository[T]) FindByID(ctx context.Context, id any) (*T, error) {
+	var entity T
+	err := r.db.WithContext(ctx).
+		Where(clause.Eq{Column: clause.PrimaryColumn, Value: id}).
+		First(&entity).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to find record %v: %w", id, err)
+	}
+	return &entity, nil
+}
+
+// Update saves all fields of entity, including zero values. As with gorm's Save,
+// an entity with a zero primary key is inserted.
+func (r *Repository[T]) Update(ctx context.Context, entity *T) error {
+	if err := r.db.WithContext(ctx).Save(entity).Error; err != nil {
+		return fmt.Errorf("failed to update record: %w", err)
+	}
+	return nil
+}
+
+// Delete removes the record with the given primary key (a soft delete for models
+// with gorm.DeletedAt). Deleting a missing record is reported as an error
+// wrapping gorm.ErrRecordNotFound.
+func (r *Repository[T]) Delete(ctx context.Context, id any) error {
+	result := r.db.WithContext(ctx).
+		Where(clause.Eq{Column: clause.PrimaryColumn, Value: id}).
+		Delete(new(T))
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete record %v: %w", id, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("failed to delete record %v: %w", id, gorm.ErrRecordNotFound)
+	}
+	return nil
+}
+
+// Paginate returns one page of records matching conds, ordered by primary key,
+// together with the total number of matching records. conds take the same form
+// as gorm's Where, e.g. ("status = ?", "active") or a struct or map.
+//
+// page is 1-based; values below 1 are treated as 1. A limit below 1 uses
+// DefaultPageLimit and a limit above MaxPageLimit is capped. A page past the end
+// returns no items and the full total.
+func (r *Repository[T]) Paginate(ctx context.Context, page, limit int, conds ...any) (items []T, total int64, err error) {
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 {
+		limit = DefaultPageLimit
+	}
+	if limit > MaxPageLimit {
+		limit = MaxPageLimit
+	}
+
+	logger := logging.ContextLogger(ctx, "db.repository")
+
+	query := r.db.WithContext(ctx).Model(new(T))
+	if len(conds) > 0 {
+		query = query.Where(conds[0], conds[1:]...)
+	}
+	// A new session lets the count and the page query share the conditions
+	query = query.Session(&gorm.Session{})
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count records: %w", err)
+	}
+
+	items = []T{}
+	if total == 0 {
+		return items, 0, nil
+	}
+
+	err = query.
+		Order(clause.OrderByColumn{Column: clause.PrimaryColumn}).
+		Offset((page - 1) * limit).
+		Limit(limit).
+		Find(&items).Error
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to fetch page %d: %w", page, err)
+	}
+
+	logger.Debug().Int("page", page).Int("limit", limit).Int("items", len(items)).Int64("total", total).Msg("Fetched page")
+	return items, total, nil
+}
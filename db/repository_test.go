@@ -0,0 +1,172 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+type repoItem struct {
+	ID       uint `gorm:"primaryKey"`
+	Name     string
+	Category string
+}
+
+func newRepoTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&repoItem{}))
+
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	// Each connection to :memory: is a separate database
+	sqlDB.SetMaxOpenConns(1)
+	t.Cleanup(func() { _ = sqlDB.Close() })
+	return db
+}
+
+func seedRepoItems(t *testing.T, repo *Repository[repoItem], n int) {
+	t.Helper()
+	for i := 1; i <= n; i++ {
+		category := "even"
+		if i%2 == 1 {
+			category = "odd"
+		}
+		require.NoError(t, repo.Create(context.Background(), &repoItem{Name: fmt.Sprintf("item-%02d", i), Category: category}))
+	}
+}
+
+func itemNames(items []repoItem) []string {
+	names := make([]string, len(items))
+	for i, item := range items {
+		names[i] = item.Name
+	}
+	return names
+}
+
+func TestRepository_CRUD(t *testing.T) {
+	ctx := context.Background()
+	repo := NewRepository[repoItem](newRepoTestDB(t))
+
+	item := &repoItem{Name: "widget", Category: "tools"}
+	require.NoError(t, repo.Create(ctx, item))
+	require.NotZero(t, item.ID)
+
+	found, err := repo.FindByID(ctx, item.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "widget", found.Name)
+
+	found.Name = "gadget"
+	require.NoError(t, repo.Update(ctx, found))
+	found, err = repo.FindByID(ctx, item.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "gadget", found.Name)
+
+	require.NoError(t, repo.Delete(ctx, item.ID))
+	_, err = repo.FindByID(ctx, item.ID)
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+
+	err = repo.Delete(ctx, item.ID)
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+}
+
+func TestRepository_Paginate(t *testing.T) {
+	ctx := context.Background()
+	repo := NewRepository[repoItem](newRepoTestDB(t))
+	seedRepoItems(t, repo, 25)
+
+	tests := []struct {
+		name      string
+		page      int
+		limit     int
+		conds     []any
+		wantNames []string
+		wantTotal int64
+	}{
+		{
+			name:      "first page",
+			page:      1,
+			limit:     10,
+			wantNames: []string{"item-01", "item-02", "item-03", "item-04", "item-05", "item-06", "item-07", "item-08", "item-09", "item-10"},
+			wantTotal: 25,
+		},
+		{
+			name:      "partial last page",
+			page:      3,
+			limit:     10,
+			wantNames: []string{"item-21", "item-22", "item-23", "item-24", "item-25"},
+			wantTotal: 25,
+		},
+		{
+			name:      "page past the end",
+			page:      4,
+			limit:     10,
+			wantNames: []string{},
+			wantTotal: 25,
+		},
+		{
+			name:      "page below 1 is clamped",
+			page:      -3,
+			limit:     2,
+			wantNames: []string{"item-01", "item-02"},
+			wantTotal: 25,
+		},
+		{
+			name:      "with conditions",
+			page:      2,
+			limit:     3,
+			conds:     []any{"category = ?", "even"},
+			wantNames: []string{"item-08", "item-10", "item-12"},
+			wantTotal: 12,
+		},
+		{
+			name:      "struct condition",
+			page:      5,
+			limit:     3,
+			conds:     []any{&repoItem{Category: "odd"}},
+			wantNames: []string{"item-25"},
+			wantTotal: 13,
+		},
+		{
+			name:      "no matches",
+			page:      1,
+			limit:     10,
+			conds:     []any{"category = ?", "none"},
+			wantNames: []string{},
+			wantTotal: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			items, total, err := repo.Paginate(ctx, tt.page, tt.limit, tt.conds...)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantTotal, total)
+			assert.Equal(t, tt.wantNames, itemNames(items))
+		})
+	}
+}
+
+func TestRepository_PaginateLimitClamping(t *testing.T) {
+	ctx := context.Background()
+	repo := NewRepository[repoItem](newRepoTestDB(t))
+	seedRepoItems(t, repo, MaxPageLimit+5)
+
+	items, total, err := repo.Paginate(ctx, 1, 0)
+	require.NoError(t, err)
+	assert.Len(t, items, DefaultPageLimit)
+	assert.Equal(t, int64(MaxPageLimit+5), total)
+
+	items, _, err = repo.Paginate(ctx, 1, MaxPageLimit*10)
+	require.NoError(t, err)
+	assert.Len(t, items, MaxPageLimit)
+}
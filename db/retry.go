@@ -0,0 +1,109 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+
+	"github.com/jasoet/pkg/v2/logging"
+)
+
+const (
+	// txRetryInitialBackoff is the wait before the first retry of RunInTxWithRetry.
+	txRetryInitialBackoff = 50 * time.Millisecond
+	// txRetryMaxBackoff caps the wait between retries of RunInTxWithRetry.
+	txRetryMaxBackoff = time.Second
+)
+
+// RunInTxWithRetry runs fn in a transaction and, if the transaction fails with a
+// serialization failure or a deadlock, rolls it back and runs it again, up to
+// attempts times in total. Any other error is returned as is, after the first
+// attempt, so fn may return sentinel errors to abort.
+//
+// The whole transaction is retried, so fn must be safe to run more than once:
+// do all work through tx and avoid side effects outside the database. The wait
+// between attempts starts at 50ms and doubles up to 1s, with jitter so that
+// conflicting transactions do not retry in lockstep. Cancelling ctx stops the
+// retries.
+//
+// Retryable errors are detected from the driver error codes:
+//   - PostgreSQL: 40001 serialization_failure and 40P01 deadlock_detected
+//   - MySQL/MariaDB: 1213 ER_LOCK_DEADLOCK and 1205 ER_LOCK_WAIT_TIMEOUT
+//
+// Example:
+//
+//	err := db.RunInTxWithRetry(ctx, pool, 3, func(tx *gorm.DB) error {
+//	    if err := tx.Exec("SET TRANSACTION ISOLATION LEVEL SERIALIZABLE").Error; err != nil {
+//	        return err
+//	    }
+//	    return tx.Model(&Account{}).Where("id = ?", id).
+//	        Update("balance", gorm.Expr("balance - ?", amount)).Error
+//	})
+func RunInTxWithRetry(ctx context.Context, db *gorm.DB, attempts int, fn func(tx *gorm.DB) error) error {
+	if attempts < 1 {
+		return fmt.Errorf("attempts must be at least 1: %d", attempts)
+	}
+
+	logger := logging.ContextLogger(ctx, "db.tx_retry")
+	wait := txRetryInitialBackoff
+
+	for attempt := 1; ; attempt++ {
+		err := db.WithContext(ctx).Transaction(fn)
+		if err == nil {
+			if attempt > 1 {
+				logger.Debug().Int("attempts", attempt).Msg("Transaction committed after retry")
+			}
+			return nil
+		}
+		if !isRetryableTxError(err) {
+			return err
+		}
+		if attempt == attempts {
+			logger.Error().Err(err).Int("attempts", attempt).Msg("Transaction failed after all retries")
+			return fmt.Errorf("transaction failed after %d attempts: %w", attempt, err)
+		}
+
+		delay := wait/2 + rand.N(wait/2+1)
+		logger.Warn().Err(err).Int("attempt", attempt).Dur("backoff", delay).Msg("Retrying transaction")
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return fmt.Errorf("transaction retry canceled after %d attempts: %w", attempt, ctx.Err())
+		case <-timer.C:
+		}
+		wait = min(wait*2, txRetryMaxBackoff)
+	}
+}
+
+// isRetryableTxError reports whether err is a serialization failure or deadlock
+// reported by the PostgreSQL or MySQL driver.
+func isRetryableTxError(err error) bool {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case "40001", // serialization_failure
+			"40P01": // deadlock_detected
+			return true
+		}
+		return false
+	}
+
+	var mysqlErr *mysql.MySQLError
+	if errors.As(err, &mysqlErr) {
+		switch mysqlErr.Number {
+		case 1213, // ER_LOCK_DEADLOCK
+			1205: // ER_LOCK_WAIT_TIMEOUT
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,197 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// txRecorder is a database/sql driver that records transaction events and
+// answers Exec with queued errors.
+type txRecorder struct {
+	mu       sync.Mutex
+	events   []string
+	execErrs []error
+}
+
+func (r *txRecorder) record(event string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, event)
+}
+
+func (r *txRecorder) recorded() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]string(nil), r.events...)
+}
+
+func (r *txRecorder) Connect(context.Context) (driver.Conn, error) { return &txRecorderConn{r}, nil }
+func (r *txRecorder) Driver() driver.Driver                        { return nil }
+
+type txRecorderConn struct{ r *txRecorder }
+
+func (c *txRecorderConn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("prepare not supported")
+}
+func (c *txRecorderConn) Close() error { return nil }
+func (c *txRecorderConn) Begin() (driver.Tx, error) {
+	return c.BeginTx(context.Background(), driver.TxOptions{})
+}
+
+func (c *txRecorderConn) BeginTx(context.Context, driver.TxOptions) (driver.Tx, error) {
+	c.r.record("begin")
+	return &txRecorderTx{c.r}, nil
+}
+
+func (c *txRecorderConn) ExecContext(_ context.Context, query string, _ []driver.NamedValue) (driver.Result, error) {
+	c.r.record("exec")
+	c.r.mu.Lock()
+	defer c.r.mu.Unlock()
+	if len(c.r.execErrs) > 0 {
+		err := c.r.execErrs[0]
+		c.r.execErrs = c.r.execErrs[1:]
+		if err != nil {
+			return nil, err
+		}
+	}
+	return driver.RowsAffected(1), nil
+}
+
+type txRecorderTx struct{ r *txRecorder }
+
+func (t *txRecorderTx) Commit() error   { t.r.record("commit"); return nil }
+func (t *txRecorderTx) Rollback() error { t.r.record("rollback"); return nil }
+
+func newTxRecorderDB(t *testing.T, execErrs ...error) (*gorm.DB, *txRecorder) {
+	t.Helper()
+
+	recorder := &txRecorder{execErrs: execErrs}
+	sqlDB := sql.OpenDB(recorder)
+	t.Cleanup(func() { _ = sqlDB.Close() })
+
+	gormDB, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{
+		DisableAutomaticPing: true,
+		Logger:               logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(t, err)
+
+	return gormDB, recorder
+}
+
+func TestRunInTxWithRetry(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("retries serialization failure and commits", func(t *testing.T) {
+		gormDB, recorder := newTxRecorderDB(t, &pgconn.PgError{Code: "40001", Message: "could not serialize access"})
+
+		calls := 0
+		err := RunInTxWithRetry(ctx, gormDB, 3, func(tx *gorm.DB) error {
+			calls++
+			return tx.Exec("UPDATE accounts SET balance = balance - 1").Error
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 2, calls)
+		assert.Equal(t, []string{"begin", "exec", "rollback", "begin", "exec", "commit"}, recorder.recorded())
+	})
+
+	t.Run("retries mysql deadlock", func(t *testing.T) {
+		gormDB, recorder := newTxRecorderDB(t, &mysql.MySQLError{Number: 1213, Message: "Deadlock found"})
+
+		calls := 0
+		err := RunInTxWithRetry(ctx, gormDB, 2, func(tx *gorm.DB) error {
+			calls++
+			return tx.Exec("UPDATE accounts SET balance = balance - 1").Error
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 2, calls)
+		assert.Equal(t, "commit", recorder.recorded()[len(recorder.recorded())-1])
+	})
+
+	t.Run("does not retry other errors", func(t *testing.T) {
+		gormDB, recorder := newTxRecorderDB(t)
+		errAbort := errors.New("insufficient funds")
+
+		calls := 0
+		err := RunInTxWithRetry(ctx, gormDB, 3, func(tx *gorm.DB) error {
+			calls++
+			return errAbort
+		})
+		require.ErrorIs(t, err, errAbort)
+		assert.Equal(t, 1, calls)
+		assert.Equal(t, []string{"begin", "rollback"}, recorder.recorded())
+	})
+
+	t.Run("gives up after attempts", func(t *testing.T) {
+		deadlock := &pgconn.PgError{Code: "40P01", Message: "deadlock detected"}
+		gormDB, _ := newTxRecorderDB(t, deadlock, deadlock)
+
+		calls := 0
+		err := RunInTxWithRetry(ctx, gormDB, 2, func(tx *gorm.DB) error {
+			calls++
+			return tx.Exec("UPDATE accounts SET balance = balance - 1").Error
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "transaction failed after 2 attempts")
+		var pgErr *pgconn.PgError
+		require.ErrorAs(t, err, &pgErr)
+		assert.Equal(t, "40P01", pgErr.Code)
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("stops when context is canceled", func(t *testing.T) {
+		gormDB, _ := newTxRecorderDB(t)
+		ctx, cancel := context.WithCancel(context.Background())
+
+		calls := 0
+		err := RunInTxWithRetry(ctx, gormDB, 5, func(tx *gorm.DB) error {
+			calls++
+			cancel()
+			return fmt.Errorf("update: %w", &pgconn.PgError{Code: "40001"})
+		})
+		require.ErrorIs(t, err, context.Canceled)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("rejects attempts below one", func(t *testing.T) {
+		err := RunInTxWithRetry(ctx, nil, 0, func(tx *gorm.DB) error { return nil })
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "attempts must be at least 1")
+	})
+}
+
+func TestIsRetryableTxError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"postgres serialization failure", &pgconn.PgError{Code: "40001"}, true},
+		{"postgres deadlock", &pgconn.PgError{Code: "40P01"}, true},
+		{"postgres unique violation", &pgconn.PgError{Code: "23505"}, false},
+		{"mysql deadlock", &mysql.MySQLError{Number: 1213}, true},
+		{"mysql lock wait timeout", &mysql.MySQLError{Number: 1205}, true},
+		{"mysql duplicate entry", &mysql.MySQLError{Number: 1062}, false},
+		{"wrapped", fmt.Errorf("transfer: %w", &pgconn.PgError{Code: "40001"}), true},
+		{"plain error", errors.New("boom"), false},
+		{"timeout", context.DeadlineExceeded, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, isRetryableTxError(tt.err))
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+
+	pkgotel "github.com/jasoet/pkg/v2/otel"
+)
+
+// slowQueryStartKey stores the start time of a statement for slow-query detection.
+const slowQueryStartKey = "pkg:slow_query_start"
+
+// registerSlowQueryLog installs callbacks that time every statement and report
+// those exceeding threshold with a WARN log and, when a span is recording, a
+// "db.slow_query" span event.
+//
+// The callbacks are placed around each gorm:<operation> callback, so they must
+// be registered before the otelgorm plugin for the span event to land on the
+// statement span before it ends.
+func registerSlowQueryLog(db *gorm.DB, threshold time.Duration, otelConfig *pkgotel.Config) error {
+	type registerFunc func(name string, fn func(*gorm.DB)) error
+
+	cb := db.Callback()
+	processors := []struct {
+		name   string
+		before registerFunc
+		after  registerFunc
+	}{
+		{"create", cb.Create().Before("gorm:create").Register, cb.Create().After("gorm:create").Register},
+		{"query", cb.Query().Before("gorm:query").Register, cb.Query().After("gorm:query").Register},
+		{"update", cb.Update().Before("gorm:update").Register, cb.Update().After("gorm:update").Register},
+		{"delete", cb.Delete().Before("gorm:delete").Register, cb.Delete().After("gorm:delete").Register},
+		{"row", cb.Row().Before("gorm:row").Register, cb.Row().After("gorm:row").Register},
+		{"raw", cb.Raw().Before("gorm:raw").Register, cb.Raw().After("gorm:raw").Register},
+	}
+
+	after := slowQueryAfter(threshold, otelConfig)
+	for _, p := range processors {
+		if err := p.before("pkg:slow_query_before", slowQueryBefore); err != nil {
+			return fmt.Errorf("failed to register %s slow query callback: %w", p.name, err)
+		}
+		if err := p.after("pkg:slow_query_after", after); err != nil {
+			return fmt.Errorf("failed to register %s slow query callback: %w", p.name, err)
+		}
+	}
+
+	return nil
+}
+
+// slowQueryBefore records when the statement started.
+func slowQueryBefore(db *gorm.DB) {
+	db.InstanceSet(slowQueryStartKey, time.Now())
+}
+
+// slowQueryAfter returns a callback that reports the statement when it ran
+// longer than threshold. Only the SQL with placeholders is reported, never the
+// bound values.
+func slowQueryAfter(threshold time.Duration, otelConfig *pkgotel.Config) func(*gorm.DB) {
+	return func(db *gorm.DB) {
+		v, ok := db.InstanceGet(slowQueryStartKey)
+		if !ok {
+			return
+		}
+		start, ok := v.(time.Time)
+		if !ok {
+			return
+		}
+
+		elapsed := time.Since(start)
+		if elapsed <= threshold {
+			return
+		}
+
+		query := db.Statement.SQL.String()
+		ctx := db.Statement.Context
+		if ctx == nil {
+			ctx = context.Background()
+		}
+
+		span := trace.SpanFromContext(ctx)
+		if span.IsRecording() {
+			span.AddEvent("db.slow_query", trace.WithAttributes(
+				attribute.String("db.query.text", query),
+				attribute.Int64("db.query.duration_ms", elapsed.Milliseconds()),
+				attribute.Int64("db.query.threshold_ms", threshold.Milliseconds()),
+			))
+		}
+
+		logger := pkgotel.NewLogHelper(ctx, otelConfig, "github.com/jasoet/pkg/v2/db", "db.slowQuery")
+		logger.Warn("Slow query",
+			pkgotel.F("sql", query),
+			pkgotel.F("duration", elapsed.String()),
+			pkgotel.F("duration_ms", elapsed.Milliseconds()),
+			pkgotel.F("threshold", threshold.String()),
+			pkgotel.F("rows_affected", db.Statement.RowsAffected),
+		)
+	}
+}
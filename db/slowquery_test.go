@@ -0,0 +1,147 @@
+package db
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/opentelemetry-go-extra/otelgorm"
+	"go.opentelemetry.io/otel/attribute"
+	otellog "go.opentelemetry.io/otel/log"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	pkgotel "github.com/jasoet/pkg/v2/otel"
+)
+
+// recordingLogProcessor keeps emitted log records in memory.
+type recordingLogProcessor struct {
+	mu      sync.Mutex
+	records []sdklog.Record
+}
+
+func (p *recordingLogProcessor) Enabled(context.Context, sdklog.EnabledParameters) bool { return true }
+
+func (p *recordingLogProcessor) OnEmit(_ context.Context, record *sdklog.Record) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.records = append(p.records, record.Clone())
+	return nil
+}
+
+func (p *recordingLogProcessor) Shutdown(context.Context) error   { return nil }
+func (p *recordingLogProcessor) ForceFlush(context.Context) error { return nil }
+
+func (p *recordingLogProcessor) Records() []sdklog.Record {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]sdklog.Record(nil), p.records...)
+}
+
+// newSlowQueryTestDB returns a dry-run DB (statements are built but never sent)
+// whose query execution takes queryDuration, with slow query reporting and
+// otelgorm installed in the same order as Pool.
+func newSlowQueryTestDB(t *testing.T, threshold, queryDuration time.Duration) (*gorm.DB, *tracetest.SpanRecorder, *recordingLogProcessor) {
+	t.Helper()
+
+	db, err := gorm.Open(postgres.Open(registryTestConfig("app").dsn()), &gorm.Config{
+		DisableAutomaticPing: true,
+		DryRun:               true,
+	})
+	require.NoError(t, err)
+
+	query := db.Callback().Query().Get("gorm:query")
+	require.NoError(t, db.Callback().Query().Replace("gorm:query", func(db *gorm.DB) {
+		time.Sleep(queryDuration)
+		query(db)
+	}))
+
+	spans := tracetest.NewSpanRecorder()
+	logs := &recordingLogProcessor{}
+	otelConfig := &pkgotel.Config{
+		ServiceName:    "db-test",
+		TracerProvider: sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans)),
+		LoggerProvider: sdklog.NewLoggerProvider(sdklog.WithProcessor(logs)),
+	}
+
+	require.NoError(t, registerSlowQueryLog(db, threshold, otelConfig))
+	require.NoError(t, db.Use(otelgorm.NewPlugin(
+		otelgorm.WithTracerProvider(otelConfig.TracerProvider),
+		otelgorm.WithoutMetrics(),
+		otelgorm.WithDryRunTx(),
+	)))
+
+	return db, spans, logs
+}
+
+func slowQueryEvents(spans *tracetest.SpanRecorder) []sdktrace.Event {
+	var events []sdktrace.Event
+	for _, span := range spans.Ended() {
+		for _, event := range span.Events() {
+			if event.Name == "db.slow_query" {
+				events = append(events, event)
+			}
+		}
+	}
+	return events
+}
+
+func TestSlowQueryLog_FastQuery(t *testing.T) {
+	db, spans, logs := newSlowQueryTestDB(t, 200*time.Millisecond, 0)
+
+	var items []map[string]any
+	require.NoError(t, db.Table("items").Where("price > ?", 10).Find(&items).Error)
+
+	require.NotEmpty(t, spans.Ended(), "otelgorm should record the statement span")
+	assert.Empty(t, slowQueryEvents(spans))
+	assert.Empty(t, logs.Records())
+}
+
+func TestSlowQueryLog_SlowQuery(t *testing.T) {
+	db, spans, logs := newSlowQueryTestDB(t, 20*time.Millisecond, 50*time.Millisecond)
+
+	var items []map[string]any
+	require.NoError(t, db.Table("items").Where("price > ?", 10).Find(&items).Error)
+
+	events := slowQueryEvents(spans)
+	require.Len(t, events, 1, "slow query event should be on the statement span")
+	attrs := attribute.NewSet(events[0].Attributes...)
+	query, _ := attrs.Value("db.query.text")
+	assert.Contains(t, query.AsString(), `WHERE price > $1`)
+	duration, _ := attrs.Value("db.query.duration_ms")
+	assert.GreaterOrEqual(t, duration.AsInt64(), int64(50))
+	threshold, _ := attrs.Value("db.query.threshold_ms")
+	assert.Equal(t, int64(20), threshold.AsInt64())
+
+	records := logs.Records()
+	require.Len(t, records, 1)
+	assert.Equal(t, "Slow query", records[0].Body().AsString())
+	assert.Equal(t, "WARN", records[0].Severity().String())
+
+	fields := make(map[string]string)
+	records[0].WalkAttributes(func(kv otellog.KeyValue) bool {
+		fields[kv.Key] = kv.Value.String()
+		return true
+	})
+	assert.Contains(t, fields["sql"], `WHERE price > $1`)
+	assert.NotContains(t, fields["sql"], "10", "bound values must not be logged")
+	assert.Equal(t, "20ms", fields["threshold"])
+	elapsed, err := time.ParseDuration(fields["duration"])
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, elapsed, 50*time.Millisecond)
+}
+
+func TestValidate_NegativeSlowQueryThreshold(t *testing.T) {
+	config := registryTestConfig("app")
+	config.SlowQueryThreshold = -time.Second
+
+	err := config.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "SlowQueryThreshold must not be negative")
+}
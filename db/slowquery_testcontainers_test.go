@@ -0,0 +1,54 @@
+//go:build integration
+
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	pkgotel "github.com/jasoet/pkg/v2/otel"
+)
+
+func TestSlowQueryThresholdWithTestcontainers(t *testing.T) {
+	container, config := setupPostgresContainer(t)
+	defer func() {
+		if err := container.Terminate(context.Background()); err != nil {
+			t.Logf("Failed to terminate container: %v", err)
+		}
+	}()
+
+	spans := tracetest.NewSpanRecorder()
+	logs := &recordingLogProcessor{}
+	config.SlowQueryThreshold = 200 * time.Millisecond
+	config.OTelConfig = &pkgotel.Config{
+		ServiceName:    "db-test",
+		TracerProvider: sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans)),
+		LoggerProvider: sdklog.NewLoggerProvider(sdklog.WithProcessor(logs)),
+	}
+
+	db, err := config.Pool()
+	require.NoError(t, err, "Failed to connect to database")
+
+	t.Run("FastQueryNotReported", func(t *testing.T) {
+		require.NoError(t, db.Exec("SELECT 1").Error)
+		assert.Empty(t, slowQueryEvents(spans))
+		assert.Empty(t, logs.Records())
+	})
+
+	t.Run("SlowQueryReported", func(t *testing.T) {
+		require.NoError(t, db.Exec("SELECT pg_sleep(0.5)").Error)
+
+		events := slowQueryEvents(spans)
+		require.Len(t, events, 1)
+		records := logs.Records()
+		require.Len(t, records, 1)
+		assert.Equal(t, "Slow query", records[0].Body().AsString())
+	})
+}
@@ -0,0 +1,75 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// queryTimeoutCancelKey stores the cancel func of a statement's timeout context.
+const queryTimeoutCancelKey = "pkg:query_timeout_cancel"
+
+// registerQueryTimeout installs callbacks that bound every statement by timeout
+// unless the caller's context already carries a deadline.
+func registerQueryTimeout(db *gorm.DB, timeout time.Duration) error {
+	type registerFunc func(name string, fn func(*gorm.DB)) error
+
+	cb := db.Callback()
+	processors := []struct {
+		name   string
+		before registerFunc
+		after  registerFunc
+	}{
+		{"create", cb.Create().Before("*").Register, cb.Create().After("*").Register},
+		{"query", cb.Query().Before("*").Register, cb.Query().After("*").Register},
+		{"update", cb.Update().Before("*").Register, cb.Update().After("*").Register},
+		{"delete", cb.Delete().Before("*").Register, cb.Delete().After("*").Register},
+		{"raw", cb.Raw().Before("*").Register, cb.Raw().After("*").Register},
+		// Row callbacks return *sql.Rows that are read after the callback chain
+		// ends, so the context is left to expire instead of being cancelled early.
+		{"row", cb.Row().Before("*").Register, nil},
+	}
+
+	for _, p := range processors {
+		if err := p.before("pkg:query_timeout_before", queryTimeoutBefore(timeout)); err != nil {
+			return fmt.Errorf("failed to register %s timeout callback: %w", p.name, err)
+		}
+		if p.after == nil {
+			continue
+		}
+		if err := p.after("pkg:query_timeout_after", queryTimeoutAfter); err != nil {
+			return fmt.Errorf("failed to register %s timeout callback: %w", p.name, err)
+		}
+	}
+
+	return nil
+}
+
+// queryTimeoutBefore returns a callback that wraps the statement context with
+// timeout when it has no deadline.
+func queryTimeoutBefore(timeout time.Duration) func(*gorm.DB) {
+	return func(db *gorm.DB) {
+		ctx := db.Statement.Context
+		if ctx == nil {
+			ctx = context.Background()
+		}
+		if _, ok := ctx.Deadline(); ok {
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		db.Statement.Context = ctx
+		db.InstanceSet(queryTimeoutCancelKey, cancel)
+	}
+}
+
+// queryTimeoutAfter releases the timeout context created by queryTimeoutBefore.
+func queryTimeoutAfter(db *gorm.DB) {
+	if v, ok := db.InstanceGet(queryTimeoutCancelKey); ok {
+		if cancel, ok := v.(context.CancelFunc); ok {
+			cancel()
+		}
+	}
+}
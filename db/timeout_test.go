@@ -0,0 +1,62 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func newTestStatementDB(ctx context.Context) *gorm.DB {
+	return &gorm.DB{
+		Config:    &gorm.Config{},
+		Statement: &gorm.Statement{Context: ctx},
+	}
+}
+
+func TestQueryTimeoutBefore_AppliesDefault(t *testing.T) {
+	db := newTestStatementDB(context.Background())
+
+	queryTimeoutBefore(time.Second)(db)
+
+	deadline, ok := db.Statement.Context.Deadline()
+	require.True(t, ok, "Expected a deadline to be set")
+	assert.WithinDuration(t, time.Now().Add(time.Second), deadline, 100*time.Millisecond)
+
+	queryTimeoutAfter(db)
+	assert.ErrorIs(t, db.Statement.Context.Err(), context.Canceled, "Expected context to be cancelled after the statement")
+}
+
+func TestQueryTimeoutBefore_KeepsCallerDeadline(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Hour)
+	defer cancel()
+	db := newTestStatementDB(ctx)
+
+	queryTimeoutBefore(time.Second)(db)
+
+	assert.Equal(t, ctx, db.Statement.Context, "Expected caller context to be kept")
+
+	// No cancel func was stored, so this must be a no-op
+	queryTimeoutAfter(db)
+	assert.NoError(t, ctx.Err())
+}
+
+func TestValidate_NegativeDefaultQueryTimeout(t *testing.T) {
+	config := &ConnectionConfig{
+		DBType:              Postgresql,
+		Host:                "localhost",
+		Port:                5432,
+		Username:            "user",
+		DBName:              "db",
+		MaxIdleConns:        1,
+		MaxOpenConns:        2,
+		DefaultQueryTimeout: -time.Second,
+	}
+
+	err := config.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "DefaultQueryTimeout must not be negative")
+}
@@ -0,0 +1,57 @@
+//go:build integration
+
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultQueryTimeoutWithTestcontainers(t *testing.T) {
+	container, config := setupPostgresContainer(t)
+	defer func() {
+		if err := container.Terminate(context.Background()); err != nil {
+			t.Logf("Failed to terminate container: %v", err)
+		}
+	}()
+
+	config.DefaultQueryTimeout = 500 * time.Millisecond
+
+	db, err := config.Pool()
+	require.NoError(t, err, "Failed to connect to database")
+
+	t.Run("SlowExecExceedsDefault", func(t *testing.T) {
+		start := time.Now()
+		err := db.WithContext(context.Background()).Exec("SELECT pg_sleep(5)").Error
+
+		require.Error(t, err)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Less(t, time.Since(start), 3*time.Second, "Query should be cut off by the default timeout")
+	})
+
+	t.Run("SlowScanExceedsDefault", func(t *testing.T) {
+		var result string
+		err := db.Raw("SELECT pg_sleep(5)::text").Scan(&result).Error
+
+		require.Error(t, err)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("CallerDeadlineTakesPrecedence", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		err := db.WithContext(ctx).Exec("SELECT pg_sleep(1)").Error
+		assert.NoError(t, err, "Query within the caller's deadline should succeed")
+	})
+
+	t.Run("FastQuerySucceeds", func(t *testing.T) {
+		var count int64
+		err := db.Model(&Product{}).Count(&count).Error
+		assert.NoError(t, err)
+	})
+}
@@ -0,0 +1,189 @@
+package db
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeTestCert writes a self-signed certificate and key for localhost to
+// certFile and keyFile.
+func writeTestCert(t *testing.T, certFile, keyFile string) *x509.Certificate {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		DNSNames:              []string{"localhost"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600))
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return cert
+}
+
+func TestConnectionConfig_Validate_SSL(t *testing.T) {
+	base := ConnectionConfig{
+		DBType:       Mysql,
+		Host:         "localhost",
+		Port:         3306,
+		Username:     "root",
+		DBName:       "test",
+		MaxIdleConns: 1,
+		MaxOpenConns: 2,
+	}
+
+	tests := []struct {
+		name    string
+		modify  func(c *ConnectionConfig)
+		wantErr string
+	}{
+		{
+			name: "MySQL verify-full with certificates",
+			modify: func(c *ConnectionConfig) {
+				c.SSLMode, c.SSLRootCert, c.SSLCert, c.SSLKey = "verify-full", "ca.pem", "c.pem", "k.pem"
+			},
+		},
+		{
+			name:    "invalid MySQL SSLMode",
+			modify:  func(c *ConnectionConfig) { c.SSLMode = "true" },
+			wantErr: `invalid SSLMode "true" for MySQL`,
+		},
+		{
+			name:    "SSLCert without SSLKey",
+			modify:  func(c *ConnectionConfig) { c.SSLCert = "c.pem" },
+			wantErr: "SSLCert and SSLKey must be set together",
+		},
+		{
+			name:    "certificates with SSLMode disable",
+			modify:  func(c *ConnectionConfig) { c.SSLMode, c.SSLRootCert = "disable", "ca.pem" },
+			wantErr: `SSL certificates cannot be used with SSLMode "disable"`,
+		},
+		{
+			name: "client certificate for MSSQL",
+			modify: func(c *ConnectionConfig) {
+				c.DBType, c.Port, c.SSLCert, c.SSLKey = MSSQL, 1433, "c.pem", "k.pem"
+			},
+			wantErr: "not supported for MSSQL",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := base
+			tt.modify(&config)
+			err := config.Validate()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestConnectionConfig_mysqlTLS(t *testing.T) {
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "client.pem")
+	keyFile := filepath.Join(dir, "client.key")
+	cert := writeTestCert(t, certFile, keyFile)
+
+	config := ConnectionConfig{
+		DBType:      Mysql,
+		Host:        "db.example.com",
+		Port:        3306,
+		Username:    "root",
+		Password:    "password",
+		DBName:      "test",
+		Timeout:     3 * time.Second,
+		SSLMode:     "verify-full",
+		SSLRootCert: certFile,
+		SSLCert:     certFile,
+		SSLKey:      keyFile,
+	}
+
+	t.Run("DSN references registered config", func(t *testing.T) {
+		name := config.mysqlTLSConfigName()
+		assert.True(t, strings.HasPrefix(name, "pkg-db-"))
+		assert.Equal(t, "root:password@tcp(db.example.com:3306)/test?parseTime=true&timeout=3s&tls="+name, config.dsn())
+		assert.NoError(t, config.registerMysqlTLS())
+	})
+
+	t.Run("verify-full loads roots and client certificate", func(t *testing.T) {
+		tlsConfig, err := config.mysqlTLSConfig()
+		require.NoError(t, err)
+		assert.Equal(t, "db.example.com", tlsConfig.ServerName)
+		assert.False(t, tlsConfig.InsecureSkipVerify)
+		assert.Len(t, tlsConfig.Certificates, 1)
+		require.NotNil(t, tlsConfig.RootCAs)
+		_, err = cert.Verify(x509.VerifyOptions{Roots: tlsConfig.RootCAs, DNSName: "localhost"})
+		assert.NoError(t, err)
+	})
+
+	t.Run("verify-ca checks the chain but not the host name", func(t *testing.T) {
+		caOnly := config
+		caOnly.SSLMode = "verify-ca"
+		tlsConfig, err := caOnly.mysqlTLSConfig()
+		require.NoError(t, err)
+		assert.True(t, tlsConfig.InsecureSkipVerify)
+		require.NotNil(t, tlsConfig.VerifyPeerCertificate)
+
+		// The certificate is for localhost, not db.example.com
+		assert.NoError(t, tlsConfig.VerifyPeerCertificate([][]byte{cert.Raw}, nil))
+
+		other := writeTestCert(t, filepath.Join(dir, "other.pem"), filepath.Join(dir, "other.key"))
+		assert.Error(t, tlsConfig.VerifyPeerCertificate([][]byte{other.Raw}, nil))
+	})
+
+	t.Run("require skips verification", func(t *testing.T) {
+		encryptOnly := config
+		encryptOnly.SSLMode = "require"
+		tlsConfig, err := encryptOnly.mysqlTLSConfig()
+		require.NoError(t, err)
+		assert.True(t, tlsConfig.InsecureSkipVerify)
+		assert.Nil(t, tlsConfig.VerifyPeerCertificate)
+	})
+
+	t.Run("missing root certificate", func(t *testing.T) {
+		missing := config
+		missing.SSLRootCert = filepath.Join(dir, "missing.pem")
+		_, err := missing.mysqlTLSConfig()
+		assert.ErrorContains(t, err, "failed to read SSLRootCert")
+	})
+
+	t.Run("Pool reports TLS errors before connecting", func(t *testing.T) {
+		missing := config
+		missing.SSLRootCert = filepath.Join(dir, "missing.pem")
+		missing.MaxIdleConns, missing.MaxOpenConns = 1, 2
+		_, err := missing.Pool()
+		assert.ErrorContains(t, err, "failed to configure TLS")
+	})
+}
@@ -2,9 +2,11 @@ package docker
 
 import (
 	"fmt"
+	"io"
 	"strings"
 	"time"
 
+	"github.com/docker/docker/api/types/container"
 	"github.com/docker/go-connections/nat"
 
 	"github.com/jasoet/pkg/v2/otel"
@@ -56,6 +58,9 @@ type ContainerRequest struct {
 	// Networks to attach the container to
 	Networks []string
 
+	// NetworkAliases are DNS names for the container on each attached network
+	NetworkAliases []string
+
 	// NetworkMode sets the network mode (bridge, host, none, container:<name>)
 	NetworkMode string
 
@@ -82,6 +87,12 @@ type ContainerRequest struct {
 	// ShmSize sets the size of /dev/shm
 	ShmSize int64
 
+	// MemoryLimit caps container memory in bytes (0 means unlimited)
+	MemoryLimit int64
+
+	// NanoCPUs caps CPU usage in units of 1e-9 CPUs (0 means unlimited)
+	NanoCPUs int64
+
 	// WaitingFor specifies the wait strategy for container readiness
 	WaitingFor WaitStrategy
 
@@ -90,6 +101,10 @@ type ContainerRequest struct {
 
 	// OTelConfig enables OpenTelemetry instrumentation (optional)
 	OTelConfig *otel.Config
+
+	// DependsOn lists services that must start before this one.
+	// Only used by Stack; ignored by New and NewFromRequest.
+	DependsOn []string
 }
 
 // config is the internal configuration used by the executor.
@@ -110,6 +125,7 @@ type config struct {
 	workDir      string
 	user         string
 	networks     []string
+	aliases      []string
 	networkMode  string
 	autoRemove   bool
 	privileged   bool
@@ -117,13 +133,17 @@ type config struct {
 	capDrop      []string
 	tmpfs        map[string]string
 	shmSize      int64
+	memoryLimit  int64
+	nanoCPUs     int64
+	healthcheck  *container.HealthConfig
 
 	// Operational configuration
 	waitStrategy WaitStrategy
 	timeout      time.Duration
 
 	// Observability
-	otelConfig *otel.Config
+	otelConfig  *otel.Config
+	logConsumer io.Writer
 }
 
 // Option is a functional option for configuring the executor.
@@ -150,6 +170,14 @@ func (c *config) validate() error {
 		return fmt.Errorf("image is required")
 	}
 
+	if c.memoryLimit < 0 {
+		return fmt.Errorf("memory limit must not be negative, got %d", c.memoryLimit)
+	}
+
+	if c.nanoCPUs < 0 {
+		return fmt.Errorf("nano CPUs must not be negative, got %d", c.nanoCPUs)
+	}
+
 	if c.timeout == 0 {
 		c.timeout = 30 * time.Second
 	}
@@ -204,6 +232,8 @@ func WithRequest(req ContainerRequest) Option {
 		c.capAdd = req.CapAdd
 		c.capDrop = req.CapDrop
 		c.shmSize = req.ShmSize
+		c.memoryLimit = req.MemoryLimit
+		c.nanoCPUs = req.NanoCPUs
 		c.waitStrategy = req.WaitingFor
 		c.networkMode = req.NetworkMode
 
@@ -264,6 +294,7 @@ func WithRequest(req ContainerRequest) Option {
 
 		// Networks
 		c.networks = append(c.networks, req.Networks...)
+		c.aliases = append(c.aliases, req.NetworkAliases...)
 
 		return nil
 	}
@@ -494,6 +525,15 @@ func WithNetworks(networks ...string) Option {
 	}
 }
 
+// WithNetworkAlias sets DNS aliases for the container on every network added with
+// WithNetwork/WithNetworks, so other containers on those networks can reach it by name.
+func WithNetworkAlias(aliases ...string) Option {
+	return func(c *config) error {
+		c.aliases = append(c.aliases, aliases...)
+		return nil
+	}
+}
+
 // WithNetworkMode sets the network mode (bridge, host, none, container:<name>).
 func WithNetworkMode(mode string) Option {
 	return func(c *config) error {
@@ -552,6 +592,45 @@ func WithShmSize(size int64) Option {
 	}
 }
 
+// WithMemoryLimit caps container memory in bytes (Docker --memory).
+// The container is OOM-killed when it exceeds the limit.
+func WithMemoryLimit(bytes int64) Option {
+	return func(c *config) error {
+		c.memoryLimit = bytes
+		return nil
+	}
+}
+
+// WithNanoCPUs caps CPU usage in units of 1e-9 CPUs (Docker --cpus).
+// For example, 500_000_000 limits the container to half a CPU.
+func WithNanoCPUs(nanoCPUs int64) Option {
+	return func(c *config) error {
+		c.nanoCPUs = nanoCPUs
+		return nil
+	}
+}
+
+// WithHealthcheck defines a container HEALTHCHECK, overriding any from the image.
+// The command runs through the container shell (CMD-SHELL) every interval.
+// Combine with WaitForHealthcheck to block until the check passes.
+func WithHealthcheck(cmd string, interval time.Duration) Option {
+	return func(c *config) error {
+		if cmd == "" {
+			return fmt.Errorf("healthcheck command is required")
+		}
+		if interval <= 0 {
+			return fmt.Errorf("healthcheck interval must be positive, got %v", interval)
+		}
+		c.healthcheck = &container.HealthConfig{
+			Test:     []string{"CMD-SHELL", cmd},
+			Interval: interval,
+			Timeout:  interval,
+			Retries:  3,
+		}
+		return nil
+	}
+}
+
 // WithWaitStrategy sets the wait strategy for container readiness.
 func WithWaitStrategy(strategy WaitStrategy) Option {
 	return func(c *config) error {
@@ -568,6 +647,29 @@ func WithTimeout(timeout time.Duration) Option {
 	}
 }
 
+// WithLogConsumer follows the container's stdout and stderr into w from Start
+// until the container stops or the executor is terminated or closed, e.g. to
+// surface container logs in test output. Logs emitted while a wait strategy runs
+// are included, which helps diagnose containers that never become ready.
+//
+// w is written to from a background goroutine, which has returned by the time
+// Terminate or Close returns, so w may log through a *testing.T. Following ends
+// when the container stops, so logs after a Restart are not captured; use
+// FollowLogs for those.
+//
+// Example:
+//
+//	exec, _ := docker.New(
+//	    docker.WithImage("postgres:16-alpine"),
+//	    docker.WithLogConsumer(os.Stderr),
+//	)
+func WithLogConsumer(w io.Writer) Option {
+	return func(c *config) error {
+		c.logConsumer = w
+		return nil
+	}
+}
+
 // WithOTelConfig enables OpenTelemetry instrumentation.
 func WithOTelConfig(otelConfig *otel.Config) Option {
 	return func(c *config) error {
@@ -450,3 +450,76 @@ func TestNetworkHelpers_InvalidPort(t *testing.T) {
 	_, err := docker.NatPort("invalid")
 	assert.Error(t, err)
 }
+
+func TestConfigOptions_Healthcheck(t *testing.T) {
+	exec, err := docker.New(
+		docker.WithImage("alpine:latest"),
+		docker.WithHealthcheck("true", time.Second),
+	)
+	require.NoError(t, err)
+	assert.NotNil(t, exec)
+
+	_, err = docker.New(
+		docker.WithImage("alpine:latest"),
+		docker.WithHealthcheck("", time.Second),
+	)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "healthcheck command is required")
+
+	_, err = docker.New(
+		docker.WithImage("alpine:latest"),
+		docker.WithHealthcheck("true", 0),
+	)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "healthcheck interval must be positive")
+}
+
+func TestConfigOptions_NetworkAlias(t *testing.T) {
+	exec, err := docker.New(
+		docker.WithImage("alpine:latest"),
+		docker.WithNetwork("test-network"),
+		docker.WithNetworkAlias("db", "postgres"),
+	)
+	require.NoError(t, err)
+	assert.NotNil(t, exec)
+
+	exec, err = docker.NewFromRequest(docker.ContainerRequest{
+		Image:          "alpine:latest",
+		Networks:       []string{"test-network"},
+		NetworkAliases: []string{"db"},
+	})
+	require.NoError(t, err)
+	assert.NotNil(t, exec)
+}
+
+func TestConfigOptions_ResourceLimits(t *testing.T) {
+	exec, err := docker.New(
+		docker.WithImage("alpine:latest"),
+		docker.WithMemoryLimit(64*1024*1024),
+		docker.WithNanoCPUs(500_000_000),
+	)
+	require.NoError(t, err)
+	assert.NotNil(t, exec)
+
+	exec, err = docker.NewFromRequest(docker.ContainerRequest{
+		Image:       "alpine:latest",
+		MemoryLimit: 64 * 1024 * 1024,
+		NanoCPUs:    500_000_000,
+	})
+	require.NoError(t, err)
+	assert.NotNil(t, exec)
+
+	_, err = docker.New(
+		docker.WithImage("alpine:latest"),
+		docker.WithMemoryLimit(-1),
+	)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "memory limit must not be negative")
+
+	_, err = docker.New(
+		docker.WithImage("alpine:latest"),
+		docker.WithNanoCPUs(-1),
+	)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "nano CPUs must not be negative")
+}
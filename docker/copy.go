@@ -0,0 +1,159 @@
+package docker
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/docker/docker/api/types/container"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// CopyFileToContainer copies a single host file into the container at containerPath.
+// mode sets the file permissions inside the container (e.g. 0o644).
+// The parent directory of containerPath must already exist in the container.
+//
+// Example:
+//
+//	err := exec.CopyFileToContainer(ctx, "testdata/nginx.conf", "/etc/nginx/nginx.conf", 0o644)
+func (e *Executor) CopyFileToContainer(ctx context.Context, hostPath, containerPath string, mode int64) error {
+	content, err := os.ReadFile(hostPath)
+	if err != nil {
+		return fmt.Errorf("failed to read host file: %w", err)
+	}
+
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	header := &tar.Header{
+		Name: path.Base(containerPath),
+		Mode: mode,
+		Size: int64(len(content)),
+	}
+	if err := tw.WriteHeader(header); err != nil {
+		return fmt.Errorf("failed to write tar header: %w", err)
+	}
+	if _, err := tw.Write(content); err != nil {
+		return fmt.Errorf("failed to write tar content: %w", err)
+	}
+	if err := tw.Close(); err != nil {
+		return fmt.Errorf("failed to close tar archive: %w", err)
+	}
+
+	return e.copyToContainer(ctx, "docker.CopyFileToContainer", containerPath, &buf)
+}
+
+// CopyDirToContainer copies a host directory recursively into the container so that
+// hostDir/a/b.txt ends up at containerDir/a/b.txt. File modes are preserved.
+// The parent directory of containerDir must already exist in the container.
+//
+// Example:
+//
+//	err := exec.CopyDirToContainer(ctx, "testdata/fixtures", "/fixtures")
+func (e *Executor) CopyDirToContainer(ctx context.Context, hostDir, containerDir string) error {
+	info, err := os.Stat(hostDir)
+	if err != nil {
+		return fmt.Errorf("failed to stat host directory: %w", err)
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("host path %q is not a directory", hostDir)
+	}
+
+	root := path.Base(path.Clean(containerDir))
+
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	err = filepath.WalkDir(hostDir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(hostDir, p)
+		if err != nil {
+			return err
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() && !info.IsDir() {
+			return nil // Skip symlinks, sockets and other special files
+		}
+
+		header, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return err
+		}
+		header.Name = path.Join(root, filepath.ToSlash(rel))
+		if info.IsDir() {
+			header.Name += "/"
+		}
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+
+		if info.IsDir() {
+			return nil
+		}
+
+		f, err := os.Open(p)
+		if err != nil {
+			return err
+		}
+		defer func() { _ = f.Close() }()
+
+		_, err = io.Copy(tw, f)
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to archive host directory: %w", err)
+	}
+	if err := tw.Close(); err != nil {
+		return fmt.Errorf("failed to close tar archive: %w", err)
+	}
+
+	return e.copyToContainer(ctx, "docker.CopyDirToContainer", containerDir, &buf)
+}
+
+// copyToContainer extracts a tar archive, whose entries are rooted at
+// path.Base(containerPath), into the parent directory of containerPath.
+func (e *Executor) copyToContainer(ctx context.Context, spanName, containerPath string, archive io.Reader) error {
+	e.mu.RLock()
+	containerID := e.containerID
+	e.mu.RUnlock()
+
+	if containerID == "" {
+		return fmt.Errorf("container not started")
+	}
+
+	// Trace with OTel
+	if e.otel != nil {
+		var span trace.Span
+		ctx, span = e.otel.startSpan(ctx, spanName)
+		defer span.End()
+	}
+
+	parent := path.Dir(path.Clean(containerPath))
+	stat, err := e.client.ContainerStatPath(ctx, containerID, parent)
+	if err != nil {
+		return fmt.Errorf("parent directory %q does not exist in container: %w", parent, err)
+	}
+	if !stat.Mode.IsDir() {
+		return fmt.Errorf("parent path %q in container is not a directory", parent)
+	}
+
+	if err := e.client.CopyToContainer(ctx, containerID, parent, archive, container.CopyToContainerOptions{}); err != nil {
+		if e.otel != nil {
+			e.otel.recordError(ctx, "copy_to_container_error", err)
+		}
+		return fmt.Errorf("failed to copy to container: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,107 @@
+package docker_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jasoet/pkg/v2/docker"
+)
+
+func startNginx(t *testing.T, ctx context.Context) *docker.Executor {
+	t.Helper()
+
+	exec, err := docker.New(
+		docker.WithImage("nginx:alpine"),
+		docker.WithAutoRemove(true),
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, exec.Start(ctx))
+	t.Cleanup(func() { _ = exec.Terminate(context.Background()) })
+
+	return exec
+}
+
+func TestCopyFileToContainer(t *testing.T) {
+	skipIfNoContainerRuntime(t)
+	ctx := context.Background()
+	exec := startNginx(t, ctx)
+
+	hostFile := filepath.Join(t.TempDir(), "hello.txt")
+	require.NoError(t, os.WriteFile(hostFile, []byte("hello from host"), 0o600))
+
+	err := exec.CopyFileToContainer(ctx, hostFile, "/usr/share/nginx/html/hello.txt", 0o644)
+	require.NoError(t, err)
+
+	exitCode, stdout, _, err := exec.Exec(ctx, []string{"cat", "/usr/share/nginx/html/hello.txt"})
+	require.NoError(t, err)
+	assert.Equal(t, 0, exitCode)
+	assert.Equal(t, "hello from host", stdout)
+
+	_, stdout, _, err = exec.Exec(ctx, []string{"stat", "-c", "%a", "/usr/share/nginx/html/hello.txt"})
+	require.NoError(t, err)
+	assert.Equal(t, "644", strings.TrimSpace(stdout))
+}
+
+func TestCopyFileToContainer_MissingParent(t *testing.T) {
+	skipIfNoContainerRuntime(t)
+	ctx := context.Background()
+	exec := startNginx(t, ctx)
+
+	hostFile := filepath.Join(t.TempDir(), "hello.txt")
+	require.NoError(t, os.WriteFile(hostFile, []byte("hello"), 0o600))
+
+	err := exec.CopyFileToContainer(ctx, hostFile, "/does/not/exist/hello.txt", 0o644)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `parent directory "/does/not/exist" does not exist`)
+}
+
+func TestCopyDirToContainer(t *testing.T) {
+	skipIfNoContainerRuntime(t)
+	ctx := context.Background()
+	exec := startNginx(t, ctx)
+
+	hostDir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(hostDir, "nested", "deeper"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(hostDir, "root.txt"), []byte("root"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(hostDir, "nested", "deeper", "leaf.txt"), []byte("leaf"), 0o644))
+
+	err := exec.CopyDirToContainer(ctx, hostDir, "/tmp/fixtures")
+	require.NoError(t, err)
+
+	_, stdout, _, err := exec.Exec(ctx, []string{"cat", "/tmp/fixtures/root.txt"})
+	require.NoError(t, err)
+	assert.Equal(t, "root", stdout)
+
+	_, stdout, _, err = exec.Exec(ctx, []string{"cat", "/tmp/fixtures/nested/deeper/leaf.txt"})
+	require.NoError(t, err)
+	assert.Equal(t, "leaf", stdout)
+}
+
+func TestCopyToContainer_HostErrors(t *testing.T) {
+	exec, err := docker.New(docker.WithImage("alpine:latest"))
+	if err != nil {
+		t.Skip("Container runtime client not available:", err)
+	}
+	ctx := context.Background()
+
+	err = exec.CopyFileToContainer(ctx, filepath.Join(t.TempDir(), "missing.txt"), "/tmp/missing.txt", 0o644)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to read host file")
+
+	hostFile := filepath.Join(t.TempDir(), "file.txt")
+	require.NoError(t, os.WriteFile(hostFile, []byte("x"), 0o600))
+	err = exec.CopyDirToContainer(ctx, hostFile, "/tmp/dir")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "is not a directory")
+
+	err = exec.CopyFileToContainer(ctx, hostFile, "/tmp/file.txt", 0o644)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "container not started")
+}
@@ -0,0 +1,104 @@
+package docker
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/pkg/stdcopy"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Exec runs a command inside the running container and waits for it to finish.
+// It returns the command's exit code and captured stdout/stderr.
+//
+// A non-zero exit code is not treated as an error; err is only set when the
+// command could not be executed (e.g. container not started, binary not found
+// by the runtime, or a Docker API failure).
+//
+// Example:
+//
+//	exitCode, stdout, stderr, err := exec.Exec(ctx, []string{"psql", "-c", "SELECT 1"})
+func (e *Executor) Exec(ctx context.Context, cmd []string) (exitCode int, stdout string, stderr string, err error) {
+	e.mu.RLock()
+	containerID := e.containerID
+	e.mu.RUnlock()
+
+	if containerID == "" {
+		return 0, "", "", fmt.Errorf("container not started")
+	}
+	if len(cmd) == 0 {
+		return 0, "", "", fmt.Errorf("command is required")
+	}
+
+	// Trace with OTel
+	if e.otel != nil {
+		var span trace.Span
+		ctx, span = e.otel.startSpan(ctx, "docker.Exec")
+		defer span.End()
+	}
+
+	created, err := e.client.ContainerExecCreate(ctx, containerID, container.ExecOptions{
+		AttachStdout: true,
+		AttachStderr: true,
+		Cmd:          cmd,
+	})
+	if err != nil {
+		if e.otel != nil {
+			e.otel.recordError(ctx, "exec_create_error", err)
+		}
+		return 0, "", "", fmt.Errorf("failed to create exec: %w", err)
+	}
+
+	attach, err := e.client.ContainerExecAttach(ctx, created.ID, container.ExecAttachOptions{})
+	if err != nil {
+		if e.otel != nil {
+			e.otel.recordError(ctx, "exec_attach_error", err)
+		}
+		return 0, "", "", fmt.Errorf("failed to attach to exec: %w", err)
+	}
+	defer attach.Close()
+
+	// Output is multiplexed; StdCopy returns once the command closes its streams
+	var outBuf, errBuf strings.Builder
+	if _, err := stdcopy.StdCopy(&outBuf, &errBuf, attach.Reader); err != nil {
+		return 0, "", "", fmt.Errorf("failed to read exec output: %w", err)
+	}
+
+	inspect, err := e.waitExecExit(ctx, created.ID)
+	if err != nil {
+		return 0, "", "", err
+	}
+
+	return inspect.ExitCode, outBuf.String(), errBuf.String(), nil
+}
+
+// execPollInterval is how often waitExecExit inspects an exec that is still running.
+const execPollInterval = 100 * time.Millisecond
+
+// waitExecExit inspects the exec until it is no longer running. The output
+// stream can end before Docker records the exit, while inspect still reports
+// Running with ExitCode 0, so reading the exit code right away could turn a
+// failed command into a success.
+func (e *Executor) waitExecExit(ctx context.Context, execID string) (container.ExecInspect, error) {
+	ticker := time.NewTicker(execPollInterval)
+	defer ticker.Stop()
+
+	for {
+		inspect, err := e.client.ContainerExecInspect(ctx, execID)
+		if err != nil {
+			return container.ExecInspect{}, fmt.Errorf("failed to inspect exec: %w", err)
+		}
+		if !inspect.Running {
+			return inspect, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return container.ExecInspect{}, fmt.Errorf("exec still running: %w", ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package docker_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jasoet/pkg/v2/docker"
+)
+
+func startSleepingAlpine(t *testing.T, ctx context.Context) *docker.Executor {
+	t.Helper()
+
+	exec, err := docker.New(
+		docker.WithImage("alpine:latest"),
+		docker.WithCmd("sleep", "60"),
+		docker.WithAutoRemove(true),
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, exec.Start(ctx))
+	t.Cleanup(func() { _ = exec.Terminate(context.Background()) })
+
+	return exec
+}
+
+func TestExec_Success(t *testing.T) {
+	skipIfNoContainerRuntime(t)
+	ctx := context.Background()
+	exec := startSleepingAlpine(t, ctx)
+
+	exitCode, stdout, stderr, err := exec.Exec(ctx, []string{"echo", "hello"})
+	require.NoError(t, err)
+	assert.Equal(t, 0, exitCode)
+	assert.Equal(t, "hello", strings.TrimSpace(stdout))
+	assert.Empty(t, stderr)
+}
+
+func TestExec_NonZeroExit(t *testing.T) {
+	skipIfNoContainerRuntime(t)
+	ctx := context.Background()
+	exec := startSleepingAlpine(t, ctx)
+
+	exitCode, stdout, stderr, err := exec.Exec(ctx, []string{"sh", "-c", "echo oops >&2; exit 3"})
+	require.NoError(t, err)
+	assert.Equal(t, 3, exitCode)
+	assert.Empty(t, stdout)
+	assert.Equal(t, "oops", strings.TrimSpace(stderr))
+}
+
+func TestExec_NotStarted(t *testing.T) {
+	exec, err := docker.New(docker.WithImage("alpine:latest"))
+	if err != nil {
+		t.Skip("Container runtime client not available:", err)
+	}
+
+	_, _, _, err = exec.Exec(context.Background(), []string{"echo", "hello"})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "container not started")
+}
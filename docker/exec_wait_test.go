@@ -0,0 +1,80 @@
+package docker
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/pkg/stdcopy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// execHandler serves a fake Docker exec API whose output stream ends before the
+// exec is reported as exited: inspect returns Running with ExitCode 0 until it
+// has been called runningInspects times, then the real exit code 3.
+func execHandler(t *testing.T, runningInspects int32, inspects *atomic.Int32) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/exec"):
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(container.ExecCreateResponse{ID: "exec-1"})
+		case strings.HasSuffix(r.URL.Path, "/start"):
+			conn, buf, err := w.(http.Hijacker).Hijack()
+			if !assert.NoError(t, err) {
+				return
+			}
+			defer conn.Close()
+			_, _ = buf.WriteString("HTTP/1.1 101 UPGRADED\r\nContent-Type: application/vnd.docker.raw-stream\r\nConnection: Upgrade\r\nUpgrade: tcp\r\n\r\n")
+			_, _ = stdcopy.NewStdWriter(buf, stdcopy.Stderr).Write([]byte("failed\n"))
+			_ = buf.Flush()
+		case strings.HasSuffix(r.URL.Path, "/json"):
+			n := inspects.Add(1)
+			w.Header().Set("Content-Type", "application/json")
+			if n <= runningInspects {
+				_ = json.NewEncoder(w).Encode(container.ExecInspect{ExecID: "exec-1", Running: true})
+				return
+			}
+			_ = json.NewEncoder(w).Encode(container.ExecInspect{ExecID: "exec-1", ExitCode: 3})
+		default:
+			http.NotFound(w, r)
+		}
+	}
+}
+
+func TestExec_WaitsForExit(t *testing.T) {
+	var inspects atomic.Int32
+	e := &Executor{
+		config:      &config{},
+		client:      newFakeDockerClient(t, execHandler(t, 2, &inspects)),
+		containerID: "abc",
+	}
+
+	exitCode, stdout, stderr, err := e.Exec(context.Background(), []string{"false"})
+	require.NoError(t, err)
+	assert.Equal(t, 3, exitCode, "exit code must be read once the exec stopped running")
+	assert.Empty(t, stdout)
+	assert.Equal(t, "failed\n", stderr)
+	assert.Equal(t, int32(3), inspects.Load())
+}
+
+func TestExec_WaitForExitHonorsContext(t *testing.T) {
+	var inspects atomic.Int32
+	e := &Executor{
+		config:      &config{},
+		client:      newFakeDockerClient(t, execHandler(t, 1<<30, &inspects)),
+		containerID: "abc",
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 300*time.Millisecond)
+	defer cancel()
+
+	_, _, _, err := e.Exec(ctx, []string{"sleep", "infinity"})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.ErrorContains(t, err, "exec still running")
+}
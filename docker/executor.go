@@ -23,6 +23,11 @@ type Executor struct {
 	containerID string
 	mu          sync.RWMutex
 	otel        *otelInstrumentation
+
+	// stopLogConsumer cancels the WithLogConsumer stream; nil when not running
+	stopLogConsumer context.CancelFunc
+	// logConsumerDone is closed when the WithLogConsumer goroutine returns
+	logConsumerDone chan struct{}
 }
 
 // New creates a new Docker executor with functional options.
@@ -152,6 +157,11 @@ func (e *Executor) Start(ctx context.Context) error {
 		return fmt.Errorf("failed to start container: %w", err)
 	}
 
+	// Attach the log consumer before waiting so startup logs are captured
+	if e.config.logConsumer != nil {
+		e.startLogConsumer(containerID)
+	}
+
 	// Wait for readiness if strategy is configured
 	if e.config.waitStrategy != nil {
 		if err := e.config.waitStrategy.WaitUntilReady(ctx, e.client, containerID); err != nil {
@@ -225,6 +235,9 @@ func (e *Executor) terminate(ctx context.Context) error {
 		return fmt.Errorf("container not started")
 	}
 
+	// Stop the log consumer even if removal fails, so its goroutine never outlives Terminate
+	defer e.cancelLogConsumer()
+
 	// Trace with OTel
 	if e.otel != nil {
 		var span trace.Span
@@ -253,6 +266,17 @@ func (e *Executor) terminate(ctx context.Context) error {
 	return nil
 }
 
+// cancelLogConsumer stops the WithLogConsumer stream, if any, and waits for it
+// to return so the consumer is not written to afterwards. The caller must hold e.mu.
+func (e *Executor) cancelLogConsumer() {
+	if e.stopLogConsumer != nil {
+		e.stopLogConsumer()
+		<-e.logConsumerDone
+		e.stopLogConsumer = nil
+		e.logConsumerDone = nil
+	}
+}
+
 // Restart restarts the container.
 // Note: There is a small TOCTOU window between the containerID check and the Docker API call.
 // Concurrent Terminate() may cause a benign "container not found" error.
@@ -337,6 +361,7 @@ func (e *Executor) Close() error {
 	if e.client == nil {
 		return nil
 	}
+	e.cancelLogConsumer()
 	err := e.client.Close()
 	e.client = nil
 	return err
@@ -386,6 +411,7 @@ func (e *Executor) createContainer(ctx context.Context) (string, error) {
 		User:         e.config.user,
 		Hostname:     e.config.hostname,
 		Volumes:      e.config.volumes,
+		Healthcheck:  e.config.healthcheck,
 	}
 
 	// Host configuration
@@ -398,6 +424,10 @@ func (e *Executor) createContainer(ctx context.Context) (string, error) {
 		CapDrop:      e.config.capDrop,
 		Tmpfs:        e.config.tmpfs,
 		ShmSize:      e.config.shmSize,
+		Resources: container.Resources{
+			Memory:   e.config.memoryLimit,
+			NanoCPUs: e.config.nanoCPUs,
+		},
 	}
 
 	// Set network mode if specified
@@ -410,7 +440,9 @@ func (e *Executor) createContainer(ctx context.Context) (string, error) {
 	if len(e.config.networks) > 0 {
 		endpoints := make(map[string]*network.EndpointSettings)
 		for _, net := range e.config.networks {
-			endpoints[net] = &network.EndpointSettings{}
+			endpoints[net] = &network.EndpointSettings{
+				Aliases: e.config.aliases,
+			}
 		}
 		networkConfig.EndpointsConfig = endpoints
 	}
@@ -0,0 +1,28 @@
+package docker
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/docker/docker/client"
+	"github.com/stretchr/testify/require"
+)
+
+// newFakeDockerClient returns a Docker client whose API requests are served by
+// handler, so Executor internals can be tested without a container runtime.
+func newFakeDockerClient(t *testing.T, handler http.Handler) *client.Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	// Drop open streams first so a leaked stream cannot block server.Close
+	t.Cleanup(func() {
+		server.CloseClientConnections()
+		server.Close()
+	})
+
+	cli, err := client.NewClientWithOpts(client.WithHost("tcp://"+server.Listener.Addr().String()), client.WithVersion("1.43"))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = cli.Close() })
+	return cli
+}
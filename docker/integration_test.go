@@ -7,6 +7,8 @@ import (
 	"testing"
 	"time"
 
+	cerrdefs "github.com/containerd/errdefs"
+	"github.com/docker/docker/client"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/otel/sdk/metric"
@@ -351,3 +353,143 @@ func TestIntegration_WaitStrategies(t *testing.T) {
 		assert.True(t, running)
 	})
 }
+
+// Integration test for multi-container topologies on a shared network
+func TestIntegration_SharedNetwork(t *testing.T) {
+	skipIfNoContainerRuntime(t)
+	ctx := context.Background()
+
+	net, err := docker.CreateNetwork(ctx)
+	require.NoError(t, err)
+	defer net.Remove(ctx)
+
+	db, err := docker.New(
+		docker.WithImage("postgres:16-alpine"),
+		docker.WithEnv("POSTGRES_PASSWORD=secret"),
+		docker.WithNetwork(net.Name),
+		docker.WithNetworkAlias("postgres"),
+		docker.WithAutoRemove(true),
+		docker.WithWaitStrategy(
+			docker.WaitForLog("database system is ready to accept connections").
+				WithStartupTimeout(60*time.Second),
+		),
+	)
+	require.NoError(t, err)
+	require.NoError(t, db.Start(ctx))
+	defer db.Terminate(ctx)
+
+	client, err := docker.New(
+		docker.WithImage("postgres:16-alpine"),
+		docker.WithEntrypoint("sleep"),
+		docker.WithCmd("60"),
+		docker.WithNetwork(net.Name),
+		docker.WithAutoRemove(true),
+	)
+	require.NoError(t, err)
+	require.NoError(t, client.Start(ctx))
+	defer client.Terminate(ctx)
+
+	networks, err := client.GetNetworks(ctx)
+	require.NoError(t, err)
+	assert.Contains(t, networks, net.Name)
+
+	// The log line can appear before the final server restart, so retry briefly
+	var exitCode int
+	var stdout string
+	for i := 0; i < 20; i++ {
+		exitCode, stdout, _, err = client.Exec(ctx, []string{"pg_isready", "-h", "postgres", "-p", "5432"})
+		require.NoError(t, err)
+		if exitCode == 0 {
+			break
+		}
+		time.Sleep(500 * time.Millisecond)
+	}
+	assert.Equal(t, 0, exitCode, "pg_isready output: %s", stdout)
+	assert.Contains(t, stdout, "postgres:5432")
+}
+
+// Integration test for resource limits
+func TestIntegration_ResourceLimits(t *testing.T) {
+	skipIfNoContainerRuntime(t)
+	ctx := context.Background()
+
+	const memoryLimit = 64 * 1024 * 1024
+
+	exec, _ := docker.New(
+		docker.WithImage("alpine:latest"),
+		docker.WithCmd("sleep", "10"),
+		docker.WithMemoryLimit(memoryLimit),
+		docker.WithNanoCPUs(500_000_000),
+	)
+
+	err := exec.Start(ctx)
+	require.NoError(t, err)
+	defer exec.Terminate(ctx)
+
+	inspect, err := exec.Inspect(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(memoryLimit), inspect.HostConfig.Memory)
+	assert.Equal(t, int64(500_000_000), inspect.HostConfig.NanoCPUs)
+}
+
+// Integration test for a multi-container stack
+func TestIntegration_Stack(t *testing.T) {
+	skipIfNoContainerRuntime(t)
+	ctx := context.Background()
+
+	stack := docker.NewStack()
+	require.NoError(t, stack.Add("app", docker.ContainerRequest{
+		Image:      "postgres:16-alpine",
+		Entrypoint: []string{"sleep"},
+		Cmd:        []string{"60"},
+		DependsOn:  []string{"postgres"},
+	}))
+	require.NoError(t, stack.Add("postgres", docker.ContainerRequest{
+		Image: "postgres:16-alpine",
+		Env:   map[string]string{"POSTGRES_PASSWORD": "secret"},
+		WaitingFor: docker.WaitForLog("database system is ready to accept connections").
+			WithStartupTimeout(60 * time.Second),
+	}))
+
+	require.NoError(t, stack.Up(ctx))
+	defer stack.Down(ctx)
+
+	db, ok := stack.Executor("postgres")
+	require.True(t, ok)
+	app, ok := stack.Executor("app")
+	require.True(t, ok)
+
+	for _, exec := range []*docker.Executor{db, app} {
+		running, err := exec.IsRunning(ctx)
+		require.NoError(t, err)
+		assert.True(t, running)
+	}
+
+	// The app reaches postgres by its service name
+	var exitCode int
+	var stdout string
+	var err error
+	for i := 0; i < 20; i++ {
+		exitCode, stdout, _, err = app.Exec(ctx, []string{"pg_isready", "-h", "postgres", "-p", "5432"})
+		require.NoError(t, err)
+		if exitCode == 0 {
+			break
+		}
+		time.Sleep(500 * time.Millisecond)
+	}
+	assert.Equal(t, 0, exitCode, "pg_isready output: %s", stdout)
+
+	containerIDs := []string{db.ContainerID(), app.ContainerID()}
+	require.NoError(t, stack.Down(ctx))
+
+	_, ok = stack.Executor("app")
+	assert.False(t, ok)
+
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	require.NoError(t, err)
+	defer cli.Close()
+	for _, id := range containerIDs {
+		_, err := cli.ContainerInspect(ctx, id)
+		assert.True(t, cerrdefs.IsNotFound(err), "container %s should be removed", id)
+	}
+}
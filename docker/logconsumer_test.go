@@ -0,0 +1,107 @@
+package docker
+
+import (
+	"bytes"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/docker/docker/pkg/stdcopy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// slowWriter counts writes, taking a while for each so that a consumer still
+// running after Terminate would be observed.
+type slowWriter struct {
+	mu     sync.Mutex
+	writes int
+}
+
+func (w *slowWriter) Write(p []byte) (int, error) {
+	time.Sleep(20 * time.Millisecond)
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.writes++
+	return len(p), nil
+}
+
+func (w *slowWriter) count() int {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.writes
+}
+
+// streamingLogsHandler serves a fake Docker API that streams log lines until
+// the request is canceled and fails to remove containers.
+func streamingLogsHandler(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case strings.HasSuffix(r.URL.Path, "/logs"):
+		w.Header().Set("Content-Type", "application/vnd.docker.raw-stream")
+		w.WriteHeader(http.StatusOK)
+		for {
+			var frame bytes.Buffer
+			_, _ = stdcopy.NewStdWriter(&frame, stdcopy.Stdout).Write([]byte("tick\n"))
+			if _, err := w.Write(frame.Bytes()); err != nil {
+				return
+			}
+			w.(http.Flusher).Flush()
+			select {
+			case <-r.Context().Done():
+				return
+			case <-time.After(5 * time.Millisecond):
+			}
+		}
+	case r.Method == http.MethodDelete:
+		http.Error(w, `{"message":"removal failed"}`, http.StatusInternalServerError)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func TestLogConsumer_StoppedWhenTerminateFails(t *testing.T) {
+	var w slowWriter
+	e := &Executor{
+		config:      &config{logConsumer: &w},
+		client:      newFakeDockerClient(t, http.HandlerFunc(streamingLogsHandler)),
+		containerID: "abc",
+	}
+
+	e.mu.Lock()
+	e.startLogConsumer(e.containerID)
+	e.mu.Unlock()
+
+	require.Eventually(t, func() bool { return w.count() > 0 }, 5*time.Second, 10*time.Millisecond)
+
+	err := e.Terminate(t.Context())
+	require.ErrorContains(t, err, "failed to remove container")
+
+	// The consumer goroutine has returned, so no write happens after Terminate
+	written := w.count()
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, written, w.count())
+	assert.Nil(t, e.stopLogConsumer)
+}
+
+func TestLogConsumer_StoppedByClose(t *testing.T) {
+	var w slowWriter
+	e := &Executor{
+		config:      &config{logConsumer: &w},
+		client:      newFakeDockerClient(t, http.HandlerFunc(streamingLogsHandler)),
+		containerID: "abc",
+	}
+
+	e.mu.Lock()
+	e.startLogConsumer(e.containerID)
+	e.mu.Unlock()
+
+	require.Eventually(t, func() bool { return w.count() > 0 }, 5*time.Second, 10*time.Millisecond)
+
+	require.NoError(t, e.Close())
+
+	written := w.count()
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, written, w.count())
+}
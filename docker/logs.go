@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/client"
 	"github.com/docker/docker/pkg/stdcopy"
 )
 
@@ -99,7 +100,8 @@ func WithUntil(until string) LogOption {
 	}
 }
 
-// FollowLogs streams container logs to the provided writer.
+// FollowLogs streams container logs to the provided writer until the container
+// stops or ctx is done; both end the stream without an error.
 // This is useful for piping logs to stdout or a file.
 //
 // Example:
@@ -108,6 +110,7 @@ func WithUntil(until string) LogOption {
 func (e *Executor) FollowLogs(ctx context.Context, w io.Writer, opts ...LogOption) error {
 	e.mu.RLock()
 	containerID := e.containerID
+	cli := e.client
 	e.mu.RUnlock()
 
 	if containerID == "" {
@@ -115,11 +118,20 @@ func (e *Executor) FollowLogs(ctx context.Context, w io.Writer, opts ...LogOptio
 	}
 
 	logOpts := defaultLogOptions()
-	logOpts.follow = true // Force follow mode
 	for _, opt := range opts {
 		opt(logOpts)
 	}
 
+	return followLogs(ctx, cli, containerID, w, logOpts)
+}
+
+// followLogs copies the followed logs of containerID to w, demultiplexing
+// Docker's stream format.
+func followLogs(ctx context.Context, cli *client.Client, containerID string, w io.Writer, logOpts *logOptions) error {
+	if cli == nil {
+		return fmt.Errorf("executor has been closed")
+	}
+
 	options := container.LogsOptions{
 		ShowStdout: logOpts.stdout,
 		ShowStderr: logOpts.stderr,
@@ -130,7 +142,7 @@ func (e *Executor) FollowLogs(ctx context.Context, w io.Writer, opts ...LogOptio
 		Until:      logOpts.until,
 	}
 
-	logs, err := e.client.ContainerLogs(ctx, containerID, options)
+	logs, err := cli.ContainerLogs(ctx, containerID, options)
 	if err != nil {
 		return fmt.Errorf("failed to get logs: %w", err)
 	}
@@ -138,13 +150,29 @@ func (e *Executor) FollowLogs(ctx context.Context, w io.Writer, opts ...LogOptio
 
 	// Copy logs to writer (handles Docker's multiplexed stream format)
 	_, err = stdcopy.StdCopy(w, w, logs)
-	if err != nil && err != io.EOF {
+	if err != nil && err != io.EOF && ctx.Err() == nil {
 		return fmt.Errorf("error streaming logs: %w", err)
 	}
 
 	return nil
 }
 
+// startLogConsumer follows the container logs into the configured log consumer
+// in the background. The caller must hold e.mu.
+func (e *Executor) startLogConsumer(containerID string) {
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	e.stopLogConsumer = cancel
+	e.logConsumerDone = done
+
+	cli, w := e.client, e.config.logConsumer
+	go func() {
+		defer close(done)
+		defer cancel()
+		_ = followLogs(ctx, cli, containerID, w, defaultLogOptions()) //nolint:errcheck // Best effort, the consumer only mirrors logs
+	}()
+}
+
 // GetLogsSince retrieves logs since a specific time.
 // Time can be RFC3339 timestamp or duration string (e.g., "10m", "1h").
 func (e *Executor) GetLogsSince(ctx context.Context, since string) (string, error) {
@@ -3,6 +3,7 @@ package docker_test
 import (
 	"context"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -233,3 +234,78 @@ func TestFollowLogs_ToWriter(t *testing.T) {
 	output := buf.String()
 	assert.NotEmpty(t, output)
 }
+
+// syncBuffer is a strings.Builder safe for a background writer and a polling reader.
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf strings.Builder
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+func TestFollowLogs_StopsOnContextCancel(t *testing.T) {
+	skipIfNoContainerRuntime(t)
+
+	exec, _ := docker.New(
+		docker.WithImage("alpine:latest"),
+		docker.WithCmd("sh", "-c", "i=0; while true; do i=$((i+1)); echo tick $i; sleep 0.2; done"),
+	)
+
+	err := exec.Start(context.Background())
+	require.NoError(t, err)
+	defer exec.Terminate(context.Background())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	var buf strings.Builder
+	err = exec.FollowLogs(ctx, &buf)
+	assert.NoError(t, err, "context cancellation should end the stream cleanly")
+
+	output := buf.String()
+	assert.Contains(t, output, "tick 1\n")
+	assert.Contains(t, output, "tick 3\n")
+}
+
+func TestWithLogConsumer(t *testing.T) {
+	skipIfNoContainerRuntime(t)
+	ctx := context.Background()
+
+	var buf syncBuffer
+	exec, err := docker.New(
+		docker.WithImage("alpine:latest"),
+		docker.WithCmd("sh", "-c", "for i in 1 2 3; do echo out $i; echo err $i >&2; sleep 0.5; done; sleep 30"),
+		docker.WithLogConsumer(&buf),
+	)
+	require.NoError(t, err)
+
+	err = exec.Start(ctx)
+	require.NoError(t, err)
+	defer exec.Terminate(ctx)
+
+	// Lines arrive while the container is still running
+	assert.Eventually(t, func() bool {
+		return strings.Contains(buf.String(), "out 3\n")
+	}, 10*time.Second, 100*time.Millisecond)
+
+	output := buf.String()
+	for _, line := range []string{"out 1\n", "out 2\n", "err 1\n", "err 3\n"} {
+		assert.Contains(t, output, line)
+	}
+
+	// Terminating the container stops the consumer
+	require.NoError(t, exec.Terminate(ctx))
+	captured := buf.String()
+	time.Sleep(500 * time.Millisecond)
+	assert.Equal(t, captured, buf.String())
+}
@@ -2,12 +2,89 @@ package docker
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"fmt"
 	"strings"
 
+	"github.com/docker/docker/api/types/network"
+	"github.com/docker/docker/client"
 	"github.com/docker/go-connections/nat"
 )
 
+// Network is a user-defined bridge network shared by multiple containers.
+// Containers on the same network can reach each other by the aliases set with
+// WithNetworkAlias.
+type Network struct {
+	// ID is the Docker network ID
+	ID string
+
+	// Name is the generated network name, pass it to WithNetwork
+	Name string
+
+	client *client.Client
+}
+
+// CreateNetwork creates a user-defined bridge network with a unique name.
+// Call Remove when done, after terminating all containers attached to it.
+//
+// Example:
+//
+//	net, err := docker.CreateNetwork(ctx)
+//	defer net.Remove(ctx)
+//
+//	db, _ := docker.New(
+//	    docker.WithImage("postgres:16-alpine"),
+//	    docker.WithNetwork(net.Name),
+//	    docker.WithNetworkAlias("postgres"),
+//	)
+//	app, _ := docker.New(
+//	    docker.WithImage("myapp:latest"),
+//	    docker.WithNetwork(net.Name),
+//	    docker.WithEnv("DB_HOST=postgres"),
+//	)
+func CreateNetwork(ctx context.Context) (*Network, error) {
+	suffix := make([]byte, 6)
+	if _, err := rand.Read(suffix); err != nil {
+		return nil, fmt.Errorf("failed to generate network name: %w", err)
+	}
+	name := "pkg-network-" + hex.EncodeToString(suffix)
+
+	cli, err := client.NewClientWithOpts(client.FromEnv, client.WithAPIVersionNegotiation())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Docker client: %w", err)
+	}
+
+	resp, err := cli.NetworkCreate(ctx, name, network.CreateOptions{Driver: "bridge"})
+	if err != nil {
+		_ = cli.Close()
+		return nil, fmt.Errorf("failed to create network: %w", err)
+	}
+
+	return &Network{
+		ID:     resp.ID,
+		Name:   name,
+		client: cli,
+	}, nil
+}
+
+// Remove deletes the network and closes its Docker client.
+// All containers attached to the network must be terminated first.
+func (n *Network) Remove(ctx context.Context) error {
+	if n.client == nil {
+		return nil
+	}
+
+	err := n.client.NetworkRemove(ctx, n.ID)
+	_ = n.client.Close()
+	n.client = nil
+	if err != nil {
+		return fmt.Errorf("failed to remove network: %w", err)
+	}
+
+	return nil
+}
+
 // Host returns the container host address.
 // For local Docker, this is always "localhost" since containers use port forwarding.
 func (e *Executor) Host(_ context.Context) (string, error) {
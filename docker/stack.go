@@ -0,0 +1,218 @@
+package docker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// Stack starts a set of related containers, Compose-style, on a shared network.
+// Each service is reachable from the others by its name, and services start after
+// the services listed in their ContainerRequest.DependsOn.
+//
+// A dependency counts as started once its Start returns, so give services that
+// others depend on a WaitingFor strategy to block until they are actually ready.
+//
+// The zero value is not usable; create one with NewStack.
+type Stack struct {
+	mu        sync.Mutex
+	names     []string
+	services  map[string]ContainerRequest
+	network   *Network
+	executors map[string]*Executor
+	started   []string
+}
+
+// NewStack creates an empty Stack.
+//
+// Example:
+//
+//	stack := docker.NewStack()
+//	_ = stack.Add("postgres", docker.ContainerRequest{
+//	    Image:      "postgres:16-alpine",
+//	    Env:        map[string]string{"POSTGRES_PASSWORD": "secret"},
+//	    WaitingFor: docker.WaitForLog("ready to accept connections"),
+//	})
+//	_ = stack.Add("app", docker.ContainerRequest{
+//	    Image:     "myapp:latest",
+//	    Env:       map[string]string{"DB_HOST": "postgres"},
+//	    DependsOn: []string{"postgres"},
+//	})
+//
+//	if err := stack.Up(ctx); err != nil {
+//	    return err
+//	}
+//	defer stack.Down(ctx)
+func NewStack() *Stack {
+	return &Stack{
+		services:  make(map[string]ContainerRequest),
+		executors: make(map[string]*Executor),
+	}
+}
+
+// Add registers a service under name. The name doubles as the service's DNS
+// alias on the stack network. Services cannot be added while the stack is up.
+func (s *Stack) Add(name string, req ContainerRequest) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if name == "" {
+		return fmt.Errorf("service name is required")
+	}
+	if _, exists := s.services[name]; exists {
+		return fmt.Errorf("service %q is already added", name)
+	}
+	if s.network != nil {
+		return fmt.Errorf("cannot add service %q while the stack is up", name)
+	}
+
+	s.names = append(s.names, name)
+	s.services[name] = req
+	return nil
+}
+
+// Up creates the stack network and starts every service in dependency order.
+// If any service fails to start, the services already started and the network
+// are torn down before the error is returned.
+func (s *Stack) Up(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.network != nil {
+		return fmt.Errorf("stack is already up")
+	}
+
+	order, err := s.startOrder()
+	if err != nil {
+		return err
+	}
+
+	net, err := CreateNetwork(ctx)
+	if err != nil {
+		return err
+	}
+	s.network = net
+
+	for _, name := range order {
+		exec, err := NewFromRequest(s.services[name],
+			WithNetwork(net.Name),
+			WithNetworkAlias(name),
+		)
+		if err != nil {
+			_ = s.down(ctx) //nolint:errcheck // Best effort cleanup, original error is more important
+			return fmt.Errorf("failed to create service %q: %w", name, err)
+		}
+		s.executors[name] = exec
+
+		if err := exec.Start(ctx); err != nil {
+			// A container that was created but failed to start is not yet tracked
+			if exec.ContainerID() != "" {
+				_ = exec.Terminate(ctx) //nolint:errcheck // Best effort cleanup
+			}
+			_ = s.down(ctx) //nolint:errcheck // Best effort cleanup, original error is more important
+			return fmt.Errorf("failed to start service %q: %w", name, err)
+		}
+		s.started = append(s.started, name)
+	}
+
+	return nil
+}
+
+// Down terminates all services in reverse start order and removes the stack
+// network. It is safe to call on a stack that is not up.
+func (s *Stack) Down(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.down(ctx)
+}
+
+// down is the internal implementation of Down (without locking).
+func (s *Stack) down(ctx context.Context) error {
+	var errs []error
+
+	for i := len(s.started) - 1; i >= 0; i-- {
+		name := s.started[i]
+		if err := s.executors[name].Terminate(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("failed to terminate service %q: %w", name, err))
+		}
+	}
+
+	for name, exec := range s.executors {
+		if err := exec.Close(); err != nil {
+			errs = append(errs, fmt.Errorf("failed to close service %q: %w", name, err))
+		}
+	}
+
+	if s.network != nil {
+		if err := s.network.Remove(ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	s.started = nil
+	s.executors = make(map[string]*Executor)
+	s.network = nil
+
+	return errors.Join(errs...)
+}
+
+// Executor returns the executor of a running service, e.g. to read its logs or
+// mapped ports. It returns false if the service does not exist or the stack is
+// not up.
+func (s *Stack) Executor(name string) (*Executor, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	exec, ok := s.executors[name]
+	return exec, ok
+}
+
+// startOrder returns the service names ordered so that every service comes after
+// its dependencies. Independent services keep the order in which they were added.
+func (s *Stack) startOrder() ([]string, error) {
+	for _, name := range s.names {
+		for _, dep := range s.services[name].DependsOn {
+			if _, ok := s.services[dep]; !ok {
+				return nil, fmt.Errorf("service %q depends on unknown service %q", name, dep)
+			}
+		}
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make(map[string]int, len(s.names))
+	order := make([]string, 0, len(s.names))
+
+	var visit func(name string) error
+	visit = func(name string) error {
+		switch state[name] {
+		case visited:
+			return nil
+		case visiting:
+			return fmt.Errorf("dependency cycle detected at service %q", name)
+		}
+
+		state[name] = visiting
+		for _, dep := range s.services[name].DependsOn {
+			if err := visit(dep); err != nil {
+				return err
+			}
+		}
+		state[name] = visited
+		order = append(order, name)
+		return nil
+	}
+
+	for _, name := range s.names {
+		if err := visit(name); err != nil {
+			return nil, err
+		}
+	}
+
+	return order, nil
+}
@@ -0,0 +1,57 @@
+package docker
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStack_StartOrder(t *testing.T) {
+	stack := NewStack()
+	require.NoError(t, stack.Add("app", ContainerRequest{Image: "myapp", DependsOn: []string{"cache", "db"}}))
+	require.NoError(t, stack.Add("worker", ContainerRequest{Image: "myapp", DependsOn: []string{"db"}}))
+	require.NoError(t, stack.Add("db", ContainerRequest{Image: "postgres"}))
+	require.NoError(t, stack.Add("cache", ContainerRequest{Image: "redis"}))
+
+	order, err := stack.startOrder()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"cache", "db", "app", "worker"}, order)
+}
+
+func TestStack_AddErrors(t *testing.T) {
+	stack := NewStack()
+
+	err := stack.Add("", ContainerRequest{Image: "alpine"})
+	assert.ErrorContains(t, err, "service name is required")
+
+	require.NoError(t, stack.Add("db", ContainerRequest{Image: "postgres"}))
+	err = stack.Add("db", ContainerRequest{Image: "mysql"})
+	assert.ErrorContains(t, err, `service "db" is already added`)
+}
+
+func TestStack_UpRejectsInvalidDependencies(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("unknown dependency", func(t *testing.T) {
+		stack := NewStack()
+		require.NoError(t, stack.Add("app", ContainerRequest{Image: "myapp", DependsOn: []string{"db"}}))
+
+		err := stack.Up(ctx)
+		assert.ErrorContains(t, err, `service "app" depends on unknown service "db"`)
+	})
+
+	t.Run("cycle", func(t *testing.T) {
+		stack := NewStack()
+		require.NoError(t, stack.Add("a", ContainerRequest{Image: "alpine", DependsOn: []string{"b"}}))
+		require.NoError(t, stack.Add("b", ContainerRequest{Image: "alpine", DependsOn: []string{"a"}}))
+
+		err := stack.Up(ctx)
+		assert.ErrorContains(t, err, "dependency cycle detected")
+	})
+
+	t.Run("down without up", func(t *testing.T) {
+		assert.NoError(t, NewStack().Down(ctx))
+	})
+}
@@ -250,7 +250,8 @@ type waitForHealthy struct {
 }
 
 // WaitForHealthy creates a wait strategy that waits for health check to pass.
-// Container must have a HEALTHCHECK defined in Dockerfile or via Docker API.
+// Container must have a HEALTHCHECK defined in Dockerfile or via WithHealthcheck.
+// Fails immediately if no HEALTHCHECK is defined or the container turns unhealthy.
 func WaitForHealthy() *waitForHealthy {
 	return &waitForHealthy{
 		timeout: 60 * time.Second,
@@ -287,8 +288,18 @@ func (w *waitForHealthy) WaitUntilReady(ctx context.Context, cli *client.Client,
 			}
 
 			// Check health status
-			if inspect.State.Health != nil && inspect.State.Health.Status == "healthy" {
+			health := inspect.State.Health
+			if health == nil {
+				return fmt.Errorf("container has no HEALTHCHECK defined")
+			}
+			switch health.Status {
+			case "healthy":
 				return nil
+			case "unhealthy":
+				if n := len(health.Log); n > 0 {
+					return fmt.Errorf("container is unhealthy: %s", strings.TrimSpace(health.Log[n-1].Output))
+				}
+				return fmt.Errorf("container is unhealthy")
 			}
 		}
 	}
@@ -366,3 +377,8 @@ func (w *multiWait) WaitUntilReady(ctx context.Context, cli *client.Client, cont
 func ForListeningPort(port string) *waitForPort {
 	return WaitForPort(port)
 }
+
+// WaitForHealthcheck is an alias for WaitForHealthy.
+func WaitForHealthcheck() *waitForHealthy {
+	return WaitForHealthy()
+}
@@ -2,6 +2,7 @@ package docker_test
 
 import (
 	"context"
+	"net"
 	"testing"
 	"time"
 
@@ -207,3 +208,73 @@ func TestWaitStrategy_HTTPTimeout(t *testing.T) {
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "failed to become ready")
 }
+
+func TestWaitStrategy_WaitForPortRedis(t *testing.T) {
+	skipIfNoContainerRuntime(t)
+	ctx := context.Background()
+
+	exec, err := docker.New(
+		docker.WithImage("redis:7-alpine"),
+		docker.WithPorts("6379:0"),
+		docker.WithAutoRemove(true),
+		docker.WithWaitStrategy(
+			docker.WaitForPort("6379").WithStartupTimeout(30*time.Second),
+		),
+	)
+	require.NoError(t, err)
+
+	err = exec.Start(ctx)
+	require.NoError(t, err)
+	defer exec.Terminate(ctx)
+
+	endpoint, err := exec.Endpoint(ctx, "6379/tcp")
+	require.NoError(t, err)
+
+	conn, err := net.DialTimeout("tcp", endpoint, 2*time.Second)
+	require.NoError(t, err)
+	_ = conn.Close()
+}
+
+func TestWaitStrategy_WaitForHealthcheck(t *testing.T) {
+	skipIfNoContainerRuntime(t)
+	ctx := context.Background()
+
+	exec, err := docker.New(
+		docker.WithImage("alpine:latest"),
+		docker.WithCmd("sh", "-c", "sleep 2 && touch /tmp/ready && sleep 30"),
+		docker.WithHealthcheck("test -f /tmp/ready", time.Second),
+		docker.WithAutoRemove(true),
+		docker.WithWaitStrategy(
+			docker.WaitForHealthcheck().WithStartupTimeout(30*time.Second),
+		),
+	)
+	require.NoError(t, err)
+
+	err = exec.Start(ctx)
+	require.NoError(t, err)
+	defer exec.Terminate(ctx)
+
+	status, err := exec.Status(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, status.Health)
+	assert.Equal(t, "healthy", status.Health.Status)
+}
+
+func TestWaitStrategy_WaitForHealthcheckNotDefined(t *testing.T) {
+	skipIfNoContainerRuntime(t)
+	ctx := context.Background()
+
+	exec, err := docker.New(
+		docker.WithImage("alpine:latest"),
+		docker.WithCmd("sleep", "10"),
+		docker.WithAutoRemove(true),
+		docker.WithWaitStrategy(
+			docker.WaitForHealthcheck().WithStartupTimeout(10*time.Second),
+		),
+	)
+	require.NoError(t, err)
+
+	err = exec.Start(ctx)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "no HEALTHCHECK defined")
+}
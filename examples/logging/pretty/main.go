@@ -0,0 +1,36 @@
+//go:build example
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/jasoet/pkg/v2/logging"
+)
+
+// Replays a JSON log file written with logging.OutputFile in the console format.
+//
+// Usage:
+//
+//	go run -tags=example ./pretty app.log
+//	tail -f app.log | go run -tags=example ./pretty
+func main() {
+	var input io.Reader = os.Stdin
+
+	if len(os.Args) > 1 {
+		file, err := os.Open(os.Args[1])
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to open log file: %v\n", err)
+			os.Exit(1)
+		}
+		defer file.Close()
+		input = file
+	}
+
+	if err := logging.PrettyPrint(input, os.Stdout); err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to pretty-print logs: %v\n", err)
+		os.Exit(1)
+	}
+}
@@ -14,10 +14,10 @@ import (
 )
 
 func main() {
-	// Configure Temporal connection
-	config := &temporal.Config{
-		HostPort:  getEnv("TEMPORAL_HOST", "localhost:7233"),
-		Namespace: getEnv("TEMPORAL_NAMESPACE", "default"),
+	// Configure Temporal connection from TEMPORAL_* environment variables
+	config := temporal.ConfigFromEnv()
+	if err := config.Validate(); err != nil {
+		log.Fatalf("Invalid Temporal config: %v", err)
 	}
 
 	// Create WorkflowManager
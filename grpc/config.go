@@ -1,13 +1,17 @@
 package grpc
 
 import (
+	"context"
+	"crypto/tls"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/keepalive"
 
 	"github.com/jasoet/pkg/v2/otel"
 )
@@ -40,11 +44,25 @@ type config struct {
 	maxConnectionIdle     time.Duration // gRPC server max connection idle time
 	maxConnectionAge      time.Duration // gRPC server max connection age
 	maxConnectionAgeGrace time.Duration // gRPC server max connection age grace
+	maxRecvMsgSize        int           // gRPC max receive message size in bytes (0 = gRPC default of 4MB)
+	maxSendMsgSize        int           // gRPC max send message size in bytes (0 = gRPC default of math.MaxInt32)
+
+	// Keepalive Configuration (nil = derived from connection timeouts / gRPC defaults)
+	keepaliveParams *keepalive.ServerParameters  // gRPC server keepalive parameters
+	keepalivePolicy *keepalive.EnforcementPolicy // gRPC server keepalive enforcement policy
 
 	// Production Features
-	enableHealthCheck bool   // Enable health check endpoints
-	healthPath        string // Base path for health check endpoints
-	enableReflection  bool   // Enable gRPC server reflection
+	enableHealthCheck bool                            // Enable health check endpoints
+	healthPath        string                          // Base path for health check endpoints
+	enableReflection  bool                            // Enable gRPC server reflection
+	reflectionAuth    func(ctx context.Context) error // Authorizes reflection requests (nil = unauthenticated)
+	enableRecovery    bool                            // Recover handler panics as codes.Internal errors
+	payloadLogging    *PayloadLogOptions              // Log request/response messages at debug level (nil = disabled)
+
+	// TLS Configuration (only supported in SeparateMode)
+	tlsCertFile string      // Path to PEM-encoded certificate file
+	tlsKeyFile  string      // Path to PEM-encoded private key file
+	tlsConfig   *tls.Config // Custom TLS configuration (takes precedence over cert/key files)
 
 	// Customization Hooks
 	grpcConfigurer   func(*grpc.Server) // Configure gRPC server
@@ -53,6 +71,7 @@ type config struct {
 	shutdown         func() error       // Custom shutdown handler
 
 	// Gateway Configuration
+	enableGateway   bool   // Serve the Echo HTTP surface (gateway, health endpoints, custom routes)
 	gatewayBasePath string // Base path for gRPC gateway routes (default: "/api/v1")
 
 	// Echo-specific Features
@@ -88,8 +107,10 @@ func newConfig(opts ...Option) (*config, error) {
 		enableHealthCheck: true,
 		healthPath:        "/health",
 		enableReflection:  false,
+		enableRecovery:    true,
 
 		// Gateway Configuration
+		enableGateway:   true,
 		gatewayBasePath: "/api/v1",
 
 		// Echo-specific Features
@@ -162,9 +183,58 @@ func (c *config) validate() error {
 		return fmt.Errorf("idle timeout cannot be negative")
 	}
 
+	if c.maxRecvMsgSize < 0 {
+		return fmt.Errorf("max receive message size cannot be negative")
+	}
+
+	if c.maxSendMsgSize < 0 {
+		return fmt.Errorf("max send message size cannot be negative")
+	}
+
+	if c.tlsConfig == nil && (c.tlsCertFile != "" || c.tlsKeyFile != "") {
+		if c.tlsCertFile == "" || c.tlsKeyFile == "" {
+			return fmt.Errorf("TLS requires both certificate and key files")
+		}
+	}
+
+	if c.enableGateway && !strings.HasPrefix(c.gatewayBasePath, "/") {
+		return fmt.Errorf("gateway base path must start with '/', got %q", c.gatewayBasePath)
+	}
+
+	if c.isTLSEnabled() && c.mode == H2CMode {
+		return fmt.Errorf("TLS cannot be used with H2C mode, use WithSeparateMode instead")
+	}
+
 	return nil
 }
 
+// isTLSEnabled returns true if TLS has been configured for the server
+func (c *config) isTLSEnabled() bool {
+	return c.tlsConfig != nil || c.tlsCertFile != "" || c.tlsKeyFile != ""
+}
+
+// buildTLSConfig returns the TLS configuration for the gRPC and HTTP listeners,
+// loading the certificate and key files if no custom tls.Config was provided.
+// It returns nil when TLS is not enabled.
+func (c *config) buildTLSConfig() (*tls.Config, error) {
+	if c.tlsConfig != nil {
+		return c.tlsConfig.Clone(), nil
+	}
+	if !c.isTLSEnabled() {
+		return nil, nil
+	}
+
+	cert, err := tls.LoadX509KeyPair(c.tlsCertFile, c.tlsKeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load TLS key pair: %w", err)
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
 // getGRPCAddress returns the full address for the gRPC server
 func (c *config) getGRPCAddress() string {
 	return ":" + c.grpcPort
@@ -222,6 +292,28 @@ func WithHTTPPort(port string) Option {
 	}
 }
 
+// ============================================================================
+// TLS Options
+// ============================================================================
+
+// WithTLS enables TLS for the gRPC server and the HTTP gateway using the given
+// PEM-encoded certificate and key files. TLS is only supported in SeparateMode.
+func WithTLS(certFile, keyFile string) Option {
+	return func(c *config) {
+		c.tlsCertFile = certFile
+		c.tlsKeyFile = keyFile
+	}
+}
+
+// WithTLSConfig enables TLS for the gRPC server and the HTTP gateway using a
+// custom tls.Config. It takes precedence over WithTLS. TLS is only supported
+// in SeparateMode.
+func WithTLSConfig(tlsConfig *tls.Config) Option {
+	return func(c *config) {
+		c.tlsConfig = tlsConfig
+	}
+}
+
 // ============================================================================
 // Timeout Options
 // ============================================================================
@@ -284,6 +376,33 @@ func WithMaxConnectionAgeGrace(d time.Duration) Option {
 	}
 }
 
+// ============================================================================
+// Message Size & Keepalive Options
+// ============================================================================
+
+// WithMaxRecvMsgSize sets the maximum message size in bytes the gRPC server can receive
+func WithMaxRecvMsgSize(size int) Option {
+	return func(c *config) {
+		c.maxRecvMsgSize = size
+	}
+}
+
+// WithMaxSendMsgSize sets the maximum message size in bytes the gRPC server can send
+func WithMaxSendMsgSize(size int) Option {
+	return func(c *config) {
+		c.maxSendMsgSize = size
+	}
+}
+
+// WithKeepaliveParams sets the gRPC server keepalive parameters and enforcement policy.
+// The parameters replace those derived from the connection timeout options.
+func WithKeepaliveParams(params keepalive.ServerParameters, policy keepalive.EnforcementPolicy) Option {
+	return func(c *config) {
+		c.keepaliveParams = &params
+		c.keepalivePolicy = &policy
+	}
+}
+
 // ============================================================================
 // Feature Toggle Options
 // ============================================================================
@@ -302,13 +421,36 @@ func WithoutHealthCheck() Option {
 	}
 }
 
-// WithReflection enables gRPC server reflection
+// WithReflection enables gRPC server reflection for any client. Reflection
+// exposes every registered service and message schema, so only use it in
+// development; in production use WithReflectionAuth or leave reflection off.
 func WithReflection() Option {
 	return func(c *config) {
 		c.enableReflection = true
 	}
 }
 
+// WithReflectionAuth enables gRPC server reflection and gates it behind auth,
+// which is called with the context of every reflection stream (incoming metadata
+// included). A non-nil error rejects the request with codes.Unauthenticated.
+// Other services are not affected.
+//
+// Example:
+//
+//	grpc.WithReflectionAuth(func(ctx context.Context) error {
+//	    md, _ := metadata.FromIncomingContext(ctx)
+//	    if tokens := md.Get("x-reflection-token"); len(tokens) == 0 || tokens[0] != os.Getenv("REFLECTION_TOKEN") {
+//	        return errors.New("invalid reflection token")
+//	    }
+//	    return nil
+//	})
+func WithReflectionAuth(auth func(ctx context.Context) error) Option {
+	return func(c *config) {
+		c.enableReflection = true
+		c.reflectionAuth = auth
+	}
+}
+
 // WithoutReflection disables gRPC server reflection
 func WithoutReflection() Option {
 	return func(c *config) {
@@ -316,6 +458,51 @@ func WithoutReflection() Option {
 	}
 }
 
+// WithRecovery enables recovery of panics in gRPC handlers (enabled by default).
+// A recovered panic is logged with its stack trace, recorded on the active span
+// and returned to the client as a codes.Internal error.
+func WithRecovery() Option {
+	return func(c *config) {
+		c.enableRecovery = true
+	}
+}
+
+// WithoutRecovery disables panic recovery in gRPC handlers, so a panicking
+// handler crashes the server process
+func WithoutRecovery() Option {
+	return func(c *config) {
+		c.enableRecovery = false
+	}
+}
+
+// WithPayloadLogging logs gRPC request and response messages as JSON at debug
+// level through the logging package, for debugging. Values of the fields named
+// in opts.RedactFields (by proto JSON name) are replaced with "[REDACTED]".
+// Payloads are only marshaled when debug logging is enabled.
+func WithPayloadLogging(opts PayloadLogOptions) Option {
+	return func(c *config) {
+		c.payloadLogging = &opts
+	}
+}
+
+// WithGateway enables the Echo HTTP server that serves the gRPC gateway,
+// health endpoints and custom routes
+func WithGateway() Option {
+	return func(c *config) {
+		c.enableGateway = true
+	}
+}
+
+// WithoutGateway disables the Echo HTTP server for pure-gRPC deployments.
+// No HTTP listener is opened, so the gateway, HTTP health endpoints,
+// middleware and Echo configurer are unused. In H2C mode the gRPC port
+// serves gRPC only.
+func WithoutGateway() Option {
+	return func(c *config) {
+		c.enableGateway = false
+	}
+}
+
 // WithCORS enables CORS middleware with default (wildcard) configuration
 func WithCORS() Option {
 	return func(c *config) {
@@ -351,7 +538,8 @@ func WithHealthPath(path string) Option {
 	}
 }
 
-// WithGatewayBasePath sets the base path for gRPC gateway routes
+// WithGatewayBasePath sets the base path the gRPC gateway is mounted under
+// (default: "/api/v1"). The path must start with "/".
 func WithGatewayBasePath(path string) Option {
 	return func(c *config) {
 		c.gatewayBasePath = path
@@ -1,6 +1,8 @@
 package grpc
 
 import (
+	"context"
+	"crypto/tls"
 	"testing"
 	"time"
 
@@ -11,6 +13,7 @@ import (
 	noopm "go.opentelemetry.io/otel/metric/noop"
 	noopt "go.opentelemetry.io/otel/trace/noop"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/keepalive"
 
 	pkgotel "github.com/jasoet/pkg/v2/otel"
 )
@@ -34,12 +37,14 @@ func TestNewConfigDefaults(t *testing.T) {
 	// Test feature flags
 	assert.True(t, cfg.enableHealthCheck)
 	assert.False(t, cfg.enableReflection)
+	assert.True(t, cfg.enableRecovery)
 	assert.False(t, cfg.enableCORS)
 	assert.False(t, cfg.enableRateLimit)
 
 	// Test paths
 	assert.Equal(t, "/health", cfg.healthPath)
 	assert.Equal(t, "/api/v1", cfg.gatewayBasePath)
+	assert.True(t, cfg.enableGateway)
 
 	// Test other defaults
 	assert.Equal(t, 100.0, cfg.rateLimit)
@@ -112,6 +117,30 @@ func TestWithMaxConnectionAgeGrace(t *testing.T) {
 	assert.Equal(t, 15*time.Second, cfg.maxConnectionAgeGrace)
 }
 
+func TestWithMaxMsgSize(t *testing.T) {
+	cfg, err := newConfig()
+	require.NoError(t, err)
+	assert.Equal(t, 0, cfg.maxRecvMsgSize, "Expected gRPC default receive size")
+	assert.Equal(t, 0, cfg.maxSendMsgSize, "Expected gRPC default send size")
+
+	cfg, err = newConfig(WithMaxRecvMsgSize(16<<20), WithMaxSendMsgSize(8<<20))
+	require.NoError(t, err)
+	assert.Equal(t, 16<<20, cfg.maxRecvMsgSize)
+	assert.Equal(t, 8<<20, cfg.maxSendMsgSize)
+}
+
+func TestWithKeepaliveParams(t *testing.T) {
+	params := keepalive.ServerParameters{Time: 30 * time.Second, Timeout: 10 * time.Second}
+	policy := keepalive.EnforcementPolicy{MinTime: 5 * time.Second, PermitWithoutStream: true}
+
+	cfg, err := newConfig(WithKeepaliveParams(params, policy))
+	require.NoError(t, err)
+	require.NotNil(t, cfg.keepaliveParams)
+	require.NotNil(t, cfg.keepalivePolicy)
+	assert.Equal(t, params, *cfg.keepaliveParams)
+	assert.Equal(t, policy, *cfg.keepalivePolicy)
+}
+
 func TestWithHealthCheck(t *testing.T) {
 	cfg, err := newConfig(WithHealthCheck())
 	require.NoError(t, err)
@@ -136,6 +165,35 @@ func TestWithoutReflection(t *testing.T) {
 	assert.False(t, cfg.enableReflection)
 }
 
+func TestWithoutRecovery(t *testing.T) {
+	cfg, err := newConfig(WithoutRecovery())
+	require.NoError(t, err)
+	assert.False(t, cfg.enableRecovery)
+
+	cfg, err = newConfig(WithoutRecovery(), WithRecovery())
+	require.NoError(t, err)
+	assert.True(t, cfg.enableRecovery)
+}
+
+func TestWithReflectionAuth(t *testing.T) {
+	cfg, err := newConfig(WithReflectionAuth(func(context.Context) error { return nil }))
+	require.NoError(t, err)
+	assert.True(t, cfg.enableReflection)
+	assert.NotNil(t, cfg.reflectionAuth)
+}
+
+func TestWithGateway(t *testing.T) {
+	cfg, err := newConfig(WithoutGateway(), WithGateway())
+	require.NoError(t, err)
+	assert.True(t, cfg.enableGateway)
+}
+
+func TestWithoutGateway(t *testing.T) {
+	cfg, err := newConfig(WithoutGateway())
+	require.NoError(t, err)
+	assert.False(t, cfg.enableGateway)
+}
+
 func TestWithCORS(t *testing.T) {
 	cfg, err := newConfig(WithCORS())
 	require.NoError(t, err)
@@ -149,6 +207,45 @@ func TestWithRateLimit(t *testing.T) {
 	assert.Equal(t, 250.0, cfg.rateLimit)
 }
 
+func TestWithTLS(t *testing.T) {
+	cfg, err := newConfig(WithSeparateMode("9090", "9091"), WithTLS("cert.pem", "key.pem"))
+	require.NoError(t, err)
+	assert.Equal(t, "cert.pem", cfg.tlsCertFile)
+	assert.Equal(t, "key.pem", cfg.tlsKeyFile)
+	assert.True(t, cfg.isTLSEnabled())
+}
+
+func TestWithTLSConfig(t *testing.T) {
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS13}
+	cfg, err := newConfig(WithSeparateMode("9090", "9091"), WithTLSConfig(tlsConfig))
+	require.NoError(t, err)
+	assert.True(t, cfg.isTLSEnabled())
+
+	built, err := cfg.buildTLSConfig()
+	require.NoError(t, err)
+	assert.Equal(t, uint16(tls.VersionTLS13), built.MinVersion)
+	assert.NotSame(t, tlsConfig, built, "Expected a clone of the provided TLS config")
+}
+
+func TestBuildTLSConfigDisabled(t *testing.T) {
+	cfg, err := newConfig()
+	require.NoError(t, err)
+	assert.False(t, cfg.isTLSEnabled())
+
+	built, err := cfg.buildTLSConfig()
+	require.NoError(t, err)
+	assert.Nil(t, built)
+}
+
+func TestBuildTLSConfigMissingFiles(t *testing.T) {
+	cfg, err := newConfig(WithSeparateMode("9090", "9091"), WithTLS("missing-cert.pem", "missing-key.pem"))
+	require.NoError(t, err)
+
+	_, err = cfg.buildTLSConfig()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to load TLS key pair")
+}
+
 func TestWithHealthPath(t *testing.T) {
 	cfg, err := newConfig(WithHealthPath("/custom-health"))
 	require.NoError(t, err)
@@ -262,6 +359,17 @@ func TestConfigValidation(t *testing.T) {
 			options:     []Option{WithSeparateMode("9090", "9091")},
 			expectError: false,
 		},
+		{
+			name:        "gateway base path without leading slash",
+			options:     []Option{WithGatewayBasePath("api")},
+			expectError: true,
+			errorMsg:    "gateway base path must start with '/'",
+		},
+		{
+			name:        "gateway base path ignored when gateway disabled",
+			options:     []Option{WithGatewayBasePath(""), WithoutGateway()},
+			expectError: false,
+		},
 		{
 			name:        "missing gRPC port",
 			options:     []Option{WithGRPCPort("")},
@@ -298,6 +406,41 @@ func TestConfigValidation(t *testing.T) {
 			expectError: true,
 			errorMsg:    "idle timeout cannot be negative",
 		},
+		{
+			name:        "negative max receive message size",
+			options:     []Option{WithMaxRecvMsgSize(-1)},
+			expectError: true,
+			errorMsg:    "max receive message size cannot be negative",
+		},
+		{
+			name:        "negative max send message size",
+			options:     []Option{WithMaxSendMsgSize(-1)},
+			expectError: true,
+			errorMsg:    "max send message size cannot be negative",
+		},
+		{
+			name:        "TLS with H2C mode",
+			options:     []Option{WithH2CMode(), WithTLS("cert.pem", "key.pem")},
+			expectError: true,
+			errorMsg:    "TLS cannot be used with H2C mode",
+		},
+		{
+			name:        "TLS config with H2C mode",
+			options:     []Option{WithTLSConfig(&tls.Config{})},
+			expectError: true,
+			errorMsg:    "TLS cannot be used with H2C mode",
+		},
+		{
+			name:        "TLS missing key file",
+			options:     []Option{WithSeparateMode("9090", "9091"), WithTLS("cert.pem", "")},
+			expectError: true,
+			errorMsg:    "TLS requires both certificate and key files",
+		},
+		{
+			name:        "valid TLS separate config",
+			options:     []Option{WithSeparateMode("9090", "9091"), WithTLS("cert.pem", "key.pem")},
+			expectError: false,
+		},
 	}
 
 	for _, tt := range tests {
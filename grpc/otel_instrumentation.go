@@ -10,6 +10,7 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 	otellog "go.opentelemetry.io/otel/log"
 	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/propagation"
 	semconv "go.opentelemetry.io/otel/semconv/v1.27.0"
 	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
@@ -48,6 +49,26 @@ func (mc metadataCarrier) Keys() []string {
 // gRPC Metrics (OpenTelemetry)
 // ============================================================================
 
+// rpcMetricAttributes returns the attributes recorded with every RPC metric: the
+// full method name, and the gRPC status of err both as the numeric code
+// (rpc.grpc.status_code, per semantic conventions) and its name (rpc.grpc.status,
+// e.g. "OK" or "NotFound") for readable per-status breakdowns.
+func rpcMetricAttributes(fullMethod string, err error) []attribute.KeyValue {
+	code := status.Code(err)
+	return []attribute.KeyValue{
+		semconv.RPCMethodKey.String(fullMethod),
+		semconv.RPCSystemKey.String("grpc"),
+		attribute.Int("rpc.grpc.status_code", int(code)),
+		attribute.String("rpc.grpc.status", code.String()),
+	}
+}
+
+// elapsedMilliseconds returns the time since start in fractional milliseconds,
+// so sub-millisecond RPCs are not recorded as zero.
+func elapsedMilliseconds(start time.Time) float64 {
+	return float64(time.Since(start)) / float64(time.Millisecond)
+}
+
 // createGRPCMetricsInterceptor creates gRPC unary interceptor for metrics
 func createGRPCMetricsInterceptor(cfg *pkgotel.Config) grpc.UnaryServerInterceptor {
 	if cfg == nil || !cfg.IsMetricsEnabled() {
@@ -88,23 +109,10 @@ func createGRPCMetricsInterceptor(cfg *pkgotel.Config) grpc.UnaryServerIntercept
 		// Call handler
 		resp, err := handler(ctx, req)
 
-		// Calculate duration
-		duration := time.Since(start).Milliseconds()
-
-		// Get status code
-		st, _ := status.FromError(err)
-		statusCode := int(st.Code())
-
-		// Prepare attributes using semantic conventions
-		attrs := []attribute.KeyValue{
-			semconv.RPCMethodKey.String(info.FullMethod),
-			semconv.RPCSystemKey.String("grpc"),
-			attribute.Int("rpc.grpc.status_code", statusCode),
-		}
-
 		// Record metrics
-		requestCounter.Add(ctx, 1, metric.WithAttributes(attrs...))
-		requestDuration.Record(ctx, float64(duration), metric.WithAttributes(attrs...))
+		attrs := metric.WithAttributes(rpcMetricAttributes(info.FullMethod, err)...)
+		requestCounter.Add(ctx, 1, attrs)
+		requestDuration.Record(ctx, elapsedMilliseconds(start), attrs)
 
 		return resp, err
 	}
@@ -123,11 +131,17 @@ func createGRPCTracingInterceptor(cfg *pkgotel.Config) grpc.UnaryServerIntercept
 	}
 
 	tracer := cfg.GetTracer("grpc.server")
+	propagator := propagation.NewCompositeTextMapPropagator(
+		otel.GetTextMapPropagator(),
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	)
 
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-		// Extract W3C Trace Context (traceparent/tracestate) from gRPC metadata
+		// Extract W3C Trace Context (traceparent/tracestate) and baggage from gRPC
+		// metadata, plus any formats of the globally registered propagator
 		if md, ok := metadata.FromIncomingContext(ctx); ok {
-			ctx = otel.GetTextMapPropagator().Extract(ctx, metadataCarrier(md))
+			ctx = propagator.Extract(ctx, metadataCarrier(md))
 		}
 
 		// Start span
@@ -327,18 +341,9 @@ func createGRPCStreamMetricsInterceptor(cfg *pkgotel.Config) grpc.StreamServerIn
 
 		err := handler(srv, ss)
 
-		duration := time.Since(start).Milliseconds()
-		st, _ := status.FromError(err)
-		statusCode := int(st.Code())
-
-		attrs := []attribute.KeyValue{
-			semconv.RPCMethodKey.String(info.FullMethod),
-			semconv.RPCSystemKey.String("grpc"),
-			attribute.Int("rpc.grpc.status_code", statusCode),
-		}
-
-		streamCounter.Add(ctx, 1, metric.WithAttributes(attrs...))
-		streamDuration.Record(ctx, float64(duration), metric.WithAttributes(attrs...))
+		attrs := metric.WithAttributes(rpcMetricAttributes(info.FullMethod, err)...)
+		streamCounter.Add(ctx, 1, attrs)
+		streamDuration.Record(ctx, elapsedMilliseconds(start), attrs)
 
 		return err
 	}
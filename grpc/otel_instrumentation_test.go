@@ -12,9 +12,12 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/otel/log/noop"
 	metricnoop "go.opentelemetry.io/otel/metric/noop"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 	tracenoop "go.opentelemetry.io/otel/trace/noop"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
 	pkgotel "github.com/jasoet/pkg/v2/otel"
@@ -163,6 +166,57 @@ func TestCreateGRPCMetricsInterceptor(t *testing.T) {
 	})
 }
 
+func TestGRPCMetricsInterceptor_RecordsMethodAndStatus(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	config := pkgotel.NewConfig("test-service").
+		WithMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)))
+
+	interceptor := createGRPCMetricsInterceptor(config)
+
+	_, err := interceptor(context.Background(), "req", mockUnaryInfo("/users.v1.UserService/GetUser"), mockUnaryHandler("ok", nil))
+	require.NoError(t, err)
+	_, err = interceptor(context.Background(), "req", mockUnaryInfo("/users.v1.UserService/GetUser"),
+		mockUnaryHandler(nil, status.Error(codes.NotFound, "user not found")))
+	require.Error(t, err)
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+
+	counts := make(map[string]int64)
+	durations := make(map[string]uint64)
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			switch data := m.Data.(type) {
+			case metricdata.Sum[int64]:
+				if m.Name != "rpc.server.request.count" {
+					continue
+				}
+				for _, dp := range data.DataPoints {
+					method, _ := dp.Attributes.Value("rpc.method")
+					assert.Equal(t, "/users.v1.UserService/GetUser", method.AsString())
+					st, _ := dp.Attributes.Value("rpc.grpc.status")
+					counts[st.AsString()] += dp.Value
+				}
+			case metricdata.Histogram[float64]:
+				if m.Name != "rpc.server.duration" {
+					continue
+				}
+				for _, dp := range data.DataPoints {
+					code, _ := dp.Attributes.Value("rpc.grpc.status_code")
+					st, _ := dp.Attributes.Value("rpc.grpc.status")
+					durations[st.AsString()] += dp.Count
+					if st.AsString() == "NotFound" {
+						assert.Equal(t, int64(codes.NotFound), code.AsInt64())
+					}
+				}
+			}
+		}
+	}
+
+	assert.Equal(t, map[string]int64{"OK": 1, "NotFound": 1}, counts)
+	assert.Equal(t, map[string]uint64{"OK": 1, "NotFound": 1}, durations)
+}
+
 // ============================================================================
 // createGRPCTracingInterceptor Tests
 // ============================================================================
@@ -232,6 +286,28 @@ func TestCreateGRPCTracingInterceptor(t *testing.T) {
 		assert.Nil(t, resp)
 		assert.Equal(t, expectedErr, err)
 	})
+
+	t.Run("extracts baggage from metadata", func(t *testing.T) {
+		config := pkgotel.NewConfig("test-service").
+			WithTracerProvider(tracenoop.NewTracerProvider())
+
+		interceptor := createGRPCTracingInterceptor(config)
+
+		ctx := metadata.NewIncomingContext(context.Background(),
+			metadata.Pairs("baggage", "tenant.id=acme,request.priority=2"))
+
+		var tenant, priority string
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			tenant, _ = pkgotel.GetBaggage(ctx, "tenant.id")
+			priority, _ = pkgotel.GetBaggage(ctx, "request.priority")
+			return "response", nil
+		}
+
+		_, err := interceptor(ctx, "req", mockUnaryInfo("/test.Service/Method"), handler)
+		require.NoError(t, err)
+		assert.Equal(t, "acme", tenant)
+		assert.Equal(t, "2", priority)
+	})
 }
 
 // ============================================================================
@@ -0,0 +1,149 @@
+package grpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/jasoet/pkg/v2/logging"
+)
+
+// redactedValue replaces the value of redacted fields in logged payloads
+const redactedValue = "[REDACTED]"
+
+// PayloadLogOptions configures logging of gRPC request and response messages
+type PayloadLogOptions struct {
+	// RedactFields lists proto field JSON names (e.g. "password", "accessToken")
+	// whose values are replaced with "[REDACTED]" at any nesting depth
+	RedactFields []string
+}
+
+// ============================================================================
+// gRPC Payload Logging
+// ============================================================================
+
+// createGRPCPayloadLoggingInterceptor creates a gRPC unary interceptor that logs
+// request and response messages at debug level
+func createGRPCPayloadLoggingInterceptor(opts PayloadLogOptions) grpc.UnaryServerInterceptor {
+	redact := redactSet(opts.RedactFields)
+
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		logPayload(ctx, info.FullMethod, "request", req, redact)
+
+		resp, err := handler(ctx, req)
+		if err == nil {
+			logPayload(ctx, info.FullMethod, "response", resp, redact)
+		}
+
+		return resp, err
+	}
+}
+
+// createGRPCStreamPayloadLoggingInterceptor creates a gRPC stream interceptor
+// that logs every received and sent message at debug level
+func createGRPCStreamPayloadLoggingInterceptor(opts PayloadLogOptions) grpc.StreamServerInterceptor {
+	redact := redactSet(opts.RedactFields)
+
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &payloadLoggingStream{ServerStream: ss, method: info.FullMethod, redact: redact})
+	}
+}
+
+// payloadLoggingStream wraps a grpc.ServerStream to log its messages
+type payloadLoggingStream struct {
+	grpc.ServerStream
+	method string
+	redact map[string]struct{}
+}
+
+func (s *payloadLoggingStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	logPayload(s.Context(), s.method, "request", m, s.redact)
+	return nil
+}
+
+func (s *payloadLoggingStream) SendMsg(m interface{}) error {
+	logPayload(s.Context(), s.method, "response", m, s.redact)
+	return s.ServerStream.SendMsg(m)
+}
+
+// logPayload logs msg as JSON with the redacted fields masked. Messages are only
+// marshaled when debug logging is enabled; non-proto messages are skipped.
+func logPayload(ctx context.Context, fullMethod, direction string, msg interface{}, redact map[string]struct{}) {
+	logger := logging.ContextLogger(ctx, "grpc.payload")
+	event := logger.Debug()
+	if !event.Enabled() {
+		return
+	}
+
+	pm, ok := msg.(proto.Message)
+	if !ok {
+		event.Discard()
+		return
+	}
+
+	event = event.
+		Str("rpc.method", fullMethod).
+		Str("direction", direction)
+
+	payload, err := marshalPayload(pm, redact)
+	if err != nil {
+		event.Err(err).Msg("Failed to marshal gRPC payload")
+		return
+	}
+	event.RawJSON("payload", payload).Msg("gRPC " + direction + " payload")
+}
+
+// marshalPayload encodes msg as JSON and masks the values of the redacted fields
+func marshalPayload(msg proto.Message, redact map[string]struct{}) ([]byte, error) {
+	data, err := protojson.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	if len(redact) == 0 {
+		return data, nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(redactValue(value, redact))
+}
+
+// redactValue masks the redacted keys of every object nested in value
+func redactValue(value interface{}, redact map[string]struct{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			if _, ok := redact[key]; ok {
+				v[key] = redactedValue
+			} else {
+				v[key] = redactValue(field, redact)
+			}
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redactValue(item, redact)
+		}
+	}
+	return value
+}
+
+// redactSet turns a list of field names into a lookup set
+func redactSet(fields []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(fields))
+	for _, field := range fields {
+		set[field] = struct{}{}
+	}
+	return set
+}
@@ -0,0 +1,126 @@
+package grpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	zlog "github.com/rs/zerolog/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+type servingHealthServer struct {
+	healthpb.UnimplementedHealthServer
+}
+
+func (servingHealthServer) Check(context.Context, *healthpb.HealthCheckRequest) (*healthpb.HealthCheckResponse, error) {
+	return &healthpb.HealthCheckResponse{Status: healthpb.HealthCheckResponse_SERVING}, nil
+}
+
+// payloadLogLines returns the grpc.payload entries written to buf
+func payloadLogLines(t *testing.T, buf *bytes.Buffer) []map[string]any {
+	t.Helper()
+
+	var lines []map[string]any
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		var entry map[string]any
+		require.NoError(t, json.Unmarshal([]byte(line), &entry))
+		if entry["component"] == "grpc.payload" {
+			lines = append(lines, entry)
+		}
+	}
+	return lines
+}
+
+func TestPayloadLogging(t *testing.T) {
+	original := zlog.Logger
+	t.Cleanup(func() { zlog.Logger = original })
+	var buf bytes.Buffer
+	zlog.Logger = zerolog.New(&buf).Level(zerolog.DebugLevel)
+
+	conn := startBufconnServer(t,
+		WithPayloadLogging(PayloadLogOptions{RedactFields: []string{"service"}}),
+		WithServiceRegistrar(func(s *grpc.Server) {
+			healthpb.RegisterHealthServer(s, servingHealthServer{})
+		}),
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{Service: "secret-service"})
+	require.NoError(t, err)
+
+	lines := payloadLogLines(t, &buf)
+	require.Len(t, lines, 2)
+
+	assert.Equal(t, "debug", lines[0]["level"])
+	assert.Equal(t, "/grpc.health.v1.Health/Check", lines[0]["rpc.method"])
+	assert.Equal(t, "request", lines[0]["direction"])
+	assert.Equal(t, map[string]any{"service": "[REDACTED]"}, lines[0]["payload"])
+	assert.NotContains(t, buf.String(), "secret-service")
+
+	assert.Equal(t, "response", lines[1]["direction"])
+	assert.Equal(t, map[string]any{"status": "SERVING"}, lines[1]["payload"])
+}
+
+func TestPayloadLogging_DisabledAboveDebug(t *testing.T) {
+	original := zlog.Logger
+	t.Cleanup(func() { zlog.Logger = original })
+	var buf bytes.Buffer
+	zlog.Logger = zerolog.New(&buf).Level(zerolog.InfoLevel)
+
+	logPayload(context.Background(), "/test.Service/Method", "request", &healthpb.HealthCheckRequest{Service: "svc"}, nil)
+	assert.Empty(t, payloadLogLines(t, &buf))
+}
+
+func TestMarshalPayload(t *testing.T) {
+	msg, err := structpb.NewStruct(map[string]any{
+		"username": "alice",
+		"password": "hunter2",
+		"profile": map[string]any{
+			"accessToken": "abc",
+			"age":         30,
+		},
+		"sessions": []any{
+			map[string]any{"id": "s1", "accessToken": "def"},
+		},
+	})
+	require.NoError(t, err)
+
+	t.Run("masks redacted fields at any depth", func(t *testing.T) {
+		payload, err := marshalPayload(msg, redactSet([]string{"password", "accessToken"}))
+		require.NoError(t, err)
+
+		var got map[string]any
+		require.NoError(t, json.Unmarshal(payload, &got))
+		assert.Equal(t, map[string]any{
+			"username": "alice",
+			"password": "[REDACTED]",
+			"profile": map[string]any{
+				"accessToken": "[REDACTED]",
+				"age":         float64(30),
+			},
+			"sessions": []any{
+				map[string]any{"id": "s1", "accessToken": "[REDACTED]"},
+			},
+		}, got)
+	})
+
+	t.Run("without redaction", func(t *testing.T) {
+		payload, err := marshalPayload(msg, redactSet(nil))
+		require.NoError(t, err)
+		assert.Contains(t, string(payload), "hunter2")
+	})
+}
@@ -0,0 +1,58 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pkgotel "github.com/jasoet/pkg/v2/otel"
+)
+
+// ============================================================================
+// gRPC Panic Recovery
+// ============================================================================
+
+// createGRPCRecoveryInterceptor creates a gRPC unary interceptor that recovers
+// handler panics and turns them into codes.Internal errors
+func createGRPCRecoveryInterceptor(cfg *pkgotel.Config) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = recoverPanic(ctx, cfg, info.FullMethod, r)
+			}
+		}()
+
+		return handler(ctx, req)
+	}
+}
+
+// createGRPCStreamRecoveryInterceptor creates a gRPC stream interceptor that
+// recovers handler panics and turns them into codes.Internal errors
+func createGRPCStreamRecoveryInterceptor(cfg *pkgotel.Config) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = recoverPanic(ss.Context(), cfg, info.FullMethod, r)
+			}
+		}()
+
+		return handler(srv, ss)
+	}
+}
+
+// recoverPanic logs a recovered panic with its stack trace, records it on the
+// active span and returns the error sent to the client. The panic value is not
+// included in the returned status to avoid leaking internals.
+func recoverPanic(ctx context.Context, cfg *pkgotel.Config, fullMethod string, r interface{}) error {
+	logger := pkgotel.NewLogHelper(ctx, cfg, "github.com/jasoet/pkg/v2/grpc", "grpc.recovery")
+	logger.Error(fmt.Errorf("panic: %v", r), "Recovered from panic in gRPC handler",
+		pkgotel.F("rpc.method", fullMethod),
+		pkgotel.F("stack", string(debug.Stack())),
+	)
+
+	return status.Error(codes.Internal, "internal server error")
+}
@@ -0,0 +1,122 @@
+package grpc
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+
+	pkgotel "github.com/jasoet/pkg/v2/otel"
+)
+
+// panickingHealthServer panics on the first call to each method and serves
+// normally afterwards.
+type panickingHealthServer struct {
+	healthpb.UnimplementedHealthServer
+	checks  int
+	watches int
+}
+
+func (h *panickingHealthServer) Check(context.Context, *healthpb.HealthCheckRequest) (*healthpb.HealthCheckResponse, error) {
+	h.checks++
+	if h.checks == 1 {
+		panic("boom")
+	}
+	return &healthpb.HealthCheckResponse{Status: healthpb.HealthCheckResponse_SERVING}, nil
+}
+
+func (h *panickingHealthServer) Watch(_ *healthpb.HealthCheckRequest, stream healthpb.Health_WatchServer) error {
+	h.watches++
+	if h.watches == 1 {
+		panic("boom")
+	}
+	return stream.Send(&healthpb.HealthCheckResponse{Status: healthpb.HealthCheckResponse_SERVING})
+}
+
+// startRecoveryTestServer serves a panickingHealthServer over bufconn with the
+// interceptors configured by opts and returns a connected client.
+func startRecoveryTestServer(t *testing.T, opts ...Option) healthpb.HealthClient {
+	t.Helper()
+
+	opts = append(opts, WithServiceRegistrar(func(s *grpc.Server) {
+		healthpb.RegisterHealthServer(s, &panickingHealthServer{})
+	}))
+	return healthpb.NewHealthClient(startBufconnServer(t, opts...))
+}
+
+func TestRecoveryInterceptor_Unary(t *testing.T) {
+	client := startRecoveryTestServer(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := client.Check(ctx, &healthpb.HealthCheckRequest{})
+	require.Error(t, err)
+	assert.Equal(t, codes.Internal, status.Code(err))
+	assert.NotContains(t, err.Error(), "boom", "panic value should not leak to the client")
+
+	// The server keeps serving after the panic
+	resp, err := client.Check(ctx, &healthpb.HealthCheckRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, resp.Status)
+}
+
+func TestRecoveryInterceptor_Stream(t *testing.T) {
+	client := startRecoveryTestServer(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	stream, err := client.Watch(ctx, &healthpb.HealthCheckRequest{})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	require.Error(t, err)
+	assert.Equal(t, codes.Internal, status.Code(err))
+
+	// The server keeps serving after the panic
+	stream, err = client.Watch(ctx, &healthpb.HealthCheckRequest{})
+	require.NoError(t, err)
+	resp, err := stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, resp.Status)
+}
+
+func TestRecoveryInterceptor_RecordsPanicOnSpan(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	otelConfig := pkgotel.NewConfig("test-service").
+		WithTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	client := startRecoveryTestServer(t, WithOTelConfig(otelConfig))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := client.Check(ctx, &healthpb.HealthCheckRequest{})
+	require.Error(t, err)
+	assert.Equal(t, codes.Internal, status.Code(err))
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	assert.Equal(t, otelcodes.Error, spans[0].Status().Code)
+
+	var exception bool
+	for _, event := range spans[0].Events() {
+		if event.Name == "exception" {
+			for _, attr := range event.Attributes {
+				if attr.Key == "exception.message" && strings.Contains(attr.Value.AsString(), "panic: boom") {
+					exception = true
+				}
+			}
+		}
+	}
+	assert.True(t, exception, "panic should be recorded on the span")
+}
@@ -0,0 +1,46 @@
+package grpc
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ============================================================================
+// gRPC Reflection Authorization
+// ============================================================================
+
+// reflectionServicePrefixes match the methods of the v1 and v1alpha reflection
+// services registered by reflection.Register
+var reflectionServicePrefixes = []string{
+	"/grpc.reflection.v1.ServerReflection/",
+	"/grpc.reflection.v1alpha.ServerReflection/",
+}
+
+// isReflectionMethod reports whether fullMethod belongs to a reflection service
+func isReflectionMethod(fullMethod string) bool {
+	for _, prefix := range reflectionServicePrefixes {
+		if strings.HasPrefix(fullMethod, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// createGRPCReflectionAuthInterceptor creates a gRPC stream interceptor that
+// rejects reflection streams with codes.Unauthenticated when auth fails.
+// Reflection is a streaming service, so no unary interceptor is needed.
+func createGRPCReflectionAuthInterceptor(auth func(ctx context.Context) error) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if isReflectionMethod(info.FullMethod) {
+			if err := auth(ss.Context()); err != nil {
+				return status.Errorf(codes.Unauthenticated, "reflection access denied: %v", err)
+			}
+		}
+
+		return handler(srv, ss)
+	}
+}
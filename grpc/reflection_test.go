@@ -0,0 +1,103 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	reflectionpb "google.golang.org/grpc/reflection/grpc_reflection_v1"
+	"google.golang.org/grpc/status"
+)
+
+// startReflectionTestServer serves a health service over bufconn with the given
+// options and returns a client connection.
+func startReflectionTestServer(t *testing.T, opts ...Option) *grpc.ClientConn {
+	t.Helper()
+
+	opts = append(opts, WithServiceRegistrar(func(s *grpc.Server) {
+		healthpb.RegisterHealthServer(s, health.NewServer())
+	}))
+	return startBufconnServer(t, opts...)
+}
+
+// listServices asks the reflection service for the registered services.
+func listServices(ctx context.Context, conn *grpc.ClientConn) ([]string, error) {
+	stream, err := reflectionpb.NewServerReflectionClient(conn).ServerReflectionInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := stream.Send(&reflectionpb.ServerReflectionRequest{
+		MessageRequest: &reflectionpb.ServerReflectionRequest_ListServices{},
+	}); err != nil {
+		return nil, err
+	}
+	resp, err := stream.Recv()
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, svc := range resp.GetListServicesResponse().GetService() {
+		names = append(names, svc.GetName())
+	}
+	return names, nil
+}
+
+func TestReflectionAuth(t *testing.T) {
+	auth := func(ctx context.Context) error {
+		md, _ := metadata.FromIncomingContext(ctx)
+		if tokens := md.Get("x-reflection-token"); len(tokens) == 0 || tokens[0] != "secret" {
+			return errors.New("invalid reflection token")
+		}
+		return nil
+	}
+	conn := startReflectionTestServer(t, WithReflectionAuth(auth))
+
+	t.Run("allowed when auth passes", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		ctx = metadata.AppendToOutgoingContext(ctx, "x-reflection-token", "secret")
+
+		services, err := listServices(ctx, conn)
+		require.NoError(t, err)
+		assert.Contains(t, services, "grpc.health.v1.Health")
+	})
+
+	t.Run("rejected when auth fails", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		_, err := listServices(ctx, conn)
+		require.Error(t, err)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+		assert.Contains(t, err.Error(), "invalid reflection token")
+	})
+
+	t.Run("other services are not gated", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		resp, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{})
+		require.NoError(t, err)
+		assert.Equal(t, healthpb.HealthCheckResponse_SERVING, resp.GetStatus())
+	})
+}
+
+func TestReflection_WithoutAuth(t *testing.T) {
+	conn := startReflectionTestServer(t, WithReflection())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	services, err := listServices(ctx, conn)
+	require.NoError(t, err)
+	assert.Contains(t, services, "grpc.health.v1.Health")
+}
@@ -2,6 +2,7 @@ package grpc
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"log"
 	"net"
@@ -21,6 +22,7 @@ import (
 	"golang.org/x/net/http2/h2c"
 	"golang.org/x/time/rate"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/keepalive"
 	"google.golang.org/grpc/reflection"
 )
@@ -33,6 +35,7 @@ type Server struct {
 	httpServer    *http.Server // Used only for H2C mode
 	gatewayMux    *runtime.ServeMux
 	healthManager *HealthManager
+	tlsConfig     *tls.Config // Resolved TLS configuration (nil when TLS is disabled)
 	shutdownOnce  sync.Once
 	running       bool
 	mu            sync.RWMutex
@@ -45,9 +48,15 @@ func New(opts ...Option) (*Server, error) {
 		return nil, fmt.Errorf("invalid config: %w", err)
 	}
 
+	tlsConfig, err := cfg.buildTLSConfig()
+	if err != nil {
+		return nil, fmt.Errorf("invalid TLS config: %w", err)
+	}
+
 	server := &Server{
 		config:        cfg,
 		healthManager: NewHealthManager(),
+		tlsConfig:     tlsConfig,
 	}
 
 	// Setup gRPC server
@@ -79,36 +88,79 @@ func (s *Server) logInfo(msg string) {
 func (s *Server) setupGRPCServer() {
 	var opts []grpc.ServerOption
 
-	// Add connection timeout options
-	if s.config.maxConnectionIdle > 0 {
+	// Add transport credentials if TLS is configured
+	if s.tlsConfig != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(s.tlsConfig)))
+	}
+
+	// Add keepalive options; explicit parameters take precedence over connection timeouts
+	if s.config.keepaliveParams != nil {
+		opts = append(opts, grpc.KeepaliveParams(*s.config.keepaliveParams))
+	} else if s.config.maxConnectionIdle > 0 {
 		opts = append(opts, grpc.KeepaliveParams(keepalive.ServerParameters{
 			MaxConnectionIdle:     s.config.maxConnectionIdle,
 			MaxConnectionAge:      s.config.maxConnectionAge,
 			MaxConnectionAgeGrace: s.config.maxConnectionAgeGrace,
 		}))
 	}
+	if s.config.keepalivePolicy != nil {
+		opts = append(opts, grpc.KeepaliveEnforcementPolicy(*s.config.keepalivePolicy))
+	}
+
+	// Add message size limits (unset values keep the gRPC defaults)
+	if s.config.maxRecvMsgSize > 0 {
+		opts = append(opts, grpc.MaxRecvMsgSize(s.config.maxRecvMsgSize))
+	}
+	if s.config.maxSendMsgSize > 0 {
+		opts = append(opts, grpc.MaxSendMsgSize(s.config.maxSendMsgSize))
+	}
+
+	// Chain interceptors: logging -> tracing -> metrics -> reflection auth -> payload logging -> recovery -> handler.
+	// Recovery runs innermost so the other interceptors observe the Internal
+	// error and the panic is recorded on the active span.
+	var unaryInterceptors []grpc.UnaryServerInterceptor
+	var streamInterceptors []grpc.StreamServerInterceptor
 
 	// Add OpenTelemetry interceptors if configured
 	if s.config.otelConfig != nil {
-		// Chain unary interceptors: logging -> tracing -> metrics -> handler
-		unaryInterceptors := []grpc.UnaryServerInterceptor{
+		unaryInterceptors = append(unaryInterceptors,
 			createGRPCLoggingInterceptor(s.config.otelConfig),
 			createGRPCTracingInterceptor(s.config.otelConfig),
 			createGRPCMetricsInterceptor(s.config.otelConfig),
-		}
-		opts = append(opts, grpc.ChainUnaryInterceptor(unaryInterceptors...))
-
-		// Chain stream interceptors: logging -> metrics -> handler
-		streamInterceptors := []grpc.StreamServerInterceptor{
+		)
+		streamInterceptors = append(streamInterceptors,
 			createGRPCStreamLoggingInterceptor(s.config.otelConfig),
 			createGRPCStreamMetricsInterceptor(s.config.otelConfig),
-		}
-		opts = append(opts, grpc.ChainStreamInterceptor(streamInterceptors...))
+		)
 
 		// Register server uptime/start_time observable gauges
 		registerServerMetrics(s.config.otelConfig)
 	}
 
+	// Gate reflection behind the auth callback if configured
+	if s.config.enableReflection && s.config.reflectionAuth != nil {
+		streamInterceptors = append(streamInterceptors, createGRPCReflectionAuthInterceptor(s.config.reflectionAuth))
+	}
+
+	// Log request/response payloads if enabled
+	if s.config.payloadLogging != nil {
+		unaryInterceptors = append(unaryInterceptors, createGRPCPayloadLoggingInterceptor(*s.config.payloadLogging))
+		streamInterceptors = append(streamInterceptors, createGRPCStreamPayloadLoggingInterceptor(*s.config.payloadLogging))
+	}
+
+	// Add panic recovery interceptors if enabled
+	if s.config.enableRecovery {
+		unaryInterceptors = append(unaryInterceptors, createGRPCRecoveryInterceptor(s.config.otelConfig))
+		streamInterceptors = append(streamInterceptors, createGRPCStreamRecoveryInterceptor(s.config.otelConfig))
+	}
+
+	if len(unaryInterceptors) > 0 {
+		opts = append(opts, grpc.ChainUnaryInterceptor(unaryInterceptors...))
+	}
+	if len(streamInterceptors) > 0 {
+		opts = append(opts, grpc.ChainStreamInterceptor(streamInterceptors...))
+	}
+
 	// Create gRPC server
 	s.grpcServer = grpc.NewServer(opts...)
 
@@ -221,6 +273,10 @@ func (s *Server) Start() error {
 	s.running = true
 	s.mu.Unlock()
 
+	if !s.config.enableGateway {
+		return s.startGRPCOnly()
+	}
+
 	if err := s.setupEchoServer(); err != nil {
 		return fmt.Errorf("failed to setup Echo server: %w", err)
 	}
@@ -259,18 +315,45 @@ func (s *Server) startSeparateMode() error {
 		}
 	}()
 
+	scheme := "http"
+	if s.tlsConfig != nil {
+		scheme = "https"
+	}
+
 	// Start Echo HTTP server
 	s.logInfo(fmt.Sprintf("Echo HTTP server starting on port %s", s.config.httpPort))
 	if s.config.enableHealthCheck {
-		s.logInfo(fmt.Sprintf("Health checks available at http://localhost:%s%s", s.config.httpPort, s.config.healthPath))
+		s.logInfo(fmt.Sprintf("Health checks available at %s://localhost:%s%s", scheme, s.config.httpPort, s.config.healthPath))
 	}
 	if s.config.serviceRegistrar != nil {
-		s.logInfo(fmt.Sprintf("gRPC Gateway available at http://localhost:%s%s", s.config.httpPort, s.config.gatewayBasePath))
+		s.logInfo(fmt.Sprintf("gRPC Gateway available at %s://localhost:%s%s", scheme, s.config.httpPort, s.config.gatewayBasePath))
+	}
+
+	if s.tlsConfig != nil {
+		s.echo.TLSServer.Addr = s.config.getHTTPAddress()
+		s.echo.TLSServer.TLSConfig = s.tlsConfig.Clone()
+		return s.echo.StartServer(s.echo.TLSServer)
 	}
 
 	return s.echo.Start(s.config.getHTTPAddress())
 }
 
+// startGRPCOnly serves gRPC on the gRPC port without any HTTP listener
+func (s *Server) startGRPCOnly() error {
+	grpcListener, err := (&net.ListenConfig{}).Listen(context.Background(), "tcp", s.config.getGRPCAddress())
+	if err != nil {
+		return fmt.Errorf("failed to listen on gRPC port %s: %w", s.config.grpcPort, err)
+	}
+
+	s.logInfo(fmt.Sprintf("gRPC server starting on port %s (gateway disabled)", s.config.grpcPort))
+	if s.config.enableReflection {
+		s.logInfo("gRPC reflection enabled")
+	}
+
+	// Serve closes the listener and returns nil once Stop or GracefulStop is called
+	return s.grpcServer.Serve(grpcListener)
+}
+
 // startH2CMode starts a mixed gRPC/HTTP server on a single port
 func (s *Server) startH2CMode() error {
 	// Create mixed handler for H2C that routes between gRPC and Echo
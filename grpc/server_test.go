@@ -2,9 +2,20 @@ package grpc
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
 	"fmt"
+	"math/big"
 	"net"
 	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -14,8 +25,15 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/status"
 	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 func TestNewServer(t *testing.T) {
@@ -142,6 +160,102 @@ func TestServerStartStop(t *testing.T) {
 	assert.False(t, server.IsRunning())
 }
 
+func TestServerGatewayBasePath(t *testing.T) {
+	server, err := New(
+		WithGRPCPort("8080"),
+		WithGatewayBasePath("/gateway"),
+		WithServiceRegistrar(func(s *grpc.Server) {}),
+	)
+	require.NoError(t, err)
+
+	err = server.setupEchoServer()
+	require.NoError(t, err)
+	require.NotNil(t, server.gatewayMux)
+
+	// Gateway handlers see the full request path, including the base path
+	for _, path := range []string{"/gateway/ping", "/api/v1/ping"} {
+		err = server.gatewayMux.HandlePath(http.MethodGet, path, func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+			_, _ = w.Write([]byte("pong"))
+		})
+		require.NoError(t, err)
+	}
+
+	t.Run("custom base path is served", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		server.echo.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/gateway/ping", nil))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "pong", rec.Body.String())
+	})
+
+	t.Run("default base path is not served", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		server.echo.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/v1/ping", nil))
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
+
+func TestServerWithoutGateway(t *testing.T) {
+	listener1, err := net.Listen("tcp", ":0")
+	require.NoError(t, err)
+	grpcPort := fmt.Sprintf("%d", listener1.Addr().(*net.TCPAddr).Port)
+	listener1.Close()
+
+	listener2, err := net.Listen("tcp", ":0")
+	require.NoError(t, err)
+	httpPort := fmt.Sprintf("%d", listener2.Addr().(*net.TCPAddr).Port)
+	listener2.Close()
+
+	echoConfigured := false
+	server, err := New(
+		WithSeparateMode(grpcPort, httpPort),
+		WithoutGateway(),
+		WithEchoConfigurer(func(e *echo.Echo) {
+			echoConfigured = true
+		}),
+		WithServiceRegistrar(func(s *grpc.Server) {
+			healthpb.RegisterHealthServer(s, health.NewServer())
+		}),
+	)
+	require.NoError(t, err)
+
+	startErr := make(chan error, 1)
+	go func() {
+		startErr <- server.Start()
+	}()
+
+	// Wait for the gRPC port to accept connections
+	require.Eventually(t, func() bool {
+		conn, err := net.DialTimeout("tcp", "localhost:"+grpcPort, 100*time.Millisecond)
+		if err != nil {
+			return false
+		}
+		conn.Close()
+		return true
+	}, 2*time.Second, 20*time.Millisecond, "gRPC port should accept connections")
+
+	// gRPC calls work
+	conn, err := grpc.NewClient("localhost:"+grpcPort, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	resp, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, resp.Status)
+
+	// No HTTP listener is opened
+	_, err = net.DialTimeout("tcp", "localhost:"+httpPort, 100*time.Millisecond)
+	assert.Error(t, err, "HTTP port should not accept connections")
+	assert.Nil(t, server.echo)
+	assert.False(t, echoConfigured, "Echo configurer should not be called")
+
+	require.NoError(t, server.Stop())
+	assert.NoError(t, <-startErr)
+}
+
 func TestServerDoubleStart(t *testing.T) {
 	server, err := New(WithGRPCPort("0")) // Use any available port
 	require.NoError(t, err)
@@ -429,3 +543,221 @@ func TestServerWithAllOptions(t *testing.T) {
 	assert.Equal(t, "/custom-health", server.config.healthPath)
 	assert.Equal(t, "/api/v2", server.config.gatewayBasePath)
 }
+
+// writeSelfSignedCert generates a self-signed certificate for localhost and
+// writes the PEM-encoded certificate and key to a temporary directory.
+func writeSelfSignedCert(t *testing.T) (certFile, keyFile string, pool *x509.CertPool) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		DNSNames:     []string{"localhost"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		IsCA:         true,
+
+		BasicConstraintsValid: true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	certFile = filepath.Join(dir, "cert.pem")
+	keyFile = filepath.Join(dir, "key.pem")
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600))
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	pool = x509.NewCertPool()
+	pool.AddCert(cert)
+
+	return certFile, keyFile, pool
+}
+
+// freePort returns a TCP port that is currently available on the host.
+func freePort(t *testing.T) string {
+	t.Helper()
+	listener, err := net.Listen("tcp", ":0")
+	require.NoError(t, err)
+	defer listener.Close()
+	return fmt.Sprintf("%d", listener.Addr().(*net.TCPAddr).Port)
+}
+
+func TestServerWithTLS(t *testing.T) {
+	certFile, keyFile, pool := writeSelfSignedCert(t)
+	grpcPort := freePort(t)
+	httpPort := freePort(t)
+
+	server, err := New(
+		WithSeparateMode(grpcPort, httpPort),
+		WithTLS(certFile, keyFile),
+		WithServiceRegistrar(func(s *grpc.Server) {
+			healthpb.RegisterHealthServer(s, health.NewServer())
+		}),
+	)
+	require.NoError(t, err)
+	require.NotNil(t, server.tlsConfig)
+
+	go func() {
+		_ = server.Start()
+	}()
+	defer server.Stop()
+
+	// Wait for both listeners to come up
+	require.Eventually(t, func() bool {
+		conn, err := tls.Dial("tcp", "localhost:"+httpPort, &tls.Config{RootCAs: pool})
+		if err != nil {
+			return false
+		}
+		conn.Close()
+		return true
+	}, 5*time.Second, 50*time.Millisecond)
+
+	t.Run("gRPC call over TLS", func(t *testing.T) {
+		conn, err := grpc.NewClient(
+			"localhost:"+grpcPort,
+			grpc.WithTransportCredentials(credentials.NewClientTLSFromCert(pool, "localhost")),
+		)
+		require.NoError(t, err)
+		defer conn.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		resp, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{})
+		require.NoError(t, err)
+		assert.Equal(t, healthpb.HealthCheckResponse_SERVING, resp.GetStatus())
+	})
+
+	t.Run("gRPC call without TLS is rejected", func(t *testing.T) {
+		conn, err := grpc.NewClient(
+			"localhost:"+grpcPort,
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+		)
+		require.NoError(t, err)
+		defer conn.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		_, err = healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{})
+		assert.Error(t, err)
+	})
+
+	t.Run("HTTPS health endpoint", func(t *testing.T) {
+		client := &http.Client{
+			Transport: &http.Transport{TLSClientConfig: &tls.Config{RootCAs: pool}},
+			Timeout:   5 * time.Second,
+		}
+		resp, err := client.Get("https://localhost:" + httpPort + "/health")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	})
+}
+
+func TestServerWithTLSInvalidKeyPair(t *testing.T) {
+	server, err := New(
+		WithSeparateMode("9090", "9091"),
+		WithTLS("missing-cert.pem", "missing-key.pem"),
+	)
+	assert.Error(t, err)
+	assert.Nil(t, server)
+}
+
+// sizeServiceDesc describes a minimal unary service that returns the length
+// of the received payload, used to exercise message size limits.
+var sizeServiceDesc = grpc.ServiceDesc{
+	ServiceName: "test.SizeService",
+	HandlerType: (*any)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Size",
+			Handler: func(_ any, _ context.Context, dec func(any) error, _ grpc.UnaryServerInterceptor) (any, error) {
+				in := new(wrapperspb.BytesValue)
+				if err := dec(in); err != nil {
+					return nil, err
+				}
+				return wrapperspb.Int64(int64(len(in.GetValue()))), nil
+			},
+		},
+	},
+}
+
+// callSizeService starts the server on an in-memory listener and sends a
+// payload of the given size to the size service.
+func callSizeService(t *testing.T, payloadSize int, opts ...Option) (*wrapperspb.Int64Value, error) {
+	t.Helper()
+
+	lis := bufconn.Listen(1024 * 1024)
+	allOpts := append([]Option{
+		WithServiceRegistrar(func(s *grpc.Server) {
+			s.RegisterService(&sizeServiceDesc, struct{}{})
+		}),
+	}, opts...)
+
+	server, err := New(allOpts...)
+	require.NoError(t, err)
+
+	go func() {
+		_ = server.grpcServer.Serve(lis)
+	}()
+	defer server.grpcServer.Stop()
+
+	conn, err := grpc.NewClient(
+		"passthrough:///bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return lis.Dial()
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	out := new(wrapperspb.Int64Value)
+	in := wrapperspb.Bytes(make([]byte, payloadSize))
+	err = conn.Invoke(ctx, "/test.SizeService/Size", in, out)
+	return out, err
+}
+
+func TestServerMaxRecvMsgSize(t *testing.T) {
+	payloadSize := 5 * 1024 * 1024 // Larger than the default 4MB limit
+
+	t.Run("default limit rejects large message", func(t *testing.T) {
+		_, err := callSizeService(t, payloadSize)
+		require.Error(t, err)
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	})
+
+	t.Run("raised limit accepts large message", func(t *testing.T) {
+		out, err := callSizeService(t, payloadSize, WithMaxRecvMsgSize(8*1024*1024))
+		require.NoError(t, err)
+		assert.Equal(t, int64(payloadSize), out.GetValue())
+	})
+}
+
+func TestServerWithKeepaliveParams(t *testing.T) {
+	out, err := callSizeService(t, 1024,
+		WithKeepaliveParams(
+			keepalive.ServerParameters{Time: time.Minute, Timeout: 20 * time.Second},
+			keepalive.EnforcementPolicy{MinTime: 10 * time.Second, PermitWithoutStream: true},
+		),
+		WithMaxSendMsgSize(1024*1024),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1024), out.GetValue())
+}
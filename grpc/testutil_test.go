@@ -0,0 +1,40 @@
+package grpc
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// startBufconnServer creates a server with opts, serves it over an in-memory
+// bufconn listener and returns a client connection to it. Services are
+// registered through WithServiceRegistrar in opts. The server and connection
+// are closed when the test ends.
+func startBufconnServer(t *testing.T, opts ...Option) *grpc.ClientConn {
+	t.Helper()
+
+	server, err := New(opts...)
+	require.NoError(t, err)
+
+	lis := bufconn.Listen(1024 * 1024)
+	go func() {
+		_ = server.grpcServer.Serve(lis)
+	}()
+	t.Cleanup(server.grpcServer.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return lis.Dial()
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return conn
+}
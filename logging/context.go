@@ -0,0 +1,92 @@
+package logging
+
+import "context"
+
+// Field is a key-value pair attached to loggers derived from a context.
+type Field struct {
+	Key   string
+	Value any
+}
+
+// F creates a Field.
+//
+// Example:
+//
+//	ctx = logging.WithFields(ctx, logging.F("request_id", reqID))
+func F(key string, value any) Field {
+	return Field{Key: key, Value: value}
+}
+
+// fieldsKey is the context key for fields stored by WithFields.
+type fieldsKey struct{}
+
+// WithFields returns a copy of ctx carrying fields. Loggers created from the
+// returned context with ContextLogger include these fields, which lets
+// middleware set request-scoped values (request_id, user_id) once for every
+// downstream logger. Fields already in ctx are kept; a field with the same key
+// replaces the earlier value.
+//
+// Example:
+//
+//	func middleware(next http.Handler) http.Handler {
+//	    return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+//	        ctx := logging.WithFields(r.Context(), logging.F("request_id", r.Header.Get("X-Request-ID")))
+//	        next.ServeHTTP(w, r.WithContext(ctx))
+//	    })
+//	}
+func WithFields(ctx context.Context, fields ...Field) context.Context {
+	if len(fields) == 0 {
+		return ctx
+	}
+
+	existing := fieldsFromContext(ctx)
+	merged := make([]Field, 0, len(existing)+len(fields))
+	merged = append(merged, existing...)
+
+	for _, f := range fields {
+		replaced := false
+		for i := range merged {
+			if merged[i].Key == f.Key {
+				merged[i] = f
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			merged = append(merged, f)
+		}
+	}
+
+	return context.WithValue(ctx, fieldsKey{}, merged)
+}
+
+// fieldsFromContext returns the fields stored by WithFields, or nil.
+func fieldsFromContext(ctx context.Context) []Field {
+	if ctx == nil {
+		return nil
+	}
+	fields, _ := ctx.Value(fieldsKey{}).([]Field)
+	return fields
+}
+
+// requestIDKey is the context key for the request ID stored by WithRequestID.
+type requestIDKey struct{}
+
+// WithRequestID returns a copy of ctx carrying requestID, also added as the
+// "request_id" field for ContextLogger. The server package stores the ID of
+// every incoming request this way, and rest.RequestIDMiddleware forwards it on
+// outgoing calls so one ID follows a request across services.
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	ctx = WithFields(ctx, F("request_id", requestID))
+	return context.WithValue(ctx, requestIDKey{}, requestID)
+}
+
+// RequestIDFromContext returns the request ID stored by WithRequestID, or "" if
+// there is none.
+func RequestIDFromContext(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	requestID, _ := ctx.Value(requestIDKey{}).(string)
+	return requestID
+}
@@ -0,0 +1,99 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/rs/zerolog"
+	zlog "github.com/rs/zerolog/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// captureGlobalLogger redirects the global logger to a buffer for the test.
+func captureGlobalLogger(t *testing.T) *bytes.Buffer {
+	t.Helper()
+
+	original := zlog.Logger
+	t.Cleanup(func() { zlog.Logger = original })
+
+	var buf bytes.Buffer
+	zlog.Logger = zerolog.New(&buf)
+	return &buf
+}
+
+func decodeLine(t *testing.T, buf *bytes.Buffer) map[string]any {
+	t.Helper()
+
+	var entry map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+	return entry
+}
+
+func TestWithFields(t *testing.T) {
+	t.Run("logger derived after WithFields emits stored fields", func(t *testing.T) {
+		buf := captureGlobalLogger(t)
+
+		ctx := WithFields(context.Background(), F("request_id", "req-123"), F("user_id", 42))
+		logger := ContextLogger(ctx, "handler")
+		logger.Info().Msg("handled")
+
+		entry := decodeLine(t, buf)
+		assert.Equal(t, "handler", entry["component"])
+		assert.Equal(t, "req-123", entry["request_id"])
+		assert.Equal(t, float64(42), entry["user_id"])
+	})
+
+	t.Run("fresh context has no stored fields", func(t *testing.T) {
+		buf := captureGlobalLogger(t)
+
+		_ = WithFields(context.Background(), F("request_id", "req-123"))
+		logger := ContextLogger(context.Background(), "handler")
+		logger.Info().Msg("handled")
+
+		entry := decodeLine(t, buf)
+		assert.NotContains(t, entry, "request_id")
+	})
+
+	t.Run("nested calls merge and override", func(t *testing.T) {
+		buf := captureGlobalLogger(t)
+
+		parent := WithFields(context.Background(), F("request_id", "req-1"), F("tenant", "acme"))
+		child := WithFields(parent, F("request_id", "req-2"), F("user_id", "u-9"))
+		logger := ContextLogger(child, "handler")
+		logger.Info().Msg("handled")
+
+		entry := decodeLine(t, buf)
+		assert.Equal(t, "req-2", entry["request_id"])
+		assert.Equal(t, "acme", entry["tenant"])
+		assert.Equal(t, "u-9", entry["user_id"])
+
+		// Parent context is unchanged
+		assert.Equal(t, []Field{F("request_id", "req-1"), F("tenant", "acme")}, fieldsFromContext(parent))
+	})
+
+	t.Run("no fields returns same context", func(t *testing.T) {
+		ctx := context.Background()
+		assert.Equal(t, ctx, WithFields(ctx))
+	})
+}
+
+func TestWithRequestID(t *testing.T) {
+	t.Run("stores ID and logger field", func(t *testing.T) {
+		buf := captureGlobalLogger(t)
+
+		ctx := WithRequestID(context.Background(), "req-123")
+		assert.Equal(t, "req-123", RequestIDFromContext(ctx))
+
+		logger := ContextLogger(ctx, "handler")
+		logger.Info().Msg("handled")
+		assert.Equal(t, "req-123", decodeLine(t, buf)["request_id"])
+	})
+
+	t.Run("missing ID is empty", func(t *testing.T) {
+		assert.Empty(t, RequestIDFromContext(context.Background()))
+		assert.Empty(t, RequestIDFromContext(WithFields(context.Background(), F("request_id", "req-123"))))
+	})
+}
@@ -0,0 +1,107 @@
+package logging
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/rs/zerolog"
+)
+
+// SetLevel changes the global log level at runtime. It applies to the global
+// logger and every logger derived from it (including ContextLogger), and is
+// safe for concurrent use.
+//
+// Returns an error if level is not one of the LogLevel constants.
+//
+// Example:
+//
+//	if err := logging.SetLevel(logging.LogLevelDebug); err != nil {
+//	    return err
+//	}
+func SetLevel(level LogLevel) error {
+	zl, ok := toZerologLevel(level)
+	if !ok {
+		return fmt.Errorf("unknown log level %q", level)
+	}
+
+	zerolog.SetGlobalLevel(zl)
+	return nil
+}
+
+// GetLevel returns the current global log level.
+func GetLevel() LogLevel {
+	switch lvl := zerolog.GlobalLevel(); {
+	case lvl == zerolog.Disabled:
+		return LogLevelNone
+	case lvl <= zerolog.DebugLevel:
+		return LogLevelDebug
+	case lvl == zerolog.InfoLevel:
+		return LogLevelInfo
+	case lvl == zerolog.WarnLevel:
+		return LogLevelWarn
+	default:
+		return LogLevelError
+	}
+}
+
+// toZerologLevel maps a LogLevel to the zerolog level.
+func toZerologLevel(level LogLevel) (zerolog.Level, bool) {
+	switch level {
+	case LogLevelDebug:
+		return zerolog.DebugLevel, true
+	case LogLevelInfo:
+		return zerolog.InfoLevel, true
+	case LogLevelWarn:
+		return zerolog.WarnLevel, true
+	case LogLevelError:
+		return zerolog.ErrorLevel, true
+	case LogLevelNone:
+		return zerolog.Disabled, true
+	default:
+		return zerolog.NoLevel, false
+	}
+}
+
+// levelPayload is the JSON body read and written by LevelHandler.
+type levelPayload struct {
+	Level LogLevel `json:"level"`
+}
+
+// LevelHandler returns an http.Handler for reading and changing the log level,
+// intended to be mounted on an admin endpoint.
+//
+//   - GET returns the current level: {"level":"info"}
+//   - PUT or POST with a body of {"level":"debug"} changes it and returns the new level
+//
+// The handler performs no authentication; protect the route accordingly.
+//
+// Example:
+//
+//	mux.Handle("/admin/log-level", logging.LevelHandler())
+//
+//	// curl -X PUT -d '{"level":"debug"}' localhost:8080/admin/log-level
+func LevelHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+		case http.MethodPut, http.MethodPost:
+			var payload levelPayload
+			if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, 1024)).Decode(&payload); err != nil {
+				http.Error(w, fmt.Sprintf("invalid request body: %v", err), http.StatusBadRequest)
+				return
+			}
+			if err := SetLevel(payload.Level); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+		default:
+			w.Header().Set("Allow", "GET, PUT, POST")
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(levelPayload{Level: GetLevel()})
+	})
+}
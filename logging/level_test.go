@@ -0,0 +1,147 @@
+package logging
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// restoreGlobalLevel resets the global zerolog level after the test.
+func restoreGlobalLevel(t *testing.T) {
+	t.Helper()
+
+	original := zerolog.GlobalLevel()
+	t.Cleanup(func() { zerolog.SetGlobalLevel(original) })
+}
+
+func TestSetLevel(t *testing.T) {
+	t.Run("toggles debug output at runtime", func(t *testing.T) {
+		restoreGlobalLevel(t)
+		buf := captureGlobalLogger(t)
+
+		require.NoError(t, SetLevel(LogLevelInfo))
+		logger := ContextLogger(context.Background(), "worker")
+
+		require.NoError(t, SetLevel(LogLevelDebug))
+		assert.Equal(t, LogLevelDebug, GetLevel())
+		logger.Debug().Msg("first debug line")
+		assert.Contains(t, buf.String(), "first debug line")
+
+		buf.Reset()
+		require.NoError(t, SetLevel(LogLevelInfo))
+		assert.Equal(t, LogLevelInfo, GetLevel())
+		logger.Debug().Msg("second debug line")
+		assert.Empty(t, buf.String(), "debug line should be suppressed at info level")
+	})
+
+	t.Run("applies after Initialize", func(t *testing.T) {
+		restoreGlobalLevel(t)
+		captureGlobalLogger(t)
+
+		require.NoError(t, Initialize("level-service", false))
+		assert.Equal(t, LogLevelInfo, GetLevel())
+
+		require.NoError(t, SetLevel(LogLevelWarn))
+		assert.Equal(t, LogLevelWarn, GetLevel())
+	})
+
+	t.Run("round trips all levels", func(t *testing.T) {
+		restoreGlobalLevel(t)
+
+		for _, level := range []LogLevel{LogLevelDebug, LogLevelInfo, LogLevelWarn, LogLevelError, LogLevelNone} {
+			require.NoError(t, SetLevel(level))
+			assert.Equal(t, level, GetLevel())
+		}
+	})
+
+	t.Run("rejects unknown level", func(t *testing.T) {
+		restoreGlobalLevel(t)
+		require.NoError(t, SetLevel(LogLevelInfo))
+
+		err := SetLevel("verbose")
+		require.Error(t, err)
+		assert.Equal(t, LogLevelInfo, GetLevel())
+	})
+
+	t.Run("concurrent use", func(t *testing.T) {
+		restoreGlobalLevel(t)
+
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				level := LogLevelInfo
+				if i%2 == 0 {
+					level = LogLevelDebug
+				}
+				_ = SetLevel(level)
+				_ = GetLevel()
+			}(i)
+		}
+		wg.Wait()
+	})
+}
+
+func TestLevelHandler(t *testing.T) {
+	restoreGlobalLevel(t)
+	require.NoError(t, SetLevel(LogLevelInfo))
+
+	handler := LevelHandler()
+
+	decode := func(t *testing.T, rec *httptest.ResponseRecorder) LogLevel {
+		t.Helper()
+		var payload levelPayload
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &payload))
+		return payload.Level
+	}
+
+	t.Run("get returns current level", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/log-level", nil))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+		assert.Equal(t, LogLevelInfo, decode(t, rec))
+	})
+
+	t.Run("put changes level", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPut, "/log-level", strings.NewReader(`{"level":"debug"}`)))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, LogLevelDebug, decode(t, rec))
+		assert.Equal(t, LogLevelDebug, GetLevel())
+	})
+
+	t.Run("invalid level", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/log-level", strings.NewReader(`{"level":"loud"}`)))
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Equal(t, LogLevelDebug, GetLevel())
+	})
+
+	t.Run("invalid body", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPut, "/log-level", strings.NewReader(`not json`)))
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("unsupported method", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodDelete, "/log-level", nil))
+
+		assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+		assert.Equal(t, "GET, PUT, POST", rec.Header().Get("Allow"))
+	})
+}
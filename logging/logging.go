@@ -6,10 +6,11 @@ import (
 	"io"
 	"os"
 	"sync"
-	"time"
 
 	"github.com/rs/zerolog"
 	zlog "github.com/rs/zerolog/log"
+	"go.opentelemetry.io/otel/trace"
+	"gopkg.in/natefinch/lumberjack.v2"
 )
 
 // initMu protects global logger assignment during initialization.
@@ -25,9 +26,21 @@ const (
 )
 
 // FileConfig configures file-based logging.
-// File rotation should be managed by OS tools like logrotate.
+// When any of MaxSizeMB, MaxBackups, MaxAgeDays or Compress is set, the file is
+// rotated in-process and rotated files are named after Path with a timestamp
+// (e.g. app-2024-01-02T15-04-05.000.log). Otherwise logs are appended to a single
+// file and rotation should be managed by OS tools like logrotate.
 type FileConfig struct {
-	Path string // Log file path (required when OutputFile is used)
+	Path       string // Log file path (required when OutputFile is used)
+	MaxSizeMB  int    // Rotate once the file reaches this size in megabytes (100 if unset while rotating)
+	MaxBackups int    // Maximum number of rotated files to keep (0 keeps all)
+	MaxAgeDays int    // Maximum days to keep rotated files (0 keeps all)
+	Compress   bool   // Gzip rotated files
+}
+
+// rotationEnabled reports whether any rotation setting is configured.
+func (c *FileConfig) rotationEnabled() bool {
+	return c.MaxSizeMB > 0 || c.MaxBackups > 0 || c.MaxAgeDays > 0 || c.Compress
 }
 
 // InitializeWithFile sets up the zerolog global logger with flexible output options.
@@ -42,6 +55,7 @@ type FileConfig struct {
 //   - debug: If true, sets log level to Debug, otherwise Info
 //   - output: Output destination flags (OutputConsole, OutputFile, or both combined with |)
 //   - fileConfig: File configuration (required if OutputFile is specified, can be nil otherwise)
+//   - opts: Optional settings such as WithSampling
 //
 // Returns an io.Closer (non-nil when file output is enabled) and an error if configuration is invalid.
 //
@@ -59,11 +73,21 @@ type FileConfig struct {
 //	closer, err := InitializeWithFile("my-service", true, OutputConsole|OutputFile, &FileConfig{Path: "app.log"})
 //	if err != nil { log.Fatal(err) }
 //	defer closer.Close()
-func InitializeWithFile(serviceName string, debug bool, output OutputDestination, fileConfig *FileConfig) (io.Closer, error) {
+func InitializeWithFile(serviceName string, debug bool, output OutputDestination, fileConfig *FileConfig, opts ...Option) (io.Closer, error) {
 	if serviceName == "" {
 		serviceName = "unknown"
 	}
 
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.sampling != nil {
+		if err := o.sampling.validate(); err != nil {
+			return nil, err
+		}
+	}
+
 	initMu.Lock()
 	defer initMu.Unlock()
 
@@ -80,15 +104,11 @@ func InitializeWithFile(serviceName string, debug bool, output OutputDestination
 	zerolog.SetGlobalLevel(level)
 
 	var writers []io.Writer
-	var file *os.File
+	var closer io.Closer
 
 	// Console output (human-readable, colored)
 	if output&OutputConsole != 0 {
-		consoleWriter := zerolog.ConsoleWriter{
-			Out:        os.Stderr,
-			TimeFormat: time.RFC3339,
-		}
-		writers = append(writers, consoleWriter)
+		writers = append(writers, newConsoleWriter(os.Stderr))
 	}
 
 	// File output (JSON, structured)
@@ -97,13 +117,37 @@ func InitializeWithFile(serviceName string, debug bool, output OutputDestination
 			return nil, fmt.Errorf("fileConfig with Path is required when OutputFile is specified")
 		}
 
-		var err error
-		file, err = os.OpenFile(fileConfig.Path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
-		if err != nil {
-			return nil, fmt.Errorf("failed to open log file %s: %w", fileConfig.Path, err)
+		if fileConfig.MaxSizeMB < 0 || fileConfig.MaxBackups < 0 || fileConfig.MaxAgeDays < 0 {
+			return nil, fmt.Errorf("fileConfig rotation settings must not be negative")
 		}
 
-		writers = append(writers, file)
+		if fileConfig.rotationEnabled() {
+			// The rotating writer opens the file lazily; open it once here so
+			// path errors surface at initialization.
+			file, err := os.OpenFile(fileConfig.Path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+			if err != nil {
+				return nil, fmt.Errorf("failed to open log file %s: %w", fileConfig.Path, err)
+			}
+			_ = file.Close()
+
+			rotator := &lumberjack.Logger{
+				Filename:   fileConfig.Path,
+				MaxSize:    fileConfig.MaxSizeMB,
+				MaxBackups: fileConfig.MaxBackups,
+				MaxAge:     fileConfig.MaxAgeDays,
+				Compress:   fileConfig.Compress,
+			}
+			writers = append(writers, rotator)
+			closer = rotator
+		} else {
+			file, err := os.OpenFile(fileConfig.Path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+			if err != nil {
+				return nil, fmt.Errorf("failed to open log file %s: %w", fileConfig.Path, err)
+			}
+
+			writers = append(writers, file)
+			closer = file
+		}
 	}
 
 	// Ensure at least one output is configured
@@ -133,9 +177,15 @@ func InitializeWithFile(serviceName string, debug bool, output OutputDestination
 		ctx = ctx.Caller()
 	}
 
-	zlog.Logger = ctx.Logger().Level(level)
+	// The logger is not pinned to a level; filtering is done by the global level
+	// so SetLevel can change verbosity at runtime, including for derived loggers.
+	logger := ctx.Logger()
+	if o.sampling != nil {
+		logger = logger.Sample(o.sampling.sampler())
+	}
+	zlog.Logger = logger
 
-	return file, nil
+	return closer, nil
 }
 
 // Initialize sets up the zerolog global logger with standard fields for console-only output.
@@ -153,17 +203,22 @@ func InitializeWithFile(serviceName string, debug bool, output OutputDestination
 //   - serviceName: Name of the service, added as a field to all log entries.
 //     Defaults to "unknown" when empty.
 //   - debug: If true, sets log level to Debug, otherwise Info
+//   - opts: Optional settings such as WithSampling
 //
 // Returns an error if the logger cannot be initialized.
-func Initialize(serviceName string, debug bool) error {
-	_, err := InitializeWithFile(serviceName, debug, OutputConsole, nil)
+func Initialize(serviceName string, debug bool, opts ...Option) error {
+	_, err := InitializeWithFile(serviceName, debug, OutputConsole, nil, opts...)
 	return err
 }
 
 // ContextLogger creates a component-scoped logger from the global logger.
 // The context is associated with the logger for use by zerolog hooks that
-// read from context (e.g., trace correlation), but context.WithValue entries
-// are not automatically extracted into log fields.
+// read from context. Fields stored with WithFields are added to the logger;
+// other context.WithValue entries are not automatically extracted into log fields.
+//
+// When ctx carries a valid OpenTelemetry span context, its trace_id and span_id
+// are added as well, so zerolog output can be correlated with traces the same
+// way as logs emitted through an OTel LoggerProvider.
 //
 // Note: ContextLogger creates a new logger instance on every call. Callers in hot
 // paths should cache the returned logger rather than calling this per-request.
@@ -172,16 +227,27 @@ func Initialize(serviceName string, debug bool) error {
 // can configure TTY detection via the Output option on zerolog.ConsoleWriter directly.
 //
 // Parameters:
-//   - ctx: Context associated with the logger (for hooks and cancellation, not value extraction)
+//   - ctx: Context associated with the logger, source of WithFields fields and the active span
 //   - component: Name of the component, added as a field to all log entries
 //
 // Returns:
 //   - A zerolog.Logger instance with the component field and associated context
 func ContextLogger(ctx context.Context, component string) zerolog.Logger {
-	return zlog.With().
+	logCtx := zlog.With().
 		Ctx(ctx).
-		Str("component", component).
-		Logger()
+		Str("component", component)
+
+	if spanCtx := trace.SpanFromContext(ctx).SpanContext(); spanCtx.IsValid() {
+		logCtx = logCtx.
+			Str("trace_id", spanCtx.TraceID().String()).
+			Str("span_id", spanCtx.SpanID().String())
+	}
+
+	for _, f := range fieldsFromContext(ctx) {
+		logCtx = logCtx.Interface(f.Key, f.Value)
+	}
+
+	return logCtx.Logger()
 }
 
 // LogLevel defines log level strings used by the otel package for cross-package configuration.
@@ -6,11 +6,13 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/rs/zerolog"
 	zlog "github.com/rs/zerolog/log"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 )
 
 func TestInitialize(t *testing.T) {
@@ -25,7 +27,7 @@ func TestInitialize(t *testing.T) {
 
 		// Verify that the global level is set to Debug
 		assert.Equal(t, zerolog.DebugLevel, zerolog.GlobalLevel())
-		assert.Equal(t, zerolog.DebugLevel, zlog.Logger.GetLevel())
+		assert.Equal(t, LogLevelDebug, GetLevel())
 	})
 
 	t.Run("sets info level when debug is false", func(t *testing.T) {
@@ -39,7 +41,7 @@ func TestInitialize(t *testing.T) {
 
 		// Verify that the global level is set to Info
 		assert.Equal(t, zerolog.InfoLevel, zerolog.GlobalLevel())
-		assert.Equal(t, zerolog.InfoLevel, zlog.Logger.GetLevel())
+		assert.Equal(t, LogLevelInfo, GetLevel())
 	})
 
 	t.Run("uses console output by default", func(t *testing.T) {
@@ -260,6 +262,102 @@ func TestInitializeWithFile(t *testing.T) {
 	})
 }
 
+func TestInitializeWithFile_Rotation(t *testing.T) {
+	const megabyte = 1024 * 1024
+
+	writeMegabytes := func(n float64) {
+		payload := strings.Repeat("x", 1024)
+		for i := 0; i < int(n*1024); i++ {
+			zlog.Logger.Info().Str("payload", payload).Msg("rotation")
+		}
+	}
+
+	t.Run("rotates when max size is reached", func(t *testing.T) {
+		original := zlog.Logger
+		t.Cleanup(func() { zlog.Logger = original })
+
+		dir := t.TempDir()
+		logFile := filepath.Join(dir, "app.log")
+		closer, err := InitializeWithFile("rotate-service", false, OutputFile, &FileConfig{
+			Path:       logFile,
+			MaxSizeMB:  1,
+			MaxBackups: 3,
+		})
+		require.NoError(t, err)
+		require.NotNil(t, closer)
+		defer closer.Close()
+
+		writeMegabytes(1.5)
+
+		backups, err := filepath.Glob(filepath.Join(dir, "app-*.log"))
+		require.NoError(t, err)
+		assert.Len(t, backups, 1, "one rotated backup file should exist")
+
+		backupInfo, err := os.Stat(backups[0])
+		require.NoError(t, err)
+		assert.LessOrEqual(t, backupInfo.Size(), int64(megabyte))
+
+		activeInfo, err := os.Stat(logFile)
+		require.NoError(t, err)
+		assert.Less(t, activeInfo.Size(), int64(megabyte), "active file should restart after rotation")
+		assert.Greater(t, activeInfo.Size(), int64(0))
+	})
+
+	t.Run("compresses rotated files", func(t *testing.T) {
+		original := zlog.Logger
+		t.Cleanup(func() { zlog.Logger = original })
+
+		dir := t.TempDir()
+		logFile := filepath.Join(dir, "app.log")
+		closer, err := InitializeWithFile("rotate-service", false, OutputFile, &FileConfig{
+			Path:      logFile,
+			MaxSizeMB: 1,
+			Compress:  true,
+		})
+		require.NoError(t, err)
+		defer closer.Close()
+
+		writeMegabytes(1.5)
+
+		// Compression runs in the background
+		assert.Eventually(t, func() bool {
+			compressed, _ := filepath.Glob(filepath.Join(dir, "app-*.log.gz"))
+			return len(compressed) == 1
+		}, 5*time.Second, 20*time.Millisecond)
+	})
+
+	t.Run("zero values keep single file", func(t *testing.T) {
+		original := zlog.Logger
+		t.Cleanup(func() { zlog.Logger = original })
+
+		logFile := filepath.Join(t.TempDir(), "single.log")
+		closer, err := InitializeWithFile("single-service", false, OutputFile, &FileConfig{Path: logFile})
+		require.NoError(t, err)
+		defer closer.Close()
+
+		_, ok := closer.(*os.File)
+		assert.True(t, ok, "closer should be the plain log file")
+	})
+
+	t.Run("returns error for negative settings", func(t *testing.T) {
+		_, err := InitializeWithFile("bad-service", false, OutputFile, &FileConfig{
+			Path:       filepath.Join(t.TempDir(), "bad.log"),
+			MaxBackups: -1,
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "must not be negative")
+	})
+
+	t.Run("returns error when rotating file cannot be opened", func(t *testing.T) {
+		_, err := InitializeWithFile("bad-service", false, OutputFile, &FileConfig{
+			Path:      "/nonexistent/directory/app.log",
+			MaxSizeMB: 1,
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to open log file")
+	})
+}
+
 func TestContextLogger(t *testing.T) {
 	t.Run("creates logger with component field", func(t *testing.T) {
 		original := zlog.Logger
@@ -337,6 +435,49 @@ func TestContextLogger(t *testing.T) {
 	})
 }
 
+func TestContextLogger_TraceCorrelation(t *testing.T) {
+	tp := sdktrace.NewTracerProvider()
+	t.Cleanup(func() { _ = tp.Shutdown(context.Background()) })
+	tracer := tp.Tracer("logging-test")
+
+	t.Run("adds trace and span IDs of the active span", func(t *testing.T) {
+		buf := captureGlobalLogger(t)
+
+		ctx, parent := tracer.Start(context.Background(), "parent")
+		defer parent.End()
+		require.True(t, parent.IsRecording())
+
+		logger := ContextLogger(ctx, "handler")
+		logger.Info().Msg("in parent")
+
+		entry := decodeLine(t, buf)
+		assert.Equal(t, parent.SpanContext().TraceID().String(), entry["trace_id"])
+		assert.Equal(t, parent.SpanContext().SpanID().String(), entry["span_id"])
+
+		buf.Reset()
+		childCtx, child := tracer.Start(ctx, "child")
+		defer child.End()
+
+		logger = ContextLogger(childCtx, "handler")
+		logger.Info().Msg("in child")
+
+		entry = decodeLine(t, buf)
+		assert.Equal(t, parent.SpanContext().TraceID().String(), entry["trace_id"], "child shares the trace")
+		assert.Equal(t, child.SpanContext().SpanID().String(), entry["span_id"])
+	})
+
+	t.Run("omits IDs without a span", func(t *testing.T) {
+		buf := captureGlobalLogger(t)
+
+		logger := ContextLogger(context.Background(), "handler")
+		logger.Info().Msg("no span")
+
+		entry := decodeLine(t, buf)
+		assert.NotContains(t, entry, "trace_id")
+		assert.NotContains(t, entry, "span_id")
+	})
+}
+
 func TestIntegration(t *testing.T) {
 	tempDir := t.TempDir()
 
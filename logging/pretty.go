@@ -0,0 +1,75 @@
+package logging
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// newConsoleWriter returns the human-readable, colored writer used for OutputConsole.
+func newConsoleWriter(out io.Writer) zerolog.ConsoleWriter {
+	return zerolog.ConsoleWriter{
+		Out:        out,
+		TimeFormat: time.RFC3339,
+	}
+}
+
+// PrettyPrint reads zerolog JSON lines from r, such as a log file written with
+// OutputFile, and writes them to w in the colored console format used by
+// OutputConsole. Blank lines are skipped and lines that are not JSON objects are
+// copied unchanged, so a partially written or mixed file can still be replayed.
+//
+// Returns an error if reading from r or writing to w fails.
+//
+// Example:
+//
+//	file, err := os.Open("app.log")
+//	if err != nil {
+//	    return err
+//	}
+//	defer file.Close()
+//
+//	if err := logging.PrettyPrint(file, os.Stdout); err != nil {
+//	    return err
+//	}
+func PrettyPrint(r io.Reader, w io.Writer) error {
+	console := newConsoleWriter(w)
+	reader := bufio.NewReader(r)
+
+	for {
+		line, readErr := reader.ReadBytes('\n')
+		if readErr != nil && !errors.Is(readErr, io.EOF) {
+			return fmt.Errorf("failed to read log line: %w", readErr)
+		}
+
+		if trimmed := bytes.TrimSpace(line); len(trimmed) > 0 {
+			if err := prettyPrintLine(console, w, trimmed); err != nil {
+				return err
+			}
+		}
+
+		if readErr != nil {
+			return nil
+		}
+	}
+}
+
+// prettyPrintLine renders a single JSON log line, or copies it unchanged if it
+// is not a JSON object.
+func prettyPrintLine(console zerolog.ConsoleWriter, w io.Writer, line []byte) error {
+	if line[0] == '{' {
+		if _, err := console.Write(line); err == nil {
+			return nil
+		}
+	}
+
+	if _, err := w.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("failed to write log line: %w", err)
+	}
+	return nil
+}
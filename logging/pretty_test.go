@@ -0,0 +1,88 @@
+package logging
+
+import (
+	"bytes"
+	"errors"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var ansiEscape = regexp.MustCompile(`\x1b\[[0-9;]*m`)
+
+// plainLines strips colors from console output and splits it into lines.
+func plainLines(out string) []string {
+	return strings.Split(strings.TrimSuffix(ansiEscape.ReplaceAllString(out, ""), "\n"), "\n")
+}
+
+// withoutTimestamp drops the leading timestamp, which is rendered in the local time zone.
+func withoutTimestamp(line string) string {
+	_, rest, _ := strings.Cut(line, " ")
+	return rest
+}
+
+func TestPrettyPrint(t *testing.T) {
+	input := strings.Join([]string{
+		`{"level":"info","service":"my-service","pid":12345,"time":"2025-11-24T12:30:45Z","message":"Service started"}`,
+		``,
+		`{"level":"error","service":"my-service","error":"connection refused","time":"2025-11-24T12:30:46Z","message":"Query failed"}`,
+		`{"level":"debug","component":"db","rows":3,"time":"2025-11-24T12:30:47Z","message":"Loaded users"}`,
+	}, "\n")
+
+	var out bytes.Buffer
+	require.NoError(t, PrettyPrint(strings.NewReader(input), &out))
+
+	lines := plainLines(out.String())
+	require.Len(t, lines, 3, "blank lines should be skipped")
+
+	assert.Equal(t, "INF Service started pid=12345 service=my-service", withoutTimestamp(lines[0]))
+	assert.Equal(t, "ERR Query failed error=\"connection refused\" service=my-service", withoutTimestamp(lines[1]))
+	assert.Equal(t, "DBG Loaded users component=db rows=3", withoutTimestamp(lines[2]))
+}
+
+func TestPrettyPrint_Colored(t *testing.T) {
+	input := `{"level":"info","user_id":"42","time":"2025-11-24T12:30:45Z","message":"User logged in"}`
+
+	var out bytes.Buffer
+	require.NoError(t, PrettyPrint(strings.NewReader(input), &out))
+
+	assert.True(t, ansiEscape.MatchString(out.String()), "output should be colored like OutputConsole")
+}
+
+func TestPrettyPrint_NonJSONLinesPassThrough(t *testing.T) {
+	input := "panic: something went wrong\n" +
+		`{"level":"warn","time":"2025-11-24T12:30:45Z","message":"Retrying"}` + "\n" +
+		`{"level":"info","message":"truncated` + "\n"
+
+	var out bytes.Buffer
+	require.NoError(t, PrettyPrint(strings.NewReader(input), &out))
+
+	lines := plainLines(out.String())
+	require.Len(t, lines, 3)
+	assert.Equal(t, "panic: something went wrong", lines[0])
+	assert.Equal(t, "WRN Retrying", withoutTimestamp(lines[1]))
+	assert.Equal(t, `{"level":"info","message":"truncated`, lines[2])
+}
+
+func TestPrettyPrint_Errors(t *testing.T) {
+	t.Run("read error", func(t *testing.T) {
+		err := PrettyPrint(errReader{}, &bytes.Buffer{})
+		assert.ErrorContains(t, err, "failed to read log line")
+	})
+
+	t.Run("write error", func(t *testing.T) {
+		err := PrettyPrint(strings.NewReader("not json\n"), errWriter{})
+		assert.ErrorContains(t, err, "failed to write log line")
+	})
+}
+
+type errReader struct{}
+
+func (errReader) Read([]byte) (int, error) { return 0, errors.New("disk failure") }
+
+type errWriter struct{}
+
+func (errWriter) Write([]byte) (int, error) { return 0, errors.New("broken pipe") }
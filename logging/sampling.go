@@ -0,0 +1,64 @@
+package logging
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// Option configures Initialize and InitializeWithFile.
+type Option func(*options)
+
+// options holds the optional settings applied by Option.
+type options struct {
+	sampling *SamplingConfig
+}
+
+// SamplingConfig rate-limits repetitive logs in hot paths. At most Burst debug
+// and info entries are written per Period; further entries in the same period
+// are dropped. Warn and error entries are never sampled.
+type SamplingConfig struct {
+	Burst  int           // Entries written per period (must be positive)
+	Period time.Duration // Length of each sampling period (must be positive)
+}
+
+// WithSampling enables log sampling. Sampling is disabled by default.
+//
+// Example:
+//
+//	// At most 100 debug/info entries per second
+//	err := logging.Initialize("my-service", false, logging.WithSampling(logging.SamplingConfig{
+//	    Burst:  100,
+//	    Period: time.Second,
+//	}))
+func WithSampling(cfg SamplingConfig) Option {
+	return func(o *options) {
+		o.sampling = &cfg
+	}
+}
+
+// validate checks the sampling settings.
+func (c *SamplingConfig) validate() error {
+	if c.Burst <= 0 || uint64(c.Burst) > math.MaxUint32 {
+		return fmt.Errorf("sampling burst must be between 1 and %d, got %d", uint32(math.MaxUint32), c.Burst)
+	}
+	if c.Period <= 0 {
+		return fmt.Errorf("sampling period must be positive, got %s", c.Period)
+	}
+	return nil
+}
+
+// sampler returns a sampler that shares one burst budget between debug and info
+// entries and passes other levels through.
+func (c *SamplingConfig) sampler() zerolog.Sampler {
+	burst := &zerolog.BurstSampler{
+		Burst:  uint32(c.Burst),
+		Period: c.Period,
+	}
+	return zerolog.LevelSampler{
+		DebugSampler: burst,
+		InfoSampler:  burst,
+	}
+}
@@ -0,0 +1,112 @@
+package logging
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	zlog "github.com/rs/zerolog/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// initSampledFileLogger initializes the global logger with file output and the
+// given options, and returns a function reading the written lines.
+func initSampledFileLogger(t *testing.T, opts ...Option) func() []string {
+	t.Helper()
+
+	original := zlog.Logger
+	t.Cleanup(func() { zlog.Logger = original })
+
+	logFile := filepath.Join(t.TempDir(), "app.log")
+	closer, err := InitializeWithFile("sampling-service", true, OutputFile, &FileConfig{Path: logFile}, opts...)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = closer.Close() })
+
+	return func() []string {
+		content, err := os.ReadFile(logFile)
+		require.NoError(t, err)
+		return strings.Split(strings.TrimSpace(string(content)), "\n")
+	}
+}
+
+func TestWithSampling(t *testing.T) {
+	t.Run("bounds identical info lines by burst", func(t *testing.T) {
+		lines := initSampledFileLogger(t, WithSampling(SamplingConfig{Burst: 10, Period: time.Minute}))
+
+		for i := 0; i < 1000; i++ {
+			zlog.Info().Str("path", "/dashboard").Msg("request served")
+		}
+
+		assert.Len(t, lines(), 10)
+	})
+
+	t.Run("shares the budget with debug and derived loggers", func(t *testing.T) {
+		lines := initSampledFileLogger(t, WithSampling(SamplingConfig{Burst: 5, Period: time.Minute}))
+
+		logger := ContextLogger(t.Context(), "analytics")
+		for i := 0; i < 100; i++ {
+			zlog.Debug().Msg("debug")
+			logger.Info().Msg("info")
+		}
+
+		assert.Len(t, lines(), 5)
+	})
+
+	t.Run("never samples warnings and errors", func(t *testing.T) {
+		lines := initSampledFileLogger(t, WithSampling(SamplingConfig{Burst: 1, Period: time.Minute}))
+
+		for i := 0; i < 50; i++ {
+			zlog.Info().Msg("info")
+			zlog.Warn().Msg("warn")
+			zlog.Error().Msg("error")
+		}
+
+		assert.Len(t, lines(), 101)
+	})
+
+	t.Run("resets after the period", func(t *testing.T) {
+		lines := initSampledFileLogger(t, WithSampling(SamplingConfig{Burst: 3, Period: 50 * time.Millisecond}))
+
+		for i := 0; i < 10; i++ {
+			zlog.Info().Msg("first period")
+		}
+		time.Sleep(100 * time.Millisecond)
+		for i := 0; i < 10; i++ {
+			zlog.Info().Msg("second period")
+		}
+
+		assert.Len(t, lines(), 6)
+	})
+
+	t.Run("disabled by default", func(t *testing.T) {
+		lines := initSampledFileLogger(t)
+
+		for i := 0; i < 1000; i++ {
+			zlog.Info().Msg("request served")
+		}
+
+		assert.Len(t, lines(), 1000)
+	})
+
+	t.Run("rejects invalid config", func(t *testing.T) {
+		tests := []struct {
+			name string
+			cfg  SamplingConfig
+			want string
+		}{
+			{"zero burst", SamplingConfig{Burst: 0, Period: time.Second}, "sampling burst"},
+			{"negative burst", SamplingConfig{Burst: -1, Period: time.Second}, "sampling burst"},
+			{"zero period", SamplingConfig{Burst: 10}, "sampling period"},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				err := Initialize("sampling-service", false, WithSampling(tt.cfg))
+				assert.ErrorContains(t, err, tt.want)
+			})
+		}
+	})
+}
@@ -0,0 +1,55 @@
+package logging
+
+import (
+	"bytes"
+	"io"
+	"log"
+
+	"github.com/rs/zerolog"
+	zlog "github.com/rs/zerolog/log"
+)
+
+// LevelWriter returns an io.Writer that logs every write as one entry at level
+// through the global logger, with the given component field. Trailing newlines
+// are trimmed and empty writes are dropped. It is meant for libraries that only
+// accept an io.Writer; an unknown level logs at info.
+//
+// The global logger is resolved on each write, so writers created before
+// Initialize pick up its configuration.
+//
+// Example:
+//
+//	cmd.Stderr = logging.LevelWriter("ffmpeg", logging.LogLevelWarn)
+func LevelWriter(component string, level LogLevel) io.Writer {
+	zl, ok := toZerologLevel(level)
+	if !ok {
+		zl = zerolog.InfoLevel
+	}
+	return &levelWriter{component: component, level: zl}
+}
+
+// StdLogger returns a standard library *log.Logger that writes through
+// LevelWriter, for libraries that accept a *log.Logger. The logger has no
+// prefix or flags since each entry is already timestamped.
+//
+// Example:
+//
+//	srv := &http.Server{
+//	    ErrorLog: logging.StdLogger("http.server", logging.LogLevelError),
+//	}
+func StdLogger(component string, level LogLevel) *log.Logger {
+	return log.New(LevelWriter(component, level), "", 0)
+}
+
+type levelWriter struct {
+	component string
+	level     zerolog.Level
+}
+
+func (w *levelWriter) Write(p []byte) (int, error) {
+	msg := bytes.TrimRight(p, "\r\n")
+	if len(msg) > 0 {
+		zlog.Logger.WithLevel(w.level).Str("component", w.component).Msg(string(msg))
+	}
+	return len(p), nil
+}
@@ -0,0 +1,71 @@
+package logging
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStdLogger(t *testing.T) {
+	buf := captureGlobalLogger(t)
+
+	logger := StdLogger("aws.sdk", LogLevelWarn)
+	logger.Printf("retrying request %d", 2)
+
+	entry := decodeLine(t, buf)
+	assert.Equal(t, "aws.sdk", entry["component"])
+	assert.Equal(t, "warn", entry["level"])
+	assert.Equal(t, "retrying request 2", entry["message"])
+}
+
+func TestLevelWriter(t *testing.T) {
+	t.Run("each write is one entry", func(t *testing.T) {
+		buf := captureGlobalLogger(t)
+
+		w := LevelWriter("worker", LogLevelError)
+		n, err := fmt.Fprint(w, "connection reset\r\n")
+		require.NoError(t, err)
+		assert.Equal(t, len("connection reset\r\n"), n)
+
+		entry := decodeLine(t, buf)
+		assert.Equal(t, "worker", entry["component"])
+		assert.Equal(t, "error", entry["level"])
+		assert.Equal(t, "connection reset", entry["message"])
+	})
+
+	t.Run("empty writes are dropped", func(t *testing.T) {
+		buf := captureGlobalLogger(t)
+
+		_, err := fmt.Fprint(LevelWriter("worker", LogLevelInfo), "\n")
+		require.NoError(t, err)
+		assert.Empty(t, buf.String())
+	})
+
+	t.Run("unknown level logs at info", func(t *testing.T) {
+		buf := captureGlobalLogger(t)
+
+		_, err := fmt.Fprint(LevelWriter("worker", LogLevel("verbose")), "hello")
+		require.NoError(t, err)
+		assert.Equal(t, "info", decodeLine(t, buf)["level"])
+	})
+
+	t.Run("none level discards", func(t *testing.T) {
+		buf := captureGlobalLogger(t)
+
+		_, err := fmt.Fprint(LevelWriter("worker", LogLevelNone), "hello")
+		require.NoError(t, err)
+		assert.Empty(t, buf.String())
+	})
+
+	t.Run("respects global level", func(t *testing.T) {
+		restoreGlobalLevel(t)
+		buf := captureGlobalLogger(t)
+		require.NoError(t, SetLevel(LogLevelInfo))
+
+		_, err := fmt.Fprint(LevelWriter("worker", LogLevelDebug), "noisy")
+		require.NoError(t, err)
+		assert.Empty(t, buf.String())
+	})
+}
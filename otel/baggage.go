@@ -0,0 +1,54 @@
+package otel
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/baggage"
+)
+
+// SetBaggage returns a copy of ctx whose W3C baggage carries the given entries,
+// in addition to any baggage already present. Existing entries with the same key
+// are replaced. Non-string values are stored in their string form.
+//
+// Baggage travels with outgoing requests made by the rest client and is extracted
+// by the grpc server and Echo middleware, so it is suited for cross-service
+// business context such as tenant IDs. It is sent in clear text on every hop;
+// never put secrets in it. Entries that cannot be stored as baggage (e.g. an empty
+// key) are skipped.
+//
+// Example:
+//
+//	ctx = otel.SetBaggage(ctx,
+//	    attribute.String("tenant.id", tenantID),
+//	    attribute.Int("request.priority", 2),
+//	)
+func SetBaggage(ctx context.Context, kv ...attribute.KeyValue) context.Context {
+	bag := baggage.FromContext(ctx)
+	for _, entry := range kv {
+		member, err := baggage.NewMemberRaw(string(entry.Key), entry.Value.Emit())
+		if err != nil {
+			continue
+		}
+		if updated, err := bag.SetMember(member); err == nil {
+			bag = updated
+		}
+	}
+	return baggage.ContextWithBaggage(ctx, bag)
+}
+
+// GetBaggage returns the baggage value stored under key in ctx.
+// The boolean is false if ctx carries no such entry.
+//
+// Example:
+//
+//	if tenantID, ok := otel.GetBaggage(ctx, "tenant.id"); ok {
+//	    logger.Info("Handling request", otel.F("tenant.id", tenantID))
+//	}
+func GetBaggage(ctx context.Context, key string) (string, bool) {
+	member := baggage.FromContext(ctx).Member(key)
+	if member.Key() == "" {
+		return "", false
+	}
+	return member.Value(), true
+}
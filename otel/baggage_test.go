@@ -0,0 +1,64 @@
+package otel
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func TestSetBaggage(t *testing.T) {
+	ctx := SetBaggage(context.Background(),
+		attribute.String("tenant.id", "acme"),
+		attribute.Int("request.priority", 2),
+		attribute.Bool("beta", true),
+	)
+
+	tenant, ok := GetBaggage(ctx, "tenant.id")
+	assert.True(t, ok)
+	assert.Equal(t, "acme", tenant)
+
+	priority, ok := GetBaggage(ctx, "request.priority")
+	assert.True(t, ok)
+	assert.Equal(t, "2", priority)
+
+	beta, ok := GetBaggage(ctx, "beta")
+	assert.True(t, ok)
+	assert.Equal(t, "true", beta)
+}
+
+func TestSetBaggage_MergesWithExisting(t *testing.T) {
+	ctx := SetBaggage(context.Background(),
+		attribute.String("tenant.id", "acme"),
+		attribute.String("region", "eu"),
+	)
+	ctx = SetBaggage(ctx, attribute.String("tenant.id", "globex"))
+
+	tenant, _ := GetBaggage(ctx, "tenant.id")
+	assert.Equal(t, "globex", tenant)
+
+	region, ok := GetBaggage(ctx, "region")
+	assert.True(t, ok)
+	assert.Equal(t, "eu", region)
+}
+
+func TestSetBaggage_SkipsInvalidKeys(t *testing.T) {
+	ctx := SetBaggage(context.Background(),
+		attribute.String("", "x"),
+		attribute.String("tenant.id", "acme"),
+	)
+
+	_, ok := GetBaggage(ctx, "")
+	assert.False(t, ok)
+
+	tenant, ok := GetBaggage(ctx, "tenant.id")
+	assert.True(t, ok)
+	assert.Equal(t, "acme", tenant)
+}
+
+func TestGetBaggage_Missing(t *testing.T) {
+	value, ok := GetBaggage(context.Background(), "tenant.id")
+	assert.False(t, ok)
+	assert.Empty(t, value)
+}
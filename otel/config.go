@@ -79,6 +79,23 @@ func NewConfig(serviceName string) *Config {
 	}
 }
 
+// Disabled returns a configuration with no-op tracer, meter and logger providers,
+// for code that requires a non-nil *Config but should emit no telemetry. Since
+// the providers are set, the Is*Enabled methods report true and instrumentation
+// runs against no-op implementations; TracingEnabled, MetricsEnabled and
+// LoggingEnabled report false.
+//
+// Example:
+//
+//	client := rest.NewClient(rest.WithOTelConfig(otel.Disabled()))
+func Disabled() *Config {
+	return &Config{
+		TracerProvider: noopTracerProvider,
+		MeterProvider:  noopMeterProvider,
+		LoggerProvider: noopLoggerProvider,
+	}
+}
+
 // WithTracerProvider sets the TracerProvider for distributed tracing
 func (c *Config) WithTracerProvider(tp trace.TracerProvider) *Config {
 	c.TracerProvider = tp
@@ -223,6 +240,43 @@ func (c *Config) IsLoggingEnabled() bool {
 	return c != nil && c.LoggerProvider != nil
 }
 
+// TracingEnabled reports whether a real TracerProvider is configured. Unlike
+// IsTracingEnabled, a no-op provider counts as disabled, so callers can skip
+// work that only feeds telemetry.
+//
+// Example:
+//
+//	if cfg.TracingEnabled() {
+//	    span.SetAttributes(attribute.String("payload", string(body)))
+//	}
+func (c *Config) TracingEnabled() bool {
+	if !c.IsTracingEnabled() {
+		return false
+	}
+	_, noop := c.TracerProvider.(noopt.TracerProvider)
+	return !noop
+}
+
+// MetricsEnabled reports whether a real MeterProvider is configured. Unlike
+// IsMetricsEnabled, a no-op provider counts as disabled.
+func (c *Config) MetricsEnabled() bool {
+	if !c.IsMetricsEnabled() {
+		return false
+	}
+	_, noop := c.MeterProvider.(noopm.MeterProvider)
+	return !noop
+}
+
+// LoggingEnabled reports whether a real LoggerProvider is configured. Unlike
+// IsLoggingEnabled, a no-op provider counts as disabled.
+func (c *Config) LoggingEnabled() bool {
+	if !c.IsLoggingEnabled() {
+		return false
+	}
+	_, noop := c.LoggerProvider.(noopl.LoggerProvider)
+	return !noop
+}
+
 // GetTracer returns a tracer for the given instrumentation scope.
 // Returns a no-op tracer if tracing is not configured.
 func (c *Config) GetTracer(scopeName string, opts ...trace.TracerOption) trace.Tracer {
@@ -8,6 +8,8 @@ import (
 	noopl "go.opentelemetry.io/otel/log/noop"
 	"go.opentelemetry.io/otel/metric"
 	noopm "go.opentelemetry.io/otel/metric/noop"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	"go.opentelemetry.io/otel/trace"
 	noopt "go.opentelemetry.io/otel/trace/noop"
 )
@@ -428,6 +430,67 @@ func TestIsLoggingEnabled(t *testing.T) {
 	})
 }
 
+func TestDisabled(t *testing.T) {
+	cfg := Disabled()
+
+	if cfg.TracingEnabled() || cfg.MetricsEnabled() || cfg.LoggingEnabled() {
+		t.Errorf("expected all pillars disabled, got tracing=%v metrics=%v logging=%v",
+			cfg.TracingEnabled(), cfg.MetricsEnabled(), cfg.LoggingEnabled())
+	}
+
+	// Providers are set, so instrumentation runs against no-op implementations
+	if !cfg.IsTracingEnabled() || !cfg.IsMetricsEnabled() || !cfg.IsLoggingEnabled() {
+		t.Error("expected no-op providers to be configured")
+	}
+	_, span := cfg.GetTracer("test").Start(context.Background(), "op")
+	if span.IsRecording() {
+		t.Error("expected no-op tracer to create non-recording spans")
+	}
+}
+
+func TestPillarEnabled(t *testing.T) {
+	t.Run("nil config", func(t *testing.T) {
+		var cfg *Config
+		if cfg.TracingEnabled() || cfg.MetricsEnabled() || cfg.LoggingEnabled() {
+			t.Error("expected nil config to report all pillars disabled")
+		}
+	})
+
+	t.Run("unset providers", func(t *testing.T) {
+		cfg := NewConfig("test-service").WithoutLogging()
+		if cfg.TracingEnabled() || cfg.MetricsEnabled() || cfg.LoggingEnabled() {
+			t.Error("expected unset providers to report disabled")
+		}
+	})
+
+	t.Run("no-op providers", func(t *testing.T) {
+		cfg := NewConfig("test-service").
+			WithTracerProvider(noopt.NewTracerProvider()).
+			WithMeterProvider(noopm.NewMeterProvider()).
+			WithLoggerProvider(noopl.NewLoggerProvider())
+		if cfg.TracingEnabled() || cfg.MetricsEnabled() || cfg.LoggingEnabled() {
+			t.Error("expected no-op providers to report disabled")
+		}
+	})
+
+	t.Run("real providers", func(t *testing.T) {
+		tp := sdktrace.NewTracerProvider()
+		mp := sdkmetric.NewMeterProvider()
+		cfg := NewConfig("test-service").WithTracerProvider(tp).WithMeterProvider(mp)
+		defer func() { _ = cfg.Shutdown(context.Background()) }()
+
+		if !cfg.TracingEnabled() {
+			t.Error("expected TracingEnabled with SDK TracerProvider")
+		}
+		if !cfg.MetricsEnabled() {
+			t.Error("expected MetricsEnabled with SDK MeterProvider")
+		}
+		if !cfg.LoggingEnabled() {
+			t.Error("expected LoggingEnabled with default LoggerProvider")
+		}
+	})
+}
+
 func TestGetTracer(t *testing.T) {
 	t.Run("returns no-op tracer when tracing is disabled", func(t *testing.T) {
 		cfg := NewConfig("test-service")
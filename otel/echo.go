@@ -0,0 +1,89 @@
+package otel
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.27.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// EchoMiddleware returns Echo middleware that starts a server span for every
+// request. Incoming W3C Trace Context (traceparent/tracestate) headers are
+// extracted so the span continues the caller's trace, along with W3C baggage
+// (see GetBaggage). The span and config are stored in the request context, so
+// loggers, DB calls and StartSpan inside handlers are correlated with it.
+//
+// The span is named "METHOD /route" and records the method, route and response
+// status. Handler errors are recorded on the span; only 5xx responses mark it
+// as failed.
+//
+// When config is nil or tracing is disabled, the middleware is a pass-through.
+//
+// Example:
+//
+//	e := echo.New()
+//	e.Use(otel.EchoMiddleware(otelConfig))
+//	e.GET("/users/:id", func(c echo.Context) error {
+//	    logger := otel.NewLogHelper(c.Request().Context(), otelConfig, "handler.user", "GetUser")
+//	    logger.Info("Fetching user") // carries trace_id/span_id of the request span
+//	    return c.JSON(http.StatusOK, user)
+//	})
+func EchoMiddleware(config *Config) echo.MiddlewareFunc {
+	if config == nil || !config.IsTracingEnabled() {
+		return func(next echo.HandlerFunc) echo.HandlerFunc {
+			return next
+		}
+	}
+
+	tracer := config.GetTracer("http.server")
+	propagator := propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{})
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+			ctx := propagator.Extract(req.Context(), propagation.HeaderCarrier(req.Header))
+
+			route := c.Path()
+			spanName := req.Method
+			if route != "" {
+				spanName = fmt.Sprintf("%s %s", req.Method, route)
+			}
+
+			ctx, span := tracer.Start(ctx, spanName,
+				trace.WithSpanKind(trace.SpanKindServer),
+				trace.WithAttributes(
+					semconv.HTTPRequestMethodKey.String(req.Method),
+					semconv.HTTPRouteKey.String(route),
+					semconv.URLPath(req.URL.Path),
+					semconv.UserAgentOriginal(req.UserAgent()),
+				),
+			)
+			defer span.End()
+
+			c.SetRequest(req.WithContext(ContextWithConfig(ctx, config)))
+
+			err := next(c)
+			if err != nil {
+				// Commit the error response so the recorded status matches what the client sees
+				c.Error(err)
+				span.RecordError(err)
+			}
+
+			status := c.Response().Status
+			span.SetAttributes(semconv.HTTPResponseStatusCode(status))
+			if status >= http.StatusInternalServerError {
+				msg := http.StatusText(status)
+				if err != nil {
+					msg = err.Error()
+				}
+				span.SetStatus(codes.Error, msg)
+			}
+
+			return err
+		}
+	}
+}
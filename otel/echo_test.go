@@ -0,0 +1,161 @@
+package otel
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func newEchoTestServer(t *testing.T) (*echo.Echo, *tracetest.SpanRecorder, *Config) {
+	t.Helper()
+
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	t.Cleanup(func() { _ = tp.Shutdown(context.Background()) })
+
+	cfg := NewConfig("test-service").WithTracerProvider(tp).WithoutLogging()
+
+	e := echo.New()
+	e.Use(EchoMiddleware(cfg))
+	return e, recorder, cfg
+}
+
+func spanAttribute(span sdktrace.ReadOnlySpan, key attribute.Key) attribute.Value {
+	for _, kv := range span.Attributes() {
+		if kv.Key == key {
+			return kv.Value
+		}
+	}
+	return attribute.Value{}
+}
+
+func TestEchoMiddleware_ContinuesIncomingTrace(t *testing.T) {
+	e, recorder, cfg := newEchoTestServer(t)
+
+	var handlerSpan trace.SpanContext
+	var handlerConfig *Config
+	e.GET("/users/:id", func(c echo.Context) error {
+		handlerSpan = trace.SpanContextFromContext(c.Request().Context())
+		handlerConfig = ConfigFromContext(c.Request().Context())
+		return c.String(http.StatusOK, "ok")
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/users/42", nil)
+	req.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	span := spans[0]
+
+	assert.Equal(t, "GET /users/:id", span.Name())
+	assert.Equal(t, trace.SpanKindServer, span.SpanKind())
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", span.SpanContext().TraceID().String())
+	assert.Equal(t, "00f067aa0ba902b7", span.Parent().SpanID().String())
+	assert.True(t, span.Parent().IsRemote())
+
+	assert.Equal(t, "GET", spanAttribute(span, "http.request.method").AsString())
+	assert.Equal(t, "/users/:id", spanAttribute(span, "http.route").AsString())
+	assert.Equal(t, int64(http.StatusOK), spanAttribute(span, "http.response.status_code").AsInt64())
+	assert.Equal(t, codes.Unset, span.Status().Code)
+
+	// Handlers see the request span and the config
+	assert.Equal(t, span.SpanContext().SpanID(), handlerSpan.SpanID())
+	assert.Same(t, cfg, handlerConfig)
+}
+
+func TestEchoMiddleware_ExtractsBaggage(t *testing.T) {
+	e, _, _ := newEchoTestServer(t)
+
+	var tenant string
+	e.GET("/ping", func(c echo.Context) error {
+		tenant, _ = GetBaggage(c.Request().Context(), "tenant.id")
+		return c.NoContent(http.StatusNoContent)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/ping", nil)
+	req.Header.Set("baggage", "tenant.id=acme,request.priority=2")
+	e.ServeHTTP(httptest.NewRecorder(), req)
+
+	assert.Equal(t, "acme", tenant)
+}
+
+func TestEchoMiddleware_StartsNewTraceWithoutHeader(t *testing.T) {
+	e, recorder, _ := newEchoTestServer(t)
+	e.GET("/ping", func(c echo.Context) error {
+		return c.NoContent(http.StatusNoContent)
+	})
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/ping", nil))
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	assert.False(t, spans[0].Parent().IsValid())
+	assert.True(t, spans[0].SpanContext().IsValid())
+}
+
+func TestEchoMiddleware_ErrorStatus(t *testing.T) {
+	e, recorder, _ := newEchoTestServer(t)
+	e.GET("/missing", func(c echo.Context) error {
+		return echo.NewHTTPError(http.StatusNotFound, "not found")
+	})
+	e.GET("/broken", func(c echo.Context) error {
+		return errors.New("boom")
+	})
+
+	tests := []struct {
+		path   string
+		status int
+		code   codes.Code
+	}{
+		{path: "/missing", status: http.StatusNotFound, code: codes.Unset},
+		{path: "/broken", status: http.StatusInternalServerError, code: codes.Error},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			recorder.Reset()
+
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tt.path, nil))
+			assert.Equal(t, tt.status, rec.Code)
+
+			spans := recorder.Ended()
+			require.Len(t, spans, 1)
+			assert.Equal(t, int64(tt.status), spanAttribute(spans[0], "http.response.status_code").AsInt64())
+			assert.Equal(t, tt.code, spans[0].Status().Code)
+			assert.Len(t, spans[0].Events(), 1, "handler error should be recorded")
+		})
+	}
+}
+
+func TestEchoMiddleware_TracingDisabled(t *testing.T) {
+	called := false
+	handler := func(c echo.Context) error {
+		called = true
+		return nil
+	}
+
+	for _, cfg := range []*Config{nil, NewConfig("test-service")} {
+		e := echo.New()
+		c := e.NewContext(httptest.NewRequest(http.MethodGet, "/", nil), httptest.NewRecorder())
+		require.NoError(t, EchoMiddleware(cfg)(handler)(c))
+		assert.True(t, called)
+		called = false
+	}
+}
@@ -0,0 +1,129 @@
+package otel
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploghttp"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+// ConfigFromEnv builds a Config from the standard OTEL_* environment variables.
+//
+// A signal is exported over OTLP/HTTP when OTEL_EXPORTER_OTLP_ENDPOINT or its
+// signal-specific variant (OTEL_EXPORTER_OTLP_TRACES_ENDPOINT,
+// OTEL_EXPORTER_OTLP_METRICS_ENDPOINT, OTEL_EXPORTER_OTLP_LOGS_ENDPOINT) is set,
+// unless OTEL_{TRACES,METRICS,LOGS}_EXPORTER is "none". Signals without an
+// endpoint are left nil, which results in no-op implementations.
+//
+// The exporters and SDK providers read the remaining variables themselves, e.g.
+// OTEL_EXPORTER_OTLP_HEADERS, OTEL_EXPORTER_OTLP_TIMEOUT, OTEL_TRACES_SAMPLER,
+// OTEL_TRACES_SAMPLER_ARG and OTEL_RESOURCE_ATTRIBUTES. OTEL_SERVICE_NAME takes
+// precedence over serviceName. Only the "http/protobuf" protocol is supported.
+//
+// The returned shutdown function flushes and shuts down all providers. It is safe
+// to call more than once; later calls return the result of the first.
+//
+// Example:
+//
+//	cfg, shutdown, err := otel.ConfigFromEnv(ctx, "my-service")
+//	if err != nil {
+//	    return err
+//	}
+//	defer shutdown(context.Background())
+func ConfigFromEnv(ctx context.Context, serviceName string) (*Config, func(context.Context) error, error) {
+	if name := os.Getenv("OTEL_SERVICE_NAME"); name != "" {
+		serviceName = name
+	}
+
+	cfg := &Config{ServiceName: serviceName}
+
+	if protocol := os.Getenv("OTEL_EXPORTER_OTLP_PROTOCOL"); protocol != "" && protocol != "http/protobuf" {
+		return nil, nil, fmt.Errorf("unsupported OTLP protocol %q, only http/protobuf is supported", protocol)
+	}
+
+	tracesEnabled := otlpSignalEnabled("TRACES")
+	metricsEnabled := otlpSignalEnabled("METRICS")
+	logsEnabled := otlpSignalEnabled("LOGS")
+
+	if !tracesEnabled && !metricsEnabled && !logsEnabled {
+		return cfg, func(context.Context) error { return nil }, nil
+	}
+
+	res, err := resource.New(ctx,
+		resource.WithAttributes(semconv.ServiceNameKey.String(serviceName)),
+		resource.WithFromEnv(),
+		resource.WithTelemetrySDK(),
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create resource: %w", err)
+	}
+
+	if tracesEnabled {
+		exporter, err := otlptracehttp.New(ctx)
+		if err != nil {
+			_ = cfg.Shutdown(ctx)
+			return nil, nil, fmt.Errorf("failed to create OTLP trace exporter: %w", err)
+		}
+		cfg.TracerProvider = sdktrace.NewTracerProvider(
+			sdktrace.WithBatcher(exporter),
+			sdktrace.WithResource(res),
+		)
+	}
+
+	if metricsEnabled {
+		exporter, err := otlpmetrichttp.New(ctx)
+		if err != nil {
+			_ = cfg.Shutdown(ctx)
+			return nil, nil, fmt.Errorf("failed to create OTLP metric exporter: %w", err)
+		}
+		cfg.MeterProvider = sdkmetric.NewMeterProvider(
+			sdkmetric.WithReader(sdkmetric.NewPeriodicReader(exporter)),
+			sdkmetric.WithResource(res),
+		)
+	}
+
+	if logsEnabled {
+		exporter, err := otlploghttp.New(ctx)
+		if err != nil {
+			_ = cfg.Shutdown(ctx)
+			return nil, nil, fmt.Errorf("failed to create OTLP log exporter: %w", err)
+		}
+		cfg.LoggerProvider = sdklog.NewLoggerProvider(
+			sdklog.WithProcessor(sdklog.NewBatchProcessor(exporter)),
+			sdklog.WithResource(res),
+		)
+	}
+
+	var (
+		once        sync.Once
+		shutdownErr error
+	)
+	shutdown := func(ctx context.Context) error {
+		once.Do(func() {
+			shutdownErr = cfg.Shutdown(ctx)
+		})
+		return shutdownErr
+	}
+
+	return cfg, shutdown, nil
+}
+
+// otlpSignalEnabled reports whether an OTLP endpoint is configured for the signal
+// (TRACES, METRICS or LOGS) and its exporter has not been disabled with "none".
+func otlpSignalEnabled(signal string) bool {
+	if strings.EqualFold(os.Getenv("OTEL_"+signal+"_EXPORTER"), "none") {
+		return false
+	}
+	return os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT") != "" ||
+		os.Getenv("OTEL_EXPORTER_OTLP_"+signal+"_ENDPOINT") != ""
+}
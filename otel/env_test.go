@@ -0,0 +1,105 @@
+package otel
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// clearOTelEnv unsets the OTEL_* variables read by ConfigFromEnv for the test duration
+func clearOTelEnv(t *testing.T) {
+	t.Helper()
+	for _, key := range []string{
+		"OTEL_SERVICE_NAME",
+		"OTEL_EXPORTER_OTLP_PROTOCOL",
+		"OTEL_EXPORTER_OTLP_ENDPOINT",
+		"OTEL_EXPORTER_OTLP_TRACES_ENDPOINT",
+		"OTEL_EXPORTER_OTLP_METRICS_ENDPOINT",
+		"OTEL_EXPORTER_OTLP_LOGS_ENDPOINT",
+		"OTEL_TRACES_EXPORTER",
+		"OTEL_METRICS_EXPORTER",
+		"OTEL_LOGS_EXPORTER",
+	} {
+		t.Setenv(key, "")
+	}
+}
+
+func newCollectorServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestConfigFromEnv_NoEndpoint(t *testing.T) {
+	clearOTelEnv(t)
+
+	cfg, shutdown, err := ConfigFromEnv(context.Background(), "test-service")
+	require.NoError(t, err)
+	require.NotNil(t, cfg)
+	require.NotNil(t, shutdown)
+
+	assert.Equal(t, "test-service", cfg.ServiceName)
+	assert.False(t, cfg.IsTracingEnabled())
+	assert.False(t, cfg.IsMetricsEnabled())
+	assert.False(t, cfg.IsLoggingEnabled())
+	assert.NoError(t, shutdown(context.Background()))
+}
+
+func TestConfigFromEnv_WithEndpoint(t *testing.T) {
+	clearOTelEnv(t)
+	server := newCollectorServer(t)
+	t.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", server.URL)
+	t.Setenv("OTEL_SERVICE_NAME", "env-service")
+	t.Setenv("OTEL_TRACES_SAMPLER", "always_on")
+
+	cfg, shutdown, err := ConfigFromEnv(context.Background(), "test-service")
+	require.NoError(t, err)
+	require.NotNil(t, cfg)
+
+	assert.Equal(t, "env-service", cfg.ServiceName)
+	assert.NotNil(t, cfg.TracerProvider)
+	assert.NotNil(t, cfg.MeterProvider)
+	assert.NotNil(t, cfg.LoggerProvider)
+
+	_, span := cfg.GetTracer("test").Start(context.Background(), "operation")
+	assert.True(t, span.IsRecording())
+	span.End()
+
+	require.NoError(t, shutdown(context.Background()))
+	assert.NoError(t, shutdown(context.Background()), "shutdown should be idempotent")
+}
+
+func TestConfigFromEnv_SignalSelection(t *testing.T) {
+	clearOTelEnv(t)
+	server := newCollectorServer(t)
+	t.Setenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT", server.URL+"/v1/traces")
+	t.Setenv("OTEL_EXPORTER_OTLP_LOGS_ENDPOINT", server.URL+"/v1/logs")
+	t.Setenv("OTEL_LOGS_EXPORTER", "none")
+
+	cfg, shutdown, err := ConfigFromEnv(context.Background(), "test-service")
+	require.NoError(t, err)
+	defer func() { _ = shutdown(context.Background()) }()
+
+	assert.True(t, cfg.IsTracingEnabled())
+	assert.False(t, cfg.IsMetricsEnabled())
+	assert.False(t, cfg.IsLoggingEnabled())
+}
+
+func TestConfigFromEnv_UnsupportedProtocol(t *testing.T) {
+	clearOTelEnv(t)
+	t.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", "http://localhost:4317")
+	t.Setenv("OTEL_EXPORTER_OTLP_PROTOCOL", "grpc")
+
+	cfg, shutdown, err := ConfigFromEnv(context.Background(), "test-service")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unsupported OTLP protocol")
+	assert.Nil(t, cfg)
+	assert.Nil(t, shutdown)
+}
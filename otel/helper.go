@@ -126,6 +126,19 @@ func (h *LogHelper) WithFields(fields ...Field) *LogHelper {
 	return newHelper
 }
 
+// With returns a child LogHelper that includes the given fields in every subsequent
+// Debug/Info/Warn/Error call, similar to zerolog's With(). The parent is unaffected.
+// It is equivalent to WithFields.
+//
+// Example:
+//
+//	reqLogger := logger.With(F("request_id", requestID))
+//	reqLogger.Info("Request received")  // Includes request_id
+//	reqLogger.Info("Request completed") // Includes request_id
+func (h *LogHelper) With(fields ...Field) *LogHelper {
+	return h.WithFields(fields...)
+}
+
 // Debug logs a debug-level message with optional fields.
 // If OTel is enabled, automatically adds trace_id and span_id.
 //
@@ -186,13 +199,29 @@ func (h *LogHelper) Span() trace.Span {
 }
 
 // Error logs an error-level message with optional fields.
-// Also sets span status to error if a span is active.
+// If a span is recording in the logger's context, the error is recorded on it
+// as an exception event and the span status is set to Error with msg.
 //
 // Example:
 //
 //	logger.Error(err, "Failed to process request", F("request_id", reqID), F("attempt", 3))
 func (h *LogHelper) Error(err error, msg string, fields ...Field) {
-	span := h.Span()
+	h.ErrorErr(h.ctx, msg, err, fields...)
+}
+
+// ErrorErr is like Error but uses ctx instead of the context captured by
+// NewLogHelper, so a long-lived LogHelper can report errors against the span
+// that is active at the call site.
+//
+// Example:
+//
+//	ctx, span := tracer.Start(ctx, "ProcessOrder")
+//	defer span.End()
+//	if err := process(ctx); err != nil {
+//	    logger.ErrorErr(ctx, "Failed to process order", err, F("order_id", orderID))
+//	}
+func (h *LogHelper) ErrorErr(ctx context.Context, msg string, err error, fields ...Field) {
+	span := trace.SpanFromContext(ctx)
 	if span.IsRecording() {
 		span.SetStatus(codes.Error, msg)
 		if err != nil {
@@ -204,12 +233,12 @@ func (h *LogHelper) Error(err error, msg string, fields ...Field) {
 
 	if h.otelLogger != nil {
 		params := otellog.EnabledParameters{Severity: otellog.SeverityError}
-		if h.otelLogger.Enabled(h.ctx, params) {
+		if h.otelLogger.Enabled(ctx, params) {
 			if err != nil {
 				errorField := F("error", err.Error())
 				allFields = append([]Field{errorField}, allFields...)
 			}
-			h.emitOTel(otellog.SeverityError, msg, allFields...)
+			h.emitOTelContext(ctx, otellog.SeverityError, msg, allFields...)
 		}
 	} else {
 		event := h.logger.Error().Err(err)
@@ -220,6 +249,11 @@ func (h *LogHelper) Error(err error, msg string, fields ...Field) {
 
 // emitOTel emits a log via OpenTelemetry with automatic trace correlation.
 func (h *LogHelper) emitOTel(severity otellog.Severity, msg string, fields ...Field) {
+	h.emitOTelContext(h.ctx, severity, msg, fields...)
+}
+
+// emitOTelContext emits a log via OpenTelemetry, correlated with the span in ctx.
+func (h *LogHelper) emitOTelContext(ctx context.Context, severity otellog.Severity, msg string, fields ...Field) {
 	var record otellog.Record
 	record.SetTimestamp(time.Now())
 	record.SetBody(otellog.StringValue(msg))
@@ -248,7 +282,7 @@ func (h *LogHelper) emitOTel(severity otellog.Severity, msg string, fields ...Fi
 		}
 	}
 
-	h.otelLogger.Emit(h.ctx, record)
+	h.otelLogger.Emit(ctx, record)
 }
 
 // addFields adds Field key-value pairs to a zerolog event.
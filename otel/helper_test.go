@@ -5,7 +5,10 @@ import (
 	"errors"
 	"testing"
 
+	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/log/noop"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 
 	"github.com/jasoet/pkg/v2/logging"
 )
@@ -293,3 +296,123 @@ func TestLogHelper_WithFields_SliceIsolation(t *testing.T) {
 		}
 	})
 }
+
+func TestLogHelper_With(t *testing.T) {
+	ctx := context.Background()
+	cfg, processor := newRecordingConfig()
+
+	parent := NewLogHelper(ctx, cfg, "test-scope", "test.Function")
+	child := parent.With(F("request_id", "abc"))
+
+	child.Debug("debug message")
+	child.Info("info message", F("step", 1))
+	child.Warn("warn message")
+	child.Error(errors.New("boom"), "error message")
+	parent.Info("parent message")
+
+	records := processor.Records()
+	if len(records) != 5 {
+		t.Fatalf("expected 5 records, got %d", len(records))
+	}
+
+	for _, record := range records[:4] {
+		attrs := recordAttributes(record)
+		if got := attrs["request_id"].AsString(); got != "abc" {
+			t.Errorf("expected request_id 'abc' on %q, got '%s'", record.Body().AsString(), got)
+		}
+	}
+
+	if _, ok := recordAttributes(records[4])["request_id"]; ok {
+		t.Error("expected parent helper to not include request_id")
+	}
+	if len(parent.baseFields) != 0 {
+		t.Errorf("expected parent to have no base fields, got %d", len(parent.baseFields))
+	}
+}
+
+func TestLogHelper_ErrorRecordsOnSpan(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	defer func() { _ = tp.Shutdown(context.Background()) }()
+
+	tests := []struct {
+		name string
+		log  func(ctx context.Context, err error)
+	}{
+		{
+			name: "Error",
+			log: func(ctx context.Context, err error) {
+				NewLogHelper(ctx, nil, "", "test.Function").Error(err, "operation failed")
+			},
+		},
+		{
+			name: "ErrorErr",
+			log: func(ctx context.Context, err error) {
+				// The helper is created without a span; the call-site context wins
+				NewLogHelper(context.Background(), nil, "", "test.Function").ErrorErr(ctx, "operation failed", err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder.Reset()
+
+			ctx, span := tp.Tracer("test").Start(context.Background(), "operation")
+			tt.log(ctx, errors.New("boom"))
+			span.End()
+
+			spans := recorder.Ended()
+			if len(spans) != 1 {
+				t.Fatalf("expected 1 span, got %d", len(spans))
+			}
+
+			status := spans[0].Status()
+			if status.Code != codes.Error {
+				t.Errorf("expected span status Error, got %v", status.Code)
+			}
+			if status.Description != "operation failed" {
+				t.Errorf("expected status description 'operation failed', got %q", status.Description)
+			}
+
+			events := spans[0].Events()
+			if len(events) != 1 || events[0].Name != "exception" {
+				t.Fatalf("expected one exception event, got %v", events)
+			}
+			for _, attr := range events[0].Attributes {
+				if attr.Key == "exception.message" && attr.Value.AsString() != "boom" {
+					t.Errorf("expected exception.message 'boom', got %q", attr.Value.AsString())
+				}
+			}
+		})
+	}
+}
+
+func TestLogHelper_ErrorErrUsesCallContext(t *testing.T) {
+	cfg, processor := newRecordingConfig()
+
+	tp := sdktrace.NewTracerProvider()
+	defer func() { _ = tp.Shutdown(context.Background()) }()
+
+	helper := NewLogHelper(context.Background(), cfg, "test-scope", "test.Function")
+
+	ctx, span := tp.Tracer("test").Start(context.Background(), "operation")
+	helper.ErrorErr(ctx, "operation failed", errors.New("boom"), F("attempt", 2))
+	span.End()
+
+	records := processor.Records()
+	if len(records) != 1 {
+		t.Fatalf("expected 1 record, got %d", len(records))
+	}
+	if records[0].TraceID() != span.SpanContext().TraceID() {
+		t.Error("expected record to be correlated with the call-site span")
+	}
+
+	attrs := recordAttributes(records[0])
+	if got := attrs["error"].AsString(); got != "boom" {
+		t.Errorf("expected error 'boom', got %q", got)
+	}
+	if got := attrs["attempt"].AsInt64(); got != 2 {
+		t.Errorf("expected attempt 2, got %d", got)
+	}
+}
@@ -0,0 +1,120 @@
+package otel
+
+import (
+	"context"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// MetricHelper provides ergonomic access to counters and histograms for a single
+// instrumentation scope. Instruments are created lazily on first use and cached by
+// name, so callers can record by name without keeping instrument references around.
+//
+// When metrics are not configured, the helper uses a no-op meter and all calls are
+// safe and free of side effects.
+//
+// Usage:
+//
+//	metrics := otelConfig.GetMeterHelper("github.com/jasoet/pkg/v2/mypackage")
+//	metrics.IncCounter(ctx, "orders.created", 1, F("channel", "web"))
+//	metrics.RecordHistogram(ctx, "orders.amount", 42.5, F("currency", "USD"))
+type MetricHelper struct {
+	meter metric.Meter
+
+	mu         sync.Mutex
+	counters   map[string]metric.Float64Counter
+	histograms map[string]metric.Float64Histogram
+}
+
+// GetMeterHelper returns a MetricHelper for the given instrumentation scope.
+// Returns a helper backed by a no-op meter if metrics are not configured.
+func (c *Config) GetMeterHelper(scopeName string, opts ...metric.MeterOption) *MetricHelper {
+	return &MetricHelper{
+		meter:      c.GetMeter(scopeName, opts...),
+		counters:   make(map[string]metric.Float64Counter),
+		histograms: make(map[string]metric.Float64Histogram),
+	}
+}
+
+// Counter returns the counter with the given name, creating it on first use.
+// Options are only applied when the counter is created.
+//
+// Example:
+//
+//	counter, err := metrics.Counter("requests.total", metric.WithUnit("{request}"))
+func (m *MetricHelper) Counter(name string, opts ...metric.Float64CounterOption) (metric.Float64Counter, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if counter, ok := m.counters[name]; ok {
+		return counter, nil
+	}
+
+	counter, err := m.meter.Float64Counter(name, opts...)
+	if err != nil {
+		return nil, err
+	}
+	m.counters[name] = counter
+	return counter, nil
+}
+
+// Histogram returns the histogram with the given name, creating it on first use.
+// Options are only applied when the histogram is created.
+//
+// Example:
+//
+//	histogram, err := metrics.Histogram("request.duration", metric.WithUnit("s"))
+func (m *MetricHelper) Histogram(name string, opts ...metric.Float64HistogramOption) (metric.Float64Histogram, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if histogram, ok := m.histograms[name]; ok {
+		return histogram, nil
+	}
+
+	histogram, err := m.meter.Float64Histogram(name, opts...)
+	if err != nil {
+		return nil, err
+	}
+	m.histograms[name] = histogram
+	return histogram, nil
+}
+
+// IncCounter adds value to the named counter with optional attributes.
+// Instrument creation errors are dropped so instrumentation never breaks callers.
+//
+// Example:
+//
+//	metrics.IncCounter(ctx, "cache.hits", 1, F("cache", "users"))
+func (m *MetricHelper) IncCounter(ctx context.Context, name string, value float64, attrs ...Field) {
+	counter, err := m.Counter(name)
+	if err != nil {
+		return
+	}
+	counter.Add(ctx, value, metric.WithAttributes(toAttributes(attrs)...))
+}
+
+// RecordHistogram records value in the named histogram with optional attributes.
+// Instrument creation errors are dropped so instrumentation never breaks callers.
+//
+// Example:
+//
+//	metrics.RecordHistogram(ctx, "request.duration", elapsed.Seconds(), F("route", "/users"))
+func (m *MetricHelper) RecordHistogram(ctx context.Context, name string, value float64, attrs ...Field) {
+	histogram, err := m.Histogram(name)
+	if err != nil {
+		return
+	}
+	histogram.Record(ctx, value, metric.WithAttributes(toAttributes(attrs)...))
+}
+
+// toAttributes converts fields to OpenTelemetry attributes
+func toAttributes(fields []Field) []attribute.KeyValue {
+	attrs := make([]attribute.KeyValue, 0, len(fields))
+	for _, field := range fields {
+		attrs = append(attrs, toAttribute(field.Key, field.Value))
+	}
+	return attrs
+}
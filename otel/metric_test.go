@@ -0,0 +1,124 @@
+package otel
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+func newManualReaderConfig(t *testing.T) (*Config, *sdkmetric.ManualReader) {
+	t.Helper()
+
+	reader := sdkmetric.NewManualReader()
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	t.Cleanup(func() { _ = provider.Shutdown(context.Background()) })
+
+	return NewConfig("test-service").WithMeterProvider(provider), reader
+}
+
+func collectMetric(t *testing.T, reader *sdkmetric.ManualReader, name string) metricdata.Metrics {
+	t.Helper()
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			if m.Name == name {
+				return m
+			}
+		}
+	}
+	t.Fatalf("metric %q not found", name)
+	return metricdata.Metrics{}
+}
+
+func TestMetricHelper_IncCounter(t *testing.T) {
+	cfg, reader := newManualReaderConfig(t)
+	ctx := context.Background()
+
+	metrics := cfg.GetMeterHelper("test-scope")
+	metrics.IncCounter(ctx, "orders.created", 1, F("channel", "web"))
+	metrics.IncCounter(ctx, "orders.created", 2, F("channel", "web"))
+
+	m := collectMetric(t, reader, "orders.created")
+	sum, ok := m.Data.(metricdata.Sum[float64])
+	require.True(t, ok, "expected Sum[float64], got %T", m.Data)
+	require.Len(t, sum.DataPoints, 1)
+
+	dp := sum.DataPoints[0]
+	assert.Equal(t, 3.0, dp.Value)
+	channel, ok := dp.Attributes.Value(attribute.Key("channel"))
+	require.True(t, ok)
+	assert.Equal(t, "web", channel.AsString())
+}
+
+func TestMetricHelper_RecordHistogram(t *testing.T) {
+	cfg, reader := newManualReaderConfig(t)
+	ctx := context.Background()
+
+	metrics := cfg.GetMeterHelper("test-scope")
+	metrics.RecordHistogram(ctx, "orders.amount", 42.5, F("currency", "USD"), F("items", 3))
+
+	m := collectMetric(t, reader, "orders.amount")
+	histogram, ok := m.Data.(metricdata.Histogram[float64])
+	require.True(t, ok, "expected Histogram[float64], got %T", m.Data)
+	require.Len(t, histogram.DataPoints, 1)
+
+	dp := histogram.DataPoints[0]
+	assert.Equal(t, uint64(1), dp.Count)
+	assert.Equal(t, 42.5, dp.Sum)
+
+	currency, ok := dp.Attributes.Value(attribute.Key("currency"))
+	require.True(t, ok)
+	assert.Equal(t, "USD", currency.AsString())
+	items, ok := dp.Attributes.Value(attribute.Key("items"))
+	require.True(t, ok)
+	assert.Equal(t, int64(3), items.AsInt64())
+}
+
+func TestMetricHelper_CachesInstruments(t *testing.T) {
+	cfg, _ := newManualReaderConfig(t)
+	metrics := cfg.GetMeterHelper("test-scope")
+
+	first, err := metrics.Counter("requests.total", metric.WithUnit("{request}"))
+	require.NoError(t, err)
+	second, err := metrics.Counter("requests.total")
+	require.NoError(t, err)
+	assert.Same(t, first, second)
+
+	h1, err := metrics.Histogram("request.duration", metric.WithUnit("s"))
+	require.NoError(t, err)
+	h2, err := metrics.Histogram("request.duration")
+	require.NoError(t, err)
+	assert.Same(t, h1, h2)
+}
+
+func TestMetricHelper_NoopWhenDisabled(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("nil config", func(t *testing.T) {
+		var cfg *Config
+		metrics := cfg.GetMeterHelper("test-scope")
+		require.NotNil(t, metrics)
+
+		// Should not panic
+		metrics.IncCounter(ctx, "noop.counter", 1)
+		metrics.RecordHistogram(ctx, "noop.histogram", 1.5, F("key", "value"))
+	})
+
+	t.Run("no meter provider", func(t *testing.T) {
+		metrics := NewConfig("test-service").GetMeterHelper("test-scope")
+
+		counter, err := metrics.Counter("noop.counter")
+		require.NoError(t, err)
+		assert.NotNil(t, counter)
+		metrics.IncCounter(ctx, "noop.counter", 1)
+	})
+}
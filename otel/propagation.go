@@ -0,0 +1,54 @@
+package otel
+
+import (
+	"context"
+
+	otelapi "go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+// defaultPropagator is used when no global propagator has been registered.
+var defaultPropagator = propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{})
+
+// InjectTraceContext writes the span context and baggage of ctx into carrier,
+// for transports other than HTTP and gRPC such as Kafka or SQS message headers.
+// It uses the global propagator registered with otel.SetTextMapPropagator, or
+// W3C TraceContext and Baggage if none has been registered. A nil carrier is ignored.
+//
+// Example:
+//
+//	headers := map[string]string{}
+//	otel.InjectTraceContext(ctx, headers)
+//	for k, v := range headers {
+//	    msg.Headers = append(msg.Headers, kafka.Header{Key: k, Value: []byte(v)})
+//	}
+func InjectTraceContext(ctx context.Context, carrier map[string]string) {
+	if carrier == nil {
+		return
+	}
+	textMapPropagator().Inject(ctx, propagation.MapCarrier(carrier))
+}
+
+// ExtractTraceContext returns a copy of ctx carrying the remote span context and
+// baggage found in carrier, as written by InjectTraceContext. Spans started from
+// the returned context join the producer's trace. The propagator is chosen as for
+// InjectTraceContext.
+//
+// Example:
+//
+//	ctx := otel.ExtractTraceContext(context.Background(), msg.Attributes)
+//	ctx, span := tracer.Start(ctx, "orders.process", trace.WithSpanKind(trace.SpanKindConsumer))
+//	defer span.End()
+func ExtractTraceContext(ctx context.Context, carrier map[string]string) context.Context {
+	return textMapPropagator().Extract(ctx, propagation.MapCarrier(carrier))
+}
+
+// textMapPropagator returns the global propagator, falling back to
+// defaultPropagator while the global one is still the no-op default.
+func textMapPropagator() propagation.TextMapPropagator {
+	global := otelapi.GetTextMapPropagator()
+	if len(global.Fields()) == 0 {
+		return defaultPropagator
+	}
+	return global
+}
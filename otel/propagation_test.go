@@ -0,0 +1,74 @@
+package otel
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	otelapi "go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestInjectExtractTraceContext(t *testing.T) {
+	tp := sdktrace.NewTracerProvider()
+	t.Cleanup(func() { _ = tp.Shutdown(context.Background()) })
+	tracer := tp.Tracer("test")
+
+	ctx, producer := tracer.Start(context.Background(), "orders.publish")
+	ctx = SetBaggage(ctx, attribute.String("tenant.id", "acme"))
+
+	headers := map[string]string{}
+	InjectTraceContext(ctx, headers)
+	producer.End()
+	assert.Contains(t, headers, "traceparent")
+
+	consumerCtx := ExtractTraceContext(context.Background(), headers)
+	_, consumer := tracer.Start(consumerCtx, "orders.process")
+	defer consumer.End()
+
+	assert.Equal(t, producer.SpanContext().TraceID(), consumer.SpanContext().TraceID())
+	assert.NotEqual(t, producer.SpanContext().SpanID(), consumer.SpanContext().SpanID())
+
+	parent := trace.SpanContextFromContext(consumerCtx)
+	assert.True(t, parent.IsRemote())
+	assert.Equal(t, producer.SpanContext().SpanID(), parent.SpanID())
+
+	tenant, ok := GetBaggage(consumerCtx, "tenant.id")
+	assert.True(t, ok)
+	assert.Equal(t, "acme", tenant)
+}
+
+func TestInjectTraceContext_UsesGlobalPropagator(t *testing.T) {
+	previous := otelapi.GetTextMapPropagator()
+	otelapi.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() { otelapi.SetTextMapPropagator(previous) })
+
+	tp := sdktrace.NewTracerProvider()
+	t.Cleanup(func() { _ = tp.Shutdown(context.Background()) })
+	ctx, span := tp.Tracer("test").Start(context.Background(), "publish")
+	defer span.End()
+	ctx = SetBaggage(ctx, attribute.String("tenant.id", "acme"))
+
+	headers := map[string]string{}
+	InjectTraceContext(ctx, headers)
+	assert.Contains(t, headers, "traceparent")
+	assert.NotContains(t, headers, "baggage", "global TraceContext-only propagator should be used")
+}
+
+func TestTraceContext_NoSpan(t *testing.T) {
+	headers := map[string]string{}
+	InjectTraceContext(context.Background(), headers)
+	assert.Empty(t, headers)
+
+	ctx := ExtractTraceContext(context.Background(), headers)
+	require.NotNil(t, ctx)
+	assert.False(t, trace.SpanContextFromContext(ctx).IsValid())
+
+	// A nil carrier is ignored
+	InjectTraceContext(context.Background(), nil)
+	assert.False(t, trace.SpanContextFromContext(ExtractTraceContext(context.Background(), nil)).IsValid())
+}
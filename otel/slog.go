@@ -0,0 +1,229 @@
+package otel
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/rs/zerolog"
+	otellog "go.opentelemetry.io/otel/log"
+)
+
+// slogHandler is an slog.Handler that routes records through the OTel
+// LoggerProvider when configured, otherwise through zerolog.
+type slogHandler struct {
+	otelLogger otellog.Logger
+	logger     zerolog.Logger
+	attrs      []slog.Attr // Attributes added via WithAttrs, already group-qualified
+	prefix     string      // Dotted group prefix from WithGroup
+}
+
+// NewSlogHandler creates an slog.Handler backed by the configured OTel LoggerProvider.
+// Records emitted with a context containing an active span are automatically
+// correlated with that span (trace_id/span_id). When config is nil or logging is
+// disabled, records are written to zerolog instead, mirroring NewLogHelper.
+//
+// Groups are flattened into dotted attribute keys (e.g. "request.id").
+//
+// Example:
+//
+//	logger := slog.New(otel.NewSlogHandler(otelConfig, "github.com/jasoet/pkg/v2/mypackage"))
+//	logger.InfoContext(ctx, "User logged in", "user_id", 123)
+func NewSlogHandler(config *Config, scope string) slog.Handler {
+	h := &slogHandler{}
+
+	if config != nil && config.IsLoggingEnabled() {
+		h.otelLogger = config.GetLogger(scope)
+	} else {
+		serviceName := scope
+		if config != nil && config.ServiceName != "" {
+			serviceName = config.ServiceName
+		}
+
+		h.logger = zerolog.New(zerolog.ConsoleWriter{Out: os.Stderr, TimeFormat: time.RFC3339}).
+			With().
+			Timestamp().
+			Str("service", serviceName).
+			Int("pid", os.Getpid()).
+			Logger()
+	}
+
+	return h
+}
+
+// Enabled implements slog.Handler
+func (h *slogHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	if h.otelLogger != nil {
+		return h.otelLogger.Enabled(ctx, otellog.EnabledParameters{Severity: slogLevelToSeverity(level)})
+	}
+	return slogLevelToZerolog(level) >= h.logger.GetLevel()
+}
+
+// Handle implements slog.Handler
+func (h *slogHandler) Handle(ctx context.Context, r slog.Record) error {
+	attrs := make([]slog.Attr, 0, len(h.attrs)+r.NumAttrs())
+	attrs = append(attrs, h.attrs...)
+	r.Attrs(func(a slog.Attr) bool {
+		attrs = appendSlogAttr(attrs, h.prefix, a)
+		return true
+	})
+
+	if h.otelLogger != nil {
+		var record otellog.Record
+		timestamp := r.Time
+		if timestamp.IsZero() {
+			timestamp = time.Now()
+		}
+		record.SetTimestamp(timestamp)
+		record.SetBody(otellog.StringValue(r.Message))
+		record.SetSeverity(slogLevelToSeverity(r.Level))
+		record.SetSeverityText(r.Level.String())
+
+		for _, a := range attrs {
+			record.AddAttributes(otellog.KeyValue{Key: a.Key, Value: slogValueToOTel(a.Value)})
+		}
+
+		h.otelLogger.Emit(ctx, record)
+		return nil
+	}
+
+	event := h.logger.WithLevel(slogLevelToZerolog(r.Level))
+	for _, a := range attrs {
+		event = addSlogAttrToEvent(event, a)
+	}
+	event.Msg(r.Message)
+	return nil
+}
+
+// WithAttrs implements slog.Handler
+func (h *slogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	if len(attrs) == 0 {
+		return h
+	}
+
+	clone := *h
+	clone.attrs = make([]slog.Attr, 0, len(h.attrs)+len(attrs))
+	clone.attrs = append(clone.attrs, h.attrs...)
+	for _, a := range attrs {
+		clone.attrs = appendSlogAttr(clone.attrs, h.prefix, a)
+	}
+	return &clone
+}
+
+// WithGroup implements slog.Handler
+func (h *slogHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+
+	clone := *h
+	clone.prefix = h.prefix + name + "."
+	return &clone
+}
+
+// appendSlogAttr resolves a and appends it to attrs with the group prefix applied.
+// Group attributes are flattened into dotted keys; empty attributes are dropped.
+func appendSlogAttr(attrs []slog.Attr, prefix string, a slog.Attr) []slog.Attr {
+	a.Value = a.Value.Resolve()
+	if a.Equal(slog.Attr{}) {
+		return attrs
+	}
+
+	if a.Value.Kind() == slog.KindGroup {
+		groupPrefix := prefix
+		if a.Key != "" {
+			groupPrefix = prefix + a.Key + "."
+		}
+		for _, ga := range a.Value.Group() {
+			attrs = appendSlogAttr(attrs, groupPrefix, ga)
+		}
+		return attrs
+	}
+
+	a.Key = prefix + a.Key
+	return append(attrs, a)
+}
+
+// slogValueToOTel converts a resolved, non-group slog.Value to an OTel log value
+func slogValueToOTel(v slog.Value) otellog.Value {
+	switch v.Kind() {
+	case slog.KindString:
+		return otellog.StringValue(v.String())
+	case slog.KindBool:
+		return otellog.BoolValue(v.Bool())
+	case slog.KindInt64:
+		return otellog.Int64Value(v.Int64())
+	case slog.KindUint64:
+		return otellog.Int64Value(int64(v.Uint64()))
+	case slog.KindFloat64:
+		return otellog.Float64Value(v.Float64())
+	case slog.KindDuration:
+		return otellog.StringValue(v.Duration().String())
+	case slog.KindTime:
+		return otellog.StringValue(v.Time().Format(time.RFC3339Nano))
+	default:
+		if err, ok := v.Any().(error); ok {
+			return otellog.StringValue(err.Error())
+		}
+		return otellog.StringValue(fmt.Sprint(v.Any()))
+	}
+}
+
+// addSlogAttrToEvent adds a resolved, non-group slog.Attr to a zerolog event
+func addSlogAttrToEvent(event *zerolog.Event, a slog.Attr) *zerolog.Event {
+	switch a.Value.Kind() {
+	case slog.KindString:
+		return event.Str(a.Key, a.Value.String())
+	case slog.KindBool:
+		return event.Bool(a.Key, a.Value.Bool())
+	case slog.KindInt64:
+		return event.Int64(a.Key, a.Value.Int64())
+	case slog.KindUint64:
+		return event.Uint64(a.Key, a.Value.Uint64())
+	case slog.KindFloat64:
+		return event.Float64(a.Key, a.Value.Float64())
+	case slog.KindDuration:
+		return event.Str(a.Key, a.Value.Duration().String())
+	case slog.KindTime:
+		return event.Time(a.Key, a.Value.Time())
+	default:
+		if err, ok := a.Value.Any().(error); ok {
+			return event.AnErr(a.Key, err)
+		}
+		return event.Str(a.Key, fmt.Sprint(a.Value.Any()))
+	}
+}
+
+// slogLevelToSeverity maps slog levels to OTel severities.
+// slog levels are spaced 4 apart starting at Debug=-4, matching the OTel
+// severity ranges (Debug=5, Info=9, Warn=13, Error=17), so an offset of 9
+// preserves intermediate levels such as slog.LevelInfo+1.
+func slogLevelToSeverity(level slog.Level) otellog.Severity {
+	severity := int(level) + 9
+	switch {
+	case severity < int(otellog.SeverityTrace1):
+		return otellog.SeverityTrace1
+	case severity > int(otellog.SeverityFatal4):
+		return otellog.SeverityFatal4
+	default:
+		return otellog.Severity(severity)
+	}
+}
+
+// slogLevelToZerolog maps slog levels to zerolog levels
+func slogLevelToZerolog(level slog.Level) zerolog.Level {
+	switch {
+	case level >= slog.LevelError:
+		return zerolog.ErrorLevel
+	case level >= slog.LevelWarn:
+		return zerolog.WarnLevel
+	case level >= slog.LevelInfo:
+		return zerolog.InfoLevel
+	case level >= slog.LevelDebug:
+		return zerolog.DebugLevel
+	default:
+		return zerolog.TraceLevel
+	}
+}
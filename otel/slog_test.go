@@ -0,0 +1,156 @@
+package otel
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	otellog "go.opentelemetry.io/otel/log"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// recordingProcessor captures emitted log records for assertions
+type recordingProcessor struct {
+	mu      sync.Mutex
+	records []sdklog.Record
+}
+
+func (p *recordingProcessor) OnEmit(_ context.Context, record *sdklog.Record) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.records = append(p.records, record.Clone())
+	return nil
+}
+
+func (p *recordingProcessor) Enabled(context.Context, sdklog.EnabledParameters) bool { return true }
+func (p *recordingProcessor) Shutdown(context.Context) error                         { return nil }
+func (p *recordingProcessor) ForceFlush(context.Context) error                       { return nil }
+
+func (p *recordingProcessor) Records() []sdklog.Record {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]sdklog.Record(nil), p.records...)
+}
+
+func newRecordingConfig() (*Config, *recordingProcessor) {
+	processor := &recordingProcessor{}
+	cfg := NewConfig("test-service").
+		WithLoggerProvider(sdklog.NewLoggerProvider(sdklog.WithProcessor(processor)))
+	return cfg, processor
+}
+
+func recordAttributes(record sdklog.Record) map[string]otellog.Value {
+	attrs := make(map[string]otellog.Value)
+	record.WalkAttributes(func(kv otellog.KeyValue) bool {
+		attrs[kv.Key] = kv.Value
+		return true
+	})
+	return attrs
+}
+
+func TestSlogHandler_TraceCorrelation(t *testing.T) {
+	cfg, processor := newRecordingConfig()
+
+	tp := sdktrace.NewTracerProvider()
+	defer func() { _ = tp.Shutdown(context.Background()) }()
+
+	ctx, span := tp.Tracer("test").Start(context.Background(), "operation")
+	logger := slog.New(NewSlogHandler(cfg, "test-scope"))
+	logger.InfoContext(ctx, "inside span", "user_id", 123)
+	span.End()
+
+	records := processor.Records()
+	require.Len(t, records, 1)
+
+	record := records[0]
+	assert.Equal(t, span.SpanContext().TraceID(), record.TraceID())
+	assert.Equal(t, span.SpanContext().SpanID(), record.SpanID())
+	assert.Equal(t, "inside span", record.Body().AsString())
+	assert.Equal(t, otellog.SeverityInfo, record.Severity())
+
+	attrs := recordAttributes(record)
+	assert.Equal(t, int64(123), attrs["user_id"].AsInt64())
+}
+
+func TestSlogHandler_WithoutSpan(t *testing.T) {
+	cfg, processor := newRecordingConfig()
+
+	logger := slog.New(NewSlogHandler(cfg, "test-scope"))
+	logger.Info("no span")
+
+	records := processor.Records()
+	require.Len(t, records, 1)
+	assert.False(t, records[0].TraceID().IsValid())
+}
+
+func TestSlogHandler_Levels(t *testing.T) {
+	tests := []struct {
+		level    slog.Level
+		expected otellog.Severity
+	}{
+		{slog.LevelDebug, otellog.SeverityDebug},
+		{slog.LevelInfo, otellog.SeverityInfo},
+		{slog.LevelWarn, otellog.SeverityWarn},
+		{slog.LevelError, otellog.SeverityError},
+		{slog.LevelInfo + 1, otellog.SeverityInfo2},
+		{slog.Level(-100), otellog.SeverityTrace1},
+		{slog.Level(100), otellog.SeverityFatal4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.level.String(), func(t *testing.T) {
+			assert.Equal(t, tt.expected, slogLevelToSeverity(tt.level))
+		})
+	}
+}
+
+func TestSlogHandler_AttrsAndGroups(t *testing.T) {
+	cfg, processor := newRecordingConfig()
+
+	logger := slog.New(NewSlogHandler(cfg, "test-scope")).
+		With("service.tier", "backend").
+		WithGroup("request")
+	logger.Warn("grouped",
+		"id", "abc",
+		slog.Group("client", "ip", "10.0.0.1"),
+		"ok", true,
+		"ratio", 0.5,
+		"err", errors.New("boom"))
+
+	records := processor.Records()
+	require.Len(t, records, 1)
+	assert.Equal(t, otellog.SeverityWarn, records[0].Severity())
+
+	attrs := recordAttributes(records[0])
+	assert.Equal(t, "backend", attrs["service.tier"].AsString())
+	assert.Equal(t, "abc", attrs["request.id"].AsString())
+	assert.Equal(t, "10.0.0.1", attrs["request.client.ip"].AsString())
+	assert.True(t, attrs["request.ok"].AsBool())
+	assert.Equal(t, 0.5, attrs["request.ratio"].AsFloat64())
+	assert.Equal(t, "boom", attrs["request.err"].AsString())
+}
+
+func TestSlogHandler_ZerologFallback(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("nil config", func(t *testing.T) {
+		handler := NewSlogHandler(nil, "test-scope")
+		assert.True(t, handler.Enabled(ctx, slog.LevelInfo))
+
+		// Should not panic
+		logger := slog.New(handler).With("key", "value").WithGroup("g")
+		logger.Info("fallback message", "count", 42, "err", errors.New("boom"))
+	})
+
+	t.Run("logging disabled", func(t *testing.T) {
+		cfg := NewConfig("test-service").WithoutLogging()
+		handler := NewSlogHandler(cfg, "test-scope")
+		require.IsType(t, &slogHandler{}, handler)
+		assert.Nil(t, handler.(*slogHandler).otelLogger)
+	})
+}
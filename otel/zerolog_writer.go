@@ -0,0 +1,188 @@
+package otel
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/rs/zerolog"
+	otellog "go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// zerologWriter re-emits zerolog JSON events as OTel log records.
+type zerologWriter struct {
+	logger otellog.Logger
+}
+
+// OTelWriter returns an io.Writer that parses zerolog JSON events and emits them
+// as OTel log records through the configured LoggerProvider. Add it to a zerolog
+// multi-writer so existing log.Info() calls are exported alongside console output.
+//
+// The zerolog level maps to the record severity, the message field becomes the
+// body, the timestamp is preserved, and all other fields become attributes.
+// trace_id and span_id fields (as written by the console exporter or by hand)
+// correlate the record with that span.
+//
+// When config is nil or logging is disabled, the returned writer discards its input.
+//
+// Example:
+//
+//	console := zerolog.ConsoleWriter{Out: os.Stderr}
+//	log.Logger = zerolog.New(zerolog.MultiLevelWriter(console, otel.OTelWriter(otelConfig, "my-service"))).
+//	    With().Timestamp().Logger()
+//	log.Info().Str("user_id", "123").Msg("User logged in")
+func OTelWriter(config *Config, scope string) io.Writer {
+	if config == nil || !config.IsLoggingEnabled() {
+		return io.Discard
+	}
+	return &zerologWriter{logger: config.GetLogger(scope)}
+}
+
+// Write implements io.Writer. Input that is not a JSON object is emitted as the
+// record body. Write never fails so it cannot break the other writers in a
+// multi-writer.
+func (w *zerologWriter) Write(p []byte) (int, error) {
+	dec := json.NewDecoder(bytes.NewReader(p))
+	dec.UseNumber()
+
+	for {
+		var event map[string]any
+		err := dec.Decode(&event)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			w.emitRaw(p)
+			break
+		}
+		w.emit(event)
+	}
+
+	return len(p), nil
+}
+
+// emit converts one decoded zerolog event into an OTel record.
+func (w *zerologWriter) emit(event map[string]any) {
+	ctx := context.Background()
+
+	var record otellog.Record
+	record.SetTimestamp(time.Now())
+
+	level := zerolog.NoLevel
+	if s, ok := event[zerolog.LevelFieldName].(string); ok {
+		if parsed, err := zerolog.ParseLevel(s); err == nil {
+			level = parsed
+		}
+		record.SetSeverityText(s)
+		delete(event, zerolog.LevelFieldName)
+	}
+	record.SetSeverity(zerologLevelToSeverity(level))
+
+	if msg, ok := event[zerolog.MessageFieldName].(string); ok {
+		record.SetBody(otellog.StringValue(msg))
+		delete(event, zerolog.MessageFieldName)
+	}
+
+	if ts, ok := event[zerolog.TimestampFieldName].(string); ok {
+		if parsed, err := time.Parse(zerolog.TimeFieldFormat, ts); err == nil {
+			record.SetTimestamp(parsed)
+			delete(event, zerolog.TimestampFieldName)
+		}
+	}
+
+	// Correlate with the span named in the event, if any
+	traceID, traceErr := trace.TraceIDFromHex(stringField(event, "trace_id"))
+	spanID, spanErr := trace.SpanIDFromHex(stringField(event, "span_id"))
+	if traceErr == nil && spanErr == nil {
+		flags := trace.TraceFlags(0)
+		if stringField(event, "trace_flags") == "01" {
+			flags = trace.FlagsSampled
+		}
+		ctx = trace.ContextWithSpanContext(ctx, trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID:    traceID,
+			SpanID:     spanID,
+			TraceFlags: flags,
+		}))
+		delete(event, "trace_id")
+		delete(event, "span_id")
+		delete(event, "trace_flags")
+	}
+
+	for key, value := range event {
+		record.AddAttributes(otellog.KeyValue{Key: key, Value: jsonValueToOTel(value)})
+	}
+
+	w.logger.Emit(ctx, record)
+}
+
+// emitRaw emits input that could not be parsed as JSON.
+func (w *zerologWriter) emitRaw(p []byte) {
+	var record otellog.Record
+	record.SetTimestamp(time.Now())
+	record.SetBody(otellog.StringValue(string(bytes.TrimSpace(p))))
+	w.logger.Emit(context.Background(), record)
+}
+
+// stringField returns event[key] if it is a string, otherwise "".
+func stringField(event map[string]any, key string) string {
+	s, _ := event[key].(string)
+	return s
+}
+
+// jsonValueToOTel converts a value decoded with json.Decoder.UseNumber to an OTel log value
+func jsonValueToOTel(v any) otellog.Value {
+	switch val := v.(type) {
+	case string:
+		return otellog.StringValue(val)
+	case bool:
+		return otellog.BoolValue(val)
+	case json.Number:
+		if i, err := val.Int64(); err == nil {
+			return otellog.Int64Value(i)
+		}
+		if f, err := val.Float64(); err == nil {
+			return otellog.Float64Value(f)
+		}
+		return otellog.StringValue(val.String())
+	case []any:
+		values := make([]otellog.Value, 0, len(val))
+		for _, item := range val {
+			values = append(values, jsonValueToOTel(item))
+		}
+		return otellog.SliceValue(values...)
+	case map[string]any:
+		kvs := make([]otellog.KeyValue, 0, len(val))
+		for k, item := range val {
+			kvs = append(kvs, otellog.KeyValue{Key: k, Value: jsonValueToOTel(item)})
+		}
+		return otellog.MapValue(kvs...)
+	default:
+		return otellog.Value{}
+	}
+}
+
+// zerologLevelToSeverity maps zerolog levels to OTel severities
+func zerologLevelToSeverity(level zerolog.Level) otellog.Severity {
+	switch level {
+	case zerolog.TraceLevel:
+		return otellog.SeverityTrace
+	case zerolog.DebugLevel:
+		return otellog.SeverityDebug
+	case zerolog.InfoLevel:
+		return otellog.SeverityInfo
+	case zerolog.WarnLevel:
+		return otellog.SeverityWarn
+	case zerolog.ErrorLevel:
+		return otellog.SeverityError
+	case zerolog.FatalLevel:
+		return otellog.SeverityFatal
+	case zerolog.PanicLevel:
+		return otellog.SeverityFatal4
+	default:
+		return otellog.SeverityUndefined
+	}
+}
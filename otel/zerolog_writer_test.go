@@ -0,0 +1,125 @@
+package otel
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	otellog "go.opentelemetry.io/otel/log"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+func TestOTelWriter_EmitsRecords(t *testing.T) {
+	cfg, processor := newRecordingConfig()
+
+	logger := zerolog.New(OTelWriter(cfg, "test-scope")).With().Timestamp().Logger()
+
+	logger.Info().Str("user_id", "u-1").Int("attempt", 3).Bool("admin", true).Msg("User logged in")
+	logger.Warn().Float64("latency", 1.5).Msg("Slow request")
+	logger.Error().Err(errors.New("boom")).Strs("tags", []string{"a", "b"}).Msg("Request failed")
+
+	records := processor.Records()
+	require.Len(t, records, 3)
+
+	info := records[0]
+	assert.Equal(t, "User logged in", info.Body().AsString())
+	assert.Equal(t, otellog.SeverityInfo, info.Severity())
+	assert.Equal(t, "info", info.SeverityText())
+	assert.WithinDuration(t, time.Now(), info.Timestamp(), 5*time.Second)
+	attrs := recordAttributes(info)
+	assert.Equal(t, "u-1", attrs["user_id"].AsString())
+	assert.Equal(t, int64(3), attrs["attempt"].AsInt64())
+	assert.True(t, attrs["admin"].AsBool())
+	assert.NotContains(t, attrs, "level")
+	assert.NotContains(t, attrs, "message")
+	assert.NotContains(t, attrs, "time")
+
+	warn := records[1]
+	assert.Equal(t, otellog.SeverityWarn, warn.Severity())
+	assert.InDelta(t, 1.5, recordAttributes(warn)["latency"].AsFloat64(), 0.0001)
+
+	errRecord := records[2]
+	assert.Equal(t, otellog.SeverityError, errRecord.Severity())
+	errAttrs := recordAttributes(errRecord)
+	assert.Equal(t, "boom", errAttrs["error"].AsString())
+	tags := errAttrs["tags"].AsSlice()
+	require.Len(t, tags, 2)
+	assert.Equal(t, "a", tags[0].AsString())
+	assert.Equal(t, "b", tags[1].AsString())
+}
+
+func TestOTelWriter_SeverityMapping(t *testing.T) {
+	tests := []struct {
+		level    zerolog.Level
+		severity otellog.Severity
+	}{
+		{zerolog.TraceLevel, otellog.SeverityTrace},
+		{zerolog.DebugLevel, otellog.SeverityDebug},
+		{zerolog.InfoLevel, otellog.SeverityInfo},
+		{zerolog.WarnLevel, otellog.SeverityWarn},
+		{zerolog.ErrorLevel, otellog.SeverityError},
+		{zerolog.FatalLevel, otellog.SeverityFatal},
+		{zerolog.PanicLevel, otellog.SeverityFatal4},
+		{zerolog.NoLevel, otellog.SeverityUndefined},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.level.String(), func(t *testing.T) {
+			cfg, processor := newRecordingConfig()
+			logger := zerolog.New(OTelWriter(cfg, "test-scope")).Level(zerolog.TraceLevel)
+
+			// WithLevel avoids os.Exit/panic for fatal and panic levels
+			logger.WithLevel(tt.level).Msg("message")
+
+			records := processor.Records()
+			require.Len(t, records, 1)
+			assert.Equal(t, tt.severity, records[0].Severity())
+		})
+	}
+}
+
+func TestOTelWriter_TraceCorrelation(t *testing.T) {
+	cfg, processor := newRecordingConfig()
+
+	tp := sdktrace.NewTracerProvider()
+	defer func() { _ = tp.Shutdown(context.Background()) }()
+
+	_, span := tp.Tracer("test").Start(context.Background(), "operation")
+	spanCtx := span.SpanContext()
+	span.End()
+
+	logger := zerolog.New(OTelWriter(cfg, "test-scope"))
+	logger.Info().
+		Str("trace_id", spanCtx.TraceID().String()).
+		Str("span_id", spanCtx.SpanID().String()).
+		Msg("inside span")
+
+	records := processor.Records()
+	require.Len(t, records, 1)
+	assert.Equal(t, spanCtx.TraceID(), records[0].TraceID())
+	assert.Equal(t, spanCtx.SpanID(), records[0].SpanID())
+	assert.NotContains(t, recordAttributes(records[0]), "trace_id")
+}
+
+func TestOTelWriter_NonJSONInput(t *testing.T) {
+	cfg, processor := newRecordingConfig()
+	w := OTelWriter(cfg, "test-scope")
+
+	n, err := w.Write([]byte("plain text line\n"))
+	require.NoError(t, err)
+	assert.Equal(t, len("plain text line\n"), n)
+
+	records := processor.Records()
+	require.Len(t, records, 1)
+	assert.Equal(t, "plain text line", records[0].Body().AsString())
+}
+
+func TestOTelWriter_DisabledConfig(t *testing.T) {
+	assert.Equal(t, io.Discard, OTelWriter(nil, "test-scope"))
+	assert.Equal(t, io.Discard, OTelWriter(&Config{ServiceName: "test-service"}, "test-scope"))
+}
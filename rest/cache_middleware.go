@@ -0,0 +1,232 @@
+package rest
+
+import (
+	"bytes"
+	"container/list"
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// cacheHitKey marks a request served from the cache; its value is the *cachedResponse.
+type cacheHitKey struct{}
+
+// cacheRevalidateKey marks a conditional request; its value is the stale *cachedResponse
+// that is served if the server answers 304 Not Modified.
+type cacheRevalidateKey struct{}
+
+// cachedResponse is a stored response.
+type cachedResponse struct {
+	statusCode int
+	header     http.Header
+	body       []byte
+}
+
+// toRestyResponse builds a response for request from the stored one. The body
+// and headers are copied so callers cannot modify the cache.
+func (c *cachedResponse) toRestyResponse(request *resty.Request) *resty.Response {
+	response := &resty.Response{
+		Request: request,
+		RawResponse: &http.Response{
+			Status:     http.StatusText(c.statusCode),
+			StatusCode: c.statusCode,
+			Header:     c.header.Clone(),
+			Body:       http.NoBody,
+		},
+	}
+	return response.SetBody(bytes.Clone(c.body))
+}
+
+// cachedResponseFromContext returns the response a CacheMiddleware chose to serve
+// from the cache in BeforeRequest.
+func cachedResponseFromContext(ctx context.Context) (*cachedResponse, bool) {
+	cached, ok := ctx.Value(cacheHitKey{}).(*cachedResponse)
+	return cached, ok
+}
+
+// revalidatedResponse replaces a 304 Not Modified answer to a conditional request
+// made by CacheMiddleware with the cached response it revalidated.
+func revalidatedResponse(ctx context.Context, response *resty.Response) *resty.Response {
+	if response == nil || response.StatusCode() != http.StatusNotModified {
+		return response
+	}
+	stale, ok := ctx.Value(cacheRevalidateKey{}).(*cachedResponse)
+	if !ok {
+		return response
+	}
+	return stale.toRestyResponse(response.Request)
+}
+
+// cacheEntry is an element of CacheMiddleware's LRU list.
+type cacheEntry struct {
+	key      string
+	response *cachedResponse
+	expires  time.Time
+}
+
+// CacheMiddleware caches 200 OK responses to GET requests in memory, keyed by
+// method and URL, and serves them without a network round-trip until they are
+// older than the TTL. Once full, the least recently used entry is evicted.
+//
+// Responses with "Cache-Control: no-store" are not cached; other caching headers
+// are ignored. The cache is shared by every request made through the client, so
+// do not use it for responses that depend on per-request headers such as
+// Authorization.
+//
+// Cache hits still pass through the other middlewares, so logging, tracing and
+// metrics record them like any other request.
+type CacheMiddleware struct {
+	ttl        time.Duration
+	maxEntries int
+	revalidate bool
+	now        func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	lru     *list.List
+}
+
+// CacheOption configures CacheMiddleware.
+type CacheOption func(*CacheMiddleware)
+
+// WithETagRevalidation keeps expired entries that carry an ETag and revalidates
+// them with If-None-Match instead of refetching. A 304 Not Modified answer is
+// returned to the caller as the cached response and renews its TTL.
+func WithETagRevalidation() CacheOption {
+	return func(m *CacheMiddleware) {
+		m.revalidate = true
+	}
+}
+
+// NewCacheMiddleware creates a CacheMiddleware that keeps up to maxEntries
+// responses for ttl. A maxEntries of zero or less means no limit.
+//
+// Example:
+//
+//	cache := rest.NewCacheMiddleware(5*time.Minute, 1000, rest.WithETagRevalidation())
+//	client := rest.NewClient(rest.WithMiddleware(cache))
+func NewCacheMiddleware(ttl time.Duration, maxEntries int, opts ...CacheOption) *CacheMiddleware {
+	m := &CacheMiddleware{
+		ttl:        ttl,
+		maxEntries: maxEntries,
+		now:        time.Now,
+		entries:    make(map[string]*list.Element),
+		lru:        list.New(),
+	}
+
+	for _, opt := range opts {
+		opt(m)
+	}
+
+	return m
+}
+
+// BeforeRequest serves fresh cache entries, skipping the network request, and
+// adds If-None-Match to requests for expired entries when revalidation is enabled.
+func (m *CacheMiddleware) BeforeRequest(ctx context.Context, method string, url string, body string, headers map[string]string) context.Context {
+	if method != http.MethodGet {
+		return ctx
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	elem, ok := m.entries[cacheKey(method, url)]
+	if !ok {
+		return ctx
+	}
+	entry := elem.Value.(*cacheEntry)
+
+	if m.now().Before(entry.expires) {
+		m.lru.MoveToFront(elem)
+		return context.WithValue(ctx, cacheHitKey{}, entry.response)
+	}
+
+	etag := entry.response.header.Get("ETag")
+	if !m.revalidate || etag == "" || headers == nil || hasHeader(headers, "If-None-Match") {
+		m.removeLocked(elem)
+		return ctx
+	}
+
+	headers["If-None-Match"] = etag
+	return context.WithValue(ctx, cacheRevalidateKey{}, entry.response)
+}
+
+// AfterRequest stores 200 OK responses to GET requests. Responses served from
+// the cache are not stored again, so their TTL is not extended.
+func (m *CacheMiddleware) AfterRequest(ctx context.Context, info RequestInfo) {
+	if info.Method != http.MethodGet || info.Error != nil || info.StatusCode != http.StatusOK {
+		return
+	}
+	if _, hit := cachedResponseFromContext(ctx); hit {
+		return
+	}
+	if strings.Contains(strings.ToLower(info.ResponseHeaders.Get("Cache-Control")), "no-store") {
+		return
+	}
+
+	response := &cachedResponse{
+		statusCode: info.StatusCode,
+		header:     info.ResponseHeaders.Clone(),
+		body:       bytes.Clone(info.ResponseBody),
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	key := cacheKey(info.Method, info.URL)
+	expires := m.now().Add(m.ttl)
+	if elem, ok := m.entries[key]; ok {
+		entry := elem.Value.(*cacheEntry)
+		entry.response = response
+		entry.expires = expires
+		m.lru.MoveToFront(elem)
+		return
+	}
+
+	m.entries[key] = m.lru.PushFront(&cacheEntry{key: key, response: response, expires: expires})
+	if m.maxEntries > 0 && m.lru.Len() > m.maxEntries {
+		m.removeLocked(m.lru.Back())
+	}
+}
+
+// Len returns the number of cached entries, including expired ones that have
+// not been evicted yet.
+func (m *CacheMiddleware) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.lru.Len()
+}
+
+// Clear removes all cached entries.
+func (m *CacheMiddleware) Clear() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.entries = make(map[string]*list.Element)
+	m.lru.Init()
+}
+
+// removeLocked removes elem from the cache. The caller must hold m.mu.
+func (m *CacheMiddleware) removeLocked(elem *list.Element) {
+	m.lru.Remove(elem)
+	delete(m.entries, elem.Value.(*cacheEntry).key)
+}
+
+// cacheKey identifies a cached response.
+func cacheKey(method, url string) string {
+	return method + " " + url
+}
+
+// hasHeader reports whether headers contains name, compared case-insensitively.
+func hasHeader(headers map[string]string, name string) bool {
+	for key := range headers {
+		if strings.EqualFold(key, name) {
+			return true
+		}
+	}
+	return false
+}
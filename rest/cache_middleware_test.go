@@ -0,0 +1,318 @@
+package rest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newCountingServer returns a server that answers with the number of requests
+// it has received, along with that counter.
+func newCountingServer(t *testing.T, handler func(w http.ResponseWriter, r *http.Request, n int32)) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	var hits atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handler(w, r, hits.Add(1))
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &hits
+}
+
+func TestCacheMiddleware(t *testing.T) {
+	ctx := context.Background()
+	var _ Middleware = &CacheMiddleware{}
+
+	respond := func(w http.ResponseWriter, r *http.Request, n int32) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"version":%d}`, n)
+	}
+
+	t.Run("serves repeated GET from cache", func(t *testing.T) {
+		server, hits := newCountingServer(t, respond)
+		client := NewClient(WithMiddleware(NewCacheMiddleware(time.Minute, 10)))
+
+		first, err := client.MakeRequest(ctx, http.MethodGet, server.URL+"/categories", "", nil)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		second, err := client.MakeRequest(ctx, http.MethodGet, server.URL+"/categories", "", nil)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if got := hits.Load(); got != 1 {
+			t.Errorf("Expected 1 server hit, got %d", got)
+		}
+		if second.String() != first.String() {
+			t.Errorf("Expected cached body %q, got %q", first.String(), second.String())
+		}
+		if second.StatusCode() != http.StatusOK {
+			t.Errorf("Expected status 200, got %d", second.StatusCode())
+		}
+		if got := second.Header().Get("Content-Type"); got != "application/json" {
+			t.Errorf("Expected cached Content-Type header, got %q", got)
+		}
+	})
+
+	t.Run("refetches after TTL expiry", func(t *testing.T) {
+		server, hits := newCountingServer(t, respond)
+		clock := time.Unix(1_700_000_000, 0)
+		cache := NewCacheMiddleware(time.Minute, 10)
+		cache.now = func() time.Time { return clock }
+		client := NewClient(WithMiddleware(cache))
+
+		if _, err := client.MakeRequest(ctx, http.MethodGet, server.URL, "", nil); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		clock = clock.Add(59 * time.Second)
+		if _, err := client.MakeRequest(ctx, http.MethodGet, server.URL, "", nil); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if got := hits.Load(); got != 1 {
+			t.Fatalf("Expected 1 server hit before expiry, got %d", got)
+		}
+
+		// A hit does not extend the TTL
+		clock = clock.Add(time.Second)
+		response, err := client.MakeRequest(ctx, http.MethodGet, server.URL, "", nil)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if got := hits.Load(); got != 2 {
+			t.Errorf("Expected 2 server hits after expiry, got %d", got)
+		}
+		if response.String() != `{"version":2}` {
+			t.Errorf("Expected refetched body, got %q", response.String())
+		}
+	})
+
+	t.Run("caches only successful GET responses", func(t *testing.T) {
+		server, hits := newCountingServer(t, func(w http.ResponseWriter, r *http.Request, n int32) {
+			switch r.URL.Path {
+			case "/missing":
+				w.WriteHeader(http.StatusNotFound)
+			case "/no-store":
+				w.Header().Set("Cache-Control", "no-store")
+			}
+		})
+		client := NewClient(WithMiddleware(NewCacheMiddleware(time.Minute, 10)))
+
+		for i := 0; i < 2; i++ {
+			_, _ = client.MakeRequest(ctx, http.MethodPost, server.URL+"/items", "{}", nil)
+			_, _ = client.MakeRequest(ctx, http.MethodGet, server.URL+"/missing", "", nil)
+			_, _ = client.MakeRequest(ctx, http.MethodGet, server.URL+"/no-store", "", nil)
+		}
+
+		if got := hits.Load(); got != 6 {
+			t.Errorf("Expected every request to reach the server, got %d hits", got)
+		}
+	})
+
+	t.Run("keys by URL", func(t *testing.T) {
+		server, hits := newCountingServer(t, respond)
+		client := NewClient(WithMiddleware(NewCacheMiddleware(time.Minute, 10)))
+
+		_, _ = client.MakeRequest(ctx, http.MethodGet, server.URL+"/a", "", nil)
+		_, _ = client.MakeRequest(ctx, http.MethodGet, server.URL+"/b", "", nil)
+		_, _ = client.MakeRequest(ctx, http.MethodGet, server.URL+"/a?page=2", "", nil)
+
+		if got := hits.Load(); got != 3 {
+			t.Errorf("Expected 3 server hits, got %d", got)
+		}
+	})
+
+	t.Run("evicts least recently used entry", func(t *testing.T) {
+		server, hits := newCountingServer(t, respond)
+		cache := NewCacheMiddleware(time.Minute, 2)
+		client := NewClient(WithMiddleware(cache))
+
+		_, _ = client.MakeRequest(ctx, http.MethodGet, server.URL+"/a", "", nil)
+		_, _ = client.MakeRequest(ctx, http.MethodGet, server.URL+"/b", "", nil)
+		_, _ = client.MakeRequest(ctx, http.MethodGet, server.URL+"/a", "", nil) // hit, /b is now oldest
+		_, _ = client.MakeRequest(ctx, http.MethodGet, server.URL+"/c", "", nil) // evicts /b
+
+		if cache.Len() != 2 {
+			t.Errorf("Expected 2 cached entries, got %d", cache.Len())
+		}
+
+		_, _ = client.MakeRequest(ctx, http.MethodGet, server.URL+"/a", "", nil) // hit
+		_, _ = client.MakeRequest(ctx, http.MethodGet, server.URL+"/b", "", nil) // miss
+		if got := hits.Load(); got != 4 {
+			t.Errorf("Expected 4 server hits, got %d", got)
+		}
+	})
+
+	t.Run("cached body cannot be modified by callers", func(t *testing.T) {
+		server, _ := newCountingServer(t, respond)
+		client := NewClient(WithMiddleware(NewCacheMiddleware(time.Minute, 10)))
+
+		_, _ = client.MakeRequest(ctx, http.MethodGet, server.URL, "", nil)
+		hit, _ := client.MakeRequest(ctx, http.MethodGet, server.URL, "", nil)
+		hit.Body()[0] = 'X'
+
+		again, _ := client.MakeRequest(ctx, http.MethodGet, server.URL, "", nil)
+		if again.String() != `{"version":1}` {
+			t.Errorf("Expected unmodified cached body, got %q", again.String())
+		}
+	})
+
+	t.Run("clear empties the cache", func(t *testing.T) {
+		server, hits := newCountingServer(t, respond)
+		cache := NewCacheMiddleware(time.Minute, 10)
+		client := NewClient(WithMiddleware(cache))
+
+		_, _ = client.MakeRequest(ctx, http.MethodGet, server.URL, "", nil)
+		cache.Clear()
+		_, _ = client.MakeRequest(ctx, http.MethodGet, server.URL, "", nil)
+
+		if got := hits.Load(); got != 2 {
+			t.Errorf("Expected 2 server hits after Clear, got %d", got)
+		}
+	})
+}
+
+func TestCacheMiddleware_ETagRevalidation(t *testing.T) {
+	ctx := context.Background()
+
+	newETagServer := func(t *testing.T, etag *atomic.Value) (*httptest.Server, *atomic.Int32, *atomic.Int32) {
+		var notModified atomic.Int32
+		server, hits := newCountingServer(t, func(w http.ResponseWriter, r *http.Request, n int32) {
+			current := etag.Load().(string)
+			if r.Header.Get("If-None-Match") == current {
+				notModified.Add(1)
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+			w.Header().Set("ETag", current)
+			fmt.Fprintf(w, `{"etag":%s}`, current)
+		})
+		return server, hits, &notModified
+	}
+
+	t.Run("serves cached body on 304", func(t *testing.T) {
+		var etag atomic.Value
+		etag.Store(`"v1"`)
+		server, hits, notModified := newETagServer(t, &etag)
+
+		clock := time.Unix(1_700_000_000, 0)
+		cache := NewCacheMiddleware(time.Minute, 10, WithETagRevalidation())
+		cache.now = func() time.Time { return clock }
+		client := NewClient(WithMiddleware(cache))
+
+		if _, err := client.MakeRequest(ctx, http.MethodGet, server.URL, "", nil); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		clock = clock.Add(2 * time.Minute)
+		response, err := client.MakeRequest(ctx, http.MethodGet, server.URL, "", nil)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if notModified.Load() != 1 {
+			t.Fatalf("Expected a conditional request answered with 304, got %d", notModified.Load())
+		}
+		if response.StatusCode() != http.StatusOK || response.String() != `{"etag":"v1"}` {
+			t.Errorf("Expected cached 200 response, got %d %q", response.StatusCode(), response.String())
+		}
+
+		// The 304 renewed the TTL
+		clock = clock.Add(30 * time.Second)
+		_, _ = client.MakeRequest(ctx, http.MethodGet, server.URL, "", nil)
+		if got := hits.Load(); got != 2 {
+			t.Errorf("Expected 2 server hits, got %d", got)
+		}
+	})
+
+	t.Run("replaces entry when resource changed", func(t *testing.T) {
+		var etag atomic.Value
+		etag.Store(`"v1"`)
+		server, _, notModified := newETagServer(t, &etag)
+
+		clock := time.Unix(1_700_000_000, 0)
+		cache := NewCacheMiddleware(time.Minute, 10, WithETagRevalidation())
+		cache.now = func() time.Time { return clock }
+		client := NewClient(WithMiddleware(cache))
+
+		_, _ = client.MakeRequest(ctx, http.MethodGet, server.URL, "", nil)
+
+		etag.Store(`"v2"`)
+		clock = clock.Add(2 * time.Minute)
+		response, err := client.MakeRequest(ctx, http.MethodGet, server.URL, "", nil)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if notModified.Load() != 0 {
+			t.Errorf("Expected no 304, got %d", notModified.Load())
+		}
+		if response.String() != `{"etag":"v2"}` {
+			t.Errorf("Expected updated body, got %q", response.String())
+		}
+
+		cached, _ := client.MakeRequest(ctx, http.MethodGet, server.URL, "", nil)
+		if cached.String() != `{"etag":"v2"}` {
+			t.Errorf("Expected updated body to be cached, got %q", cached.String())
+		}
+	})
+
+	t.Run("does not leak If-None-Match into a reused headers map", func(t *testing.T) {
+		var etag atomic.Value
+		etag.Store(`"v1"`)
+		server, _, notModified := newETagServer(t, &etag)
+
+		var received atomic.Value
+		received.Store("")
+		other, _ := newCountingServer(t, func(w http.ResponseWriter, r *http.Request, n int32) {
+			received.Store(r.Header.Get("If-None-Match"))
+			fmt.Fprint(w, `{"other":true}`)
+		})
+
+		clock := time.Unix(1_700_000_000, 0)
+		cache := NewCacheMiddleware(time.Minute, 10, WithETagRevalidation())
+		cache.now = func() time.Time { return clock }
+		client := NewClient(WithMiddleware(cache))
+
+		headers := map[string]string{"Accept": "application/json"}
+		_, _ = client.MakeRequest(ctx, http.MethodGet, server.URL, "", headers)
+		clock = clock.Add(2 * time.Minute)
+		_, _ = client.MakeRequest(ctx, http.MethodGet, server.URL, "", headers)
+		if notModified.Load() != 1 {
+			t.Fatalf("Expected a conditional request answered with 304, got %d", notModified.Load())
+		}
+
+		if _, err := client.MakeRequest(ctx, http.MethodGet, other.URL, "", headers); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if got := received.Load().(string); got != "" {
+			t.Errorf("Expected no If-None-Match on the second URL, got %q", got)
+		}
+		if _, ok := headers["If-None-Match"]; ok || len(headers) != 1 {
+			t.Errorf("Expected caller headers to be unchanged, got %v", headers)
+		}
+	})
+
+	t.Run("disabled by default", func(t *testing.T) {
+		var etag atomic.Value
+		etag.Store(`"v1"`)
+		server, hits, notModified := newETagServer(t, &etag)
+
+		clock := time.Unix(1_700_000_000, 0)
+		cache := NewCacheMiddleware(time.Minute, 10)
+		cache.now = func() time.Time { return clock }
+		client := NewClient(WithMiddleware(cache))
+
+		_, _ = client.MakeRequest(ctx, http.MethodGet, server.URL, "", nil)
+		clock = clock.Add(2 * time.Minute)
+		_, _ = client.MakeRequest(ctx, http.MethodGet, server.URL, "", nil)
+
+		if hits.Load() != 2 || notModified.Load() != 0 {
+			t.Errorf("Expected an unconditional refetch, got %d hits and %d 304s", hits.Load(), notModified.Load())
+		}
+	})
+}
@@ -4,12 +4,18 @@ package rest
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"io"
 	"net/http"
+	"os"
+	"path/filepath"
 	"sync"
 	"time"
 
 	"github.com/go-resty/resty/v2"
+	"go.opentelemetry.io/otel/propagation"
 
 	"github.com/jasoet/pkg/v2/otel"
 )
@@ -20,6 +26,10 @@ type Client struct {
 	restConfig  *Config
 	middlewares []Middleware
 	mu          sync.RWMutex
+
+	retryCondition   func(*resty.Response, error) bool
+	retryAfterHeader bool
+	autoDecompress   bool
 }
 
 // ClientOption configures a Client during construction.
@@ -59,6 +69,43 @@ func WithOTelConfig(cfg *otel.Config) ClientOption {
 	}
 }
 
+// WithRetryCondition replaces the default retry condition (transport errors and
+// 5xx responses) with condition, which decides per attempt whether to retry.
+// Retries still require Config.RetryCount > 0. When retries are exhausted, the
+// last response is returned and handled as usual.
+//
+// Example, retrying rate-limited requests as well as server errors:
+//
+//	rest.WithRetryCondition(func(resp *resty.Response, err error) bool {
+//	    return err != nil || resp.StatusCode() == http.StatusTooManyRequests || resp.StatusCode() >= 500
+//	})
+func WithRetryCondition(condition func(resp *resty.Response, err error) bool) ClientOption {
+	return func(client *Client) {
+		client.retryCondition = condition
+	}
+}
+
+// WithRetryAfterHeader makes retries wait for the duration given by the response's
+// Retry-After header (delta-seconds or HTTP-date) instead of the exponential
+// backoff. The wait is clamped to Config.RetryWaitTime and Config.RetryMaxWaitTime;
+// responses without a valid Retry-After fall back to the backoff.
+func WithRetryAfterHeader(enabled bool) ClientOption {
+	return func(client *Client) {
+		client.retryAfterHeader = enabled
+	}
+}
+
+// defaultRetryCondition retries transport errors and 5xx responses.
+func defaultRetryCondition(r *resty.Response, err error) bool {
+	return err != nil || (r != nil && r.StatusCode() >= 500)
+}
+
+// retryAfter reads the backoff from the Retry-After header. Zero makes resty fall
+// back to its exponential backoff.
+func retryAfter(_ *resty.Client, r *resty.Response) (time.Duration, error) {
+	return parseRetryAfter(r.Header().Get("Retry-After"), time.Now()), nil
+}
+
 // truncateBody limits the body string to maxLen bytes, appending "...(truncated)" if truncated.
 // If maxLen is 0 or negative, the full body is returned unchanged.
 func truncateBody(body string, maxLen int) string {
@@ -116,9 +163,17 @@ func NewClient(options ...ClientOption) *Client {
 		SetRetryWaitTime(client.restConfig.RetryWaitTime).
 		SetRetryMaxWaitTime(client.restConfig.RetryMaxWaitTime).
 		SetTimeout(client.restConfig.Timeout)
-	httpClient.AddRetryCondition(func(r *resty.Response, err error) bool {
-		return err != nil || (r != nil && r.StatusCode() >= 500)
-	})
+	retryCondition := client.retryCondition
+	if retryCondition == nil {
+		retryCondition = defaultRetryCondition
+	}
+	httpClient.AddRetryCondition(retryCondition)
+	if client.retryAfterHeader {
+		httpClient.SetRetryAfter(retryAfter)
+	}
+	if client.restConfig.hasTransportSettings() {
+		httpClient.SetTransport(client.restConfig.transport())
+	}
 
 	client.restClient = httpClient
 
@@ -171,7 +226,8 @@ func (c *Client) MakeRequestWithTrace(ctx context.Context, method string, url st
 	return c.doRequest(ctx, method, url, body, headers, true)
 }
 
-// MakeRequest executes an HTTP request without resty trace.
+// MakeRequest executes an HTTP request without resty trace. The headers map is
+// not modified, so it can be reused across requests and goroutines.
 //
 // The body parameter is a string; for binary payloads, use GetRestClient()
 // and build the request directly with resty's SetBody(interface{}).
@@ -179,6 +235,195 @@ func (c *Client) MakeRequest(ctx context.Context, method string, url string, bod
 	return c.doRequest(ctx, method, url, body, headers, false)
 }
 
+// Head issues a HEAD request and returns the response headers and status code,
+// e.g. to check that a resource exists or read its metadata without downloading it.
+// Non-2xx statuses are reported as typed errors (see HandleResponse) alongside the
+// headers and status, so a missing resource yields a 404 and a *ResourceNotFoundError.
+func (c *Client) Head(ctx context.Context, url string, headers map[string]string) (http.Header, int, error) {
+	return c.headerRequest(ctx, http.MethodHead, url, headers)
+}
+
+// Options issues an OPTIONS request and returns the response headers and status
+// code, e.g. to read the Allow header. Errors are reported as for Head.
+func (c *Client) Options(ctx context.Context, url string, headers map[string]string) (http.Header, int, error) {
+	return c.headerRequest(ctx, http.MethodOptions, url, headers)
+}
+
+// headerRequest is the shared implementation for Head and Options.
+func (c *Client) headerRequest(ctx context.Context, method string, url string, headers map[string]string) (http.Header, int, error) {
+	response, err := c.doRequest(ctx, method, url, "", headers, false)
+	if response == nil || response.RawResponse == nil {
+		return nil, 0, err
+	}
+	return response.Header(), response.StatusCode(), err
+}
+
+// DoJSON executes a JSON request through the middleware chain like MakeRequest.
+// reqBody is marshalled to JSON unless it is nil (no body) or already a string,
+// which is sent as is. Content-Type (when there is a body) and Accept default to
+// application/json; entries in headers take precedence and the map is not modified.
+//
+// On a successful response with a non-empty body, the body is unmarshalled into
+// respOut when it is non-nil. Non-success responses are returned as typed errors
+// (see HandleResponse) without decoding.
+//
+// Example:
+//
+//	var user User
+//	_, err := client.DoJSON(ctx, http.MethodPost, baseURL+"/users",
+//	    CreateUser{Name: "Alice"}, &user, nil)
+func (c *Client) DoJSON(ctx context.Context, method string, url string, reqBody any, respOut any, headers map[string]string) (*resty.Response, error) {
+	var body string
+	switch v := reqBody.(type) {
+	case nil:
+	case string:
+		body = v
+	default:
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal request body: %w", err)
+		}
+		body = string(encoded)
+	}
+
+	jsonHeaders := make(map[string]string, len(headers)+2)
+	for k, v := range headers {
+		jsonHeaders[k] = v
+	}
+	if body != "" && !hasHeader(jsonHeaders, "Content-Type") {
+		jsonHeaders["Content-Type"] = "application/json"
+	}
+	if !hasHeader(jsonHeaders, "Accept") {
+		jsonHeaders["Accept"] = "application/json"
+	}
+
+	response, err := c.doRequest(ctx, method, url, body, jsonHeaders, false)
+	if err != nil {
+		return response, err
+	}
+
+	if respOut != nil && len(response.Body()) > 0 {
+		if err := json.Unmarshal(response.Body(), respOut); err != nil {
+			return response, fmt.Errorf("failed to unmarshal response body: %w", err)
+		}
+	}
+
+	return response, nil
+}
+
+// maxDownloadErrorBody caps how much of a failed download's body is read for
+// the returned error.
+const maxDownloadErrorBody = 64 * 1024
+
+// DownloadFile issues a GET request and streams the response body to destPath
+// without buffering it in memory. The body is written to a temporary file next to
+// destPath that is renamed into place once complete, so on any error (including
+// ctx cancellation mid-transfer) no partial file is left behind. The file is
+// created with mode 0600.
+//
+// onProgress, when non-nil, is called after each chunk is written with the bytes
+// written so far and the expected total from Content-Length, or -1 if unknown.
+//
+// Middleware is not applied because it expects a buffered response body; pass
+// authorization and other headers explicitly. Non-success responses are returned
+// as typed errors (see HandleResponse).
+//
+// Config.Timeout (30s by default) bounds the whole transfer, including reading
+// the body, so a download that takes longer fails with no file written. For large
+// files, use a client whose Config.Timeout is 0 and bound the download with ctx.
+//
+// Example:
+//
+//	err := client.DownloadFile(ctx, baseURL+"/backups/latest", "/var/backups/latest.tar.gz", nil,
+//	    func(written, total int64) {
+//	        if total > 0 {
+//	            fmt.Printf("\r%d%%", written*100/total)
+//	        }
+//	    })
+func (c *Client) DownloadFile(ctx context.Context, url, destPath string, headers map[string]string, onProgress func(written, total int64)) (err error) {
+	var otelConfig *otel.Config
+	if c.restConfig != nil {
+		otelConfig = c.restConfig.OTelConfig
+	}
+	logger := otel.NewLogHelper(ctx, otelConfig, "github.com/jasoet/pkg/v2/rest", "rest.DownloadFile")
+
+	if c.restClient == nil {
+		return errors.New("rest client is nil")
+	}
+
+	requestHeaders := make(map[string]string, len(headers))
+	for k, v := range headers {
+		requestHeaders[k] = v
+	}
+	propagation.Baggage{}.Inject(ctx, propagation.MapCarrier(requestHeaders))
+
+	response, err := c.restClient.R().
+		SetHeaders(requestHeaders).
+		SetContext(ctx).
+		SetDoNotParseResponse(true).
+		Get(url)
+	if err != nil {
+		logger.Error(err, "Failed to make request")
+		return NewExecutionError("Failed to make request", err)
+	}
+	body := response.RawBody()
+	defer body.Close()
+
+	if !response.IsSuccess() {
+		errBody, _ := io.ReadAll(io.LimitReader(body, maxDownloadErrorBody))
+		response.SetBody(errBody)
+		if err := c.HandleResponse(response); err != nil {
+			return err
+		}
+		return NewResponseError(response.StatusCode(), "Unexpected status", string(errBody))
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(destPath), "."+filepath.Base(destPath)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create download file: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tmp.Close()
+			_ = os.Remove(tmp.Name())
+		}
+	}()
+
+	var w io.Writer = tmp
+	if onProgress != nil {
+		w = &progressWriter{w: tmp, total: response.RawResponse.ContentLength, onProgress: onProgress}
+	}
+	written, err := io.Copy(w, body)
+	if err != nil {
+		logger.Error(err, "Failed to download file", otel.F("written", written))
+		return fmt.Errorf("failed to download file: %w", err)
+	}
+	if err = tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write download file: %w", err)
+	}
+	if err = os.Rename(tmp.Name(), destPath); err != nil {
+		return fmt.Errorf("failed to move download file into place: %w", err)
+	}
+
+	logger.Debug("File downloaded", otel.F("path", destPath), otel.F("bytes", written))
+	return nil
+}
+
+// progressWriter reports the running byte count to onProgress after each write.
+type progressWriter struct {
+	w          io.Writer
+	written    int64
+	total      int64
+	onProgress func(written, total int64)
+}
+
+func (p *progressWriter) Write(b []byte) (int, error) {
+	n, err := p.w.Write(b)
+	p.written += int64(n)
+	p.onProgress(p.written, p.total)
+	return n, err
+}
+
 // doRequest is the shared implementation for MakeRequest and MakeRequestWithTrace.
 //
 // Note: The url parameter is passed directly to resty with no validation. Callers
@@ -200,6 +445,21 @@ func (c *Client) doRequest(ctx context.Context, method string, url string, body
 		return nil, errors.New("rest client is nil")
 	}
 
+	// Defaults, baggage and middleware (e.g. Authorization, X-Request-ID) add
+	// headers, so they write to a per-request copy and never to the caller's map
+	requestHeaders := make(map[string]string, len(headers))
+	for k, v := range headers {
+		requestHeaders[k] = v
+	}
+	headers = requestHeaders
+
+	if c.autoDecompress && !hasHeader(headers, "Accept-Encoding") {
+		headers["Accept-Encoding"] = acceptEncoding
+	}
+
+	// Propagate W3C baggage (see otel.SetBaggage) independently of tracing
+	propagation.Baggage{}.Inject(ctx, propagation.MapCarrier(headers))
+
 	startTime := time.Now()
 	c.mu.RLock()
 	middlewaresCopy := make([]Middleware, len(c.middlewares))
@@ -225,23 +485,17 @@ func (c *Client) doRequest(ctx context.Context, method string, url string, body
 	var response *resty.Response
 	var err error
 
-	switch method {
-	case http.MethodGet:
-		response, err = request.Get(url)
-	case http.MethodPost:
-		response, err = request.Post(url)
-	case http.MethodPut:
-		response, err = request.Put(url)
-	case http.MethodDelete:
-		response, err = request.Delete(url)
-	case http.MethodPatch:
-		response, err = request.Patch(url)
-	case http.MethodHead:
-		response, err = request.Head(url)
-	case http.MethodOptions:
-		response, err = request.Options(url)
-	default:
-		response, err = request.Execute(method, url)
+	if cached, ok := cachedResponseFromContext(ctx); ok {
+		// Served from cache by CacheMiddleware, no network round-trip
+		response = cached.toRestyResponse(request)
+	} else {
+		response, err = executeRequest(request, method, url)
+		if err == nil && c.autoDecompress {
+			err = decompressResponse(response)
+		}
+		if err == nil {
+			response = revalidatedResponse(ctx, response)
+		}
 	}
 
 	endTime := time.Now()
@@ -269,6 +523,8 @@ func (c *Client) doRequest(ctx context.Context, method string, url string, body
 			maxLog = c.restConfig.MaxResponseBodyLog
 		}
 		requestInfo.Response = truncateBody(response.String(), maxLog)
+		requestInfo.ResponseBody = response.Body()
+		requestInfo.ResponseHeaders = response.Header()
 		if enableTrace && response.Request != nil {
 			requestInfo.TraceInfo = response.Request.TraceInfo()
 		}
@@ -291,9 +547,31 @@ func (c *Client) doRequest(ctx context.Context, method string, url string, body
 	return response, nil
 }
 
+// executeRequest sends request with the given method.
+func executeRequest(request *resty.Request, method string, url string) (*resty.Response, error) {
+	switch method {
+	case http.MethodGet:
+		return request.Get(url)
+	case http.MethodPost:
+		return request.Post(url)
+	case http.MethodPut:
+		return request.Put(url)
+	case http.MethodDelete:
+		return request.Delete(url)
+	case http.MethodPatch:
+		return request.Patch(url)
+	case http.MethodHead:
+		return request.Head(url)
+	case http.MethodOptions:
+		return request.Options(url)
+	default:
+		return request.Execute(method, url)
+	}
+}
+
 // HandleResponse checks the HTTP status code and returns a typed error for
 // non-success responses. Checks are ordered from most specific to least:
-// 401/403 -> 404 -> 5xx -> other 4xx.
+// 401/403 -> 404 -> 429 -> 5xx -> other 4xx.
 func (c *Client) HandleResponse(response *resty.Response) error {
 	maxLog := 0
 	if c.restConfig != nil {
@@ -309,6 +587,11 @@ func (c *Client) HandleResponse(response *resty.Response) error {
 		return NewResourceNotFoundError(response.StatusCode(), "Resource not found", body)
 	}
 
+	if IsTooManyRequests(response) {
+		retryAfter := parseRetryAfter(response.Header().Get("Retry-After"), time.Now())
+		return NewTooManyRequestsError(response.StatusCode(), "Too many requests", body, retryAfter)
+	}
+
 	if IsServerError(response) {
 		return NewServerError(response.StatusCode(), "Server error", body)
 	}
@@ -336,8 +619,13 @@ func IsNotFound(response *resty.Response) bool {
 	return response.StatusCode() == http.StatusNotFound
 }
 
+// IsTooManyRequests returns true for HTTP 429 (Too Many Requests).
+func IsTooManyRequests(response *resty.Response) bool {
+	return response.StatusCode() == http.StatusTooManyRequests
+}
+
 // IsClientError returns true for any HTTP 4xx status code.
-// Note: this overlaps with IsUnauthorized and IsNotFound; in HandleResponse,
+// Note: this overlaps with IsUnauthorized, IsNotFound and IsTooManyRequests; in HandleResponse,
 // those are checked first so IsClientError only catches remaining 4xx codes.
 func IsClientError(response *resty.Response) bool {
 	return response.StatusCode() >= 400 && response.StatusCode() < 500
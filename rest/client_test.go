@@ -2,16 +2,23 @@ package rest
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/go-resty/resty/v2"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
 
 	"github.com/jasoet/pkg/v2/concurrent"
 	"github.com/jasoet/pkg/v2/otel"
@@ -180,6 +187,59 @@ func TestClient_GetRestClient(t *testing.T) {
 	}
 }
 
+func TestNewClient_TransportSettings(t *testing.T) {
+	config := DefaultRestConfig()
+	config.MaxIdleConns = 200
+	config.MaxIdleConnsPerHost = 50
+	config.MaxConnsPerHost = 100
+	config.IdleConnTimeout = 45 * time.Second
+
+	client := NewClient(WithRestConfig(*config))
+
+	transport, ok := client.GetRestClient().GetClient().Transport.(*http.Transport)
+	if !ok {
+		t.Fatalf("Expected *http.Transport, got %T", client.GetRestClient().GetClient().Transport)
+	}
+	if transport.MaxIdleConns != 200 {
+		t.Errorf("Expected MaxIdleConns to be 200, got %d", transport.MaxIdleConns)
+	}
+	if transport.MaxIdleConnsPerHost != 50 {
+		t.Errorf("Expected MaxIdleConnsPerHost to be 50, got %d", transport.MaxIdleConnsPerHost)
+	}
+	if transport.MaxConnsPerHost != 100 {
+		t.Errorf("Expected MaxConnsPerHost to be 100, got %d", transport.MaxConnsPerHost)
+	}
+	if transport.IdleConnTimeout != 45*time.Second {
+		t.Errorf("Expected IdleConnTimeout to be 45s, got %s", transport.IdleConnTimeout)
+	}
+	if transport == http.DefaultTransport {
+		t.Error("Expected a clone, not http.DefaultTransport itself")
+	}
+}
+
+func TestNewClient_PartialTransportSettings(t *testing.T) {
+	config := DefaultRestConfig()
+	config.MaxIdleConnsPerHost = 32
+
+	client := NewClient(WithRestConfig(*config))
+
+	transport, ok := client.GetRestClient().GetClient().Transport.(*http.Transport)
+	if !ok {
+		t.Fatalf("Expected *http.Transport, got %T", client.GetRestClient().GetClient().Transport)
+	}
+
+	defaults := http.DefaultTransport.(*http.Transport)
+	if transport.MaxIdleConnsPerHost != 32 {
+		t.Errorf("Expected MaxIdleConnsPerHost to be 32, got %d", transport.MaxIdleConnsPerHost)
+	}
+	if transport.MaxIdleConns != defaults.MaxIdleConns {
+		t.Errorf("Expected default MaxIdleConns %d, got %d", defaults.MaxIdleConns, transport.MaxIdleConns)
+	}
+	if transport.IdleConnTimeout != defaults.IdleConnTimeout {
+		t.Errorf("Expected default IdleConnTimeout %s, got %s", defaults.IdleConnTimeout, transport.IdleConnTimeout)
+	}
+}
+
 func TestClient_GetRestConfig(t *testing.T) {
 	client := NewClient()
 	config := client.GetRestConfig()
@@ -503,6 +563,69 @@ func TestClient_HandleResponse(t *testing.T) {
 	})
 }
 
+func TestClient_HandleResponse_TooManyRequests(t *testing.T) {
+	client := NewClient()
+
+	t.Run("Retry-After seconds", func(t *testing.T) {
+		response := &resty.Response{}
+		response.Request = &resty.Request{}
+		response.RawResponse = &http.Response{
+			StatusCode: http.StatusTooManyRequests,
+			Header:     http.Header{"Retry-After": []string{"5"}},
+		}
+
+		err := client.HandleResponse(response)
+
+		var tooManyErr *TooManyRequestsError
+		if !errors.As(err, &tooManyErr) {
+			t.Fatalf("Expected TooManyRequestsError, got %T", err)
+		}
+		if tooManyErr.StatusCode != http.StatusTooManyRequests {
+			t.Errorf("Expected StatusCode %d, got %d", http.StatusTooManyRequests, tooManyErr.StatusCode)
+		}
+		if tooManyErr.RetryAfter != 5*time.Second {
+			t.Errorf("Expected RetryAfter 5s, got %v", tooManyErr.RetryAfter)
+		}
+	})
+
+	t.Run("Retry-After HTTP date", func(t *testing.T) {
+		retryAt := time.Now().Add(time.Hour).UTC()
+		response := &resty.Response{}
+		response.Request = &resty.Request{}
+		response.RawResponse = &http.Response{
+			StatusCode: http.StatusTooManyRequests,
+			Header:     http.Header{"Retry-After": []string{retryAt.Format(http.TimeFormat)}},
+		}
+
+		err := client.HandleResponse(response)
+
+		var tooManyErr *TooManyRequestsError
+		if !errors.As(err, &tooManyErr) {
+			t.Fatalf("Expected TooManyRequestsError, got %T", err)
+		}
+		// HTTP dates have second precision and time passes during the test
+		if tooManyErr.RetryAfter <= 59*time.Minute || tooManyErr.RetryAfter > time.Hour {
+			t.Errorf("Expected RetryAfter close to 1h, got %v", tooManyErr.RetryAfter)
+		}
+	})
+
+	t.Run("Missing Retry-After", func(t *testing.T) {
+		response := &resty.Response{}
+		response.Request = &resty.Request{}
+		response.RawResponse = &http.Response{StatusCode: http.StatusTooManyRequests}
+
+		err := client.HandleResponse(response)
+
+		var tooManyErr *TooManyRequestsError
+		if !errors.As(err, &tooManyErr) {
+			t.Fatalf("Expected TooManyRequestsError, got %T", err)
+		}
+		if tooManyErr.RetryAfter != 0 {
+			t.Errorf("Expected zero RetryAfter, got %v", tooManyErr.RetryAfter)
+		}
+	})
+}
+
 func TestIsServerError(t *testing.T) {
 	t.Run("Valid HTTP status", func(t *testing.T) {
 		response := &resty.Response{}
@@ -930,3 +1053,562 @@ func TestClient_MakeRequestWithTrace(t *testing.T) {
 		}
 	})
 }
+
+func TestClient_MakeRequestWithTrace_PropagatesBaggage(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("baggage", r.Header.Get("baggage"))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	ctx := otel.SetBaggage(context.Background(),
+		attribute.String("tenant.id", "acme"),
+		attribute.Int("request.priority", 2),
+	)
+
+	client := NewClient()
+	response, err := client.MakeRequestWithTrace(ctx, "GET", server.URL, "", nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// Decode the echoed header to check the values round-trip
+	echoed := propagation.Baggage{}.Extract(context.Background(), propagation.HeaderCarrier(response.Header()))
+	if tenant, ok := otel.GetBaggage(echoed, "tenant.id"); !ok || tenant != "acme" {
+		t.Errorf("Expected tenant.id 'acme', got %q (present: %v)", tenant, ok)
+	}
+	if priority, ok := otel.GetBaggage(echoed, "request.priority"); !ok || priority != "2" {
+		t.Errorf("Expected request.priority '2', got %q (present: %v)", priority, ok)
+	}
+}
+
+func TestClient_MakeRequest_DoesNotModifyHeaders(t *testing.T) {
+	var mu sync.Mutex
+	var baggage []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		baggage = append(baggage, r.Header.Get("baggage"))
+		mu.Unlock()
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := NewClient()
+	headers := map[string]string{"X-Foo": "bar"}
+
+	ctx := otel.SetBaggage(context.Background(), attribute.String("tenant", "A"))
+	if _, err := client.MakeRequest(ctx, "GET", server.URL, "", headers); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, err := client.MakeRequest(context.Background(), "GET", server.URL, "", headers); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(headers) != 1 || headers["X-Foo"] != "bar" {
+		t.Errorf("Expected caller headers to be unchanged, got %v", headers)
+	}
+	if len(baggage) != 2 || baggage[0] != "tenant=A" || baggage[1] != "" {
+		t.Errorf("Expected baggage only on the first request, got %q", baggage)
+	}
+
+	// Concurrent requests sharing one map must not race
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, _ = client.MakeRequest(ctx, "GET", server.URL, "", headers)
+		}()
+	}
+	wg.Wait()
+}
+
+func TestClient_Head(t *testing.T) {
+	t.Run("returns headers and status without body", func(t *testing.T) {
+		var method string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			method = r.Method
+			w.Header().Set("X-Resource-Version", "42")
+			w.Write([]byte("ignored for HEAD"))
+		}))
+		defer server.Close()
+
+		mw := &mockMiddleware{}
+		client := NewClient(WithMiddlewares(mw))
+
+		headers, status, err := client.Head(context.Background(), server.URL, nil)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if method != http.MethodHead {
+			t.Errorf("Expected HEAD request, got %s", method)
+		}
+		if status != http.StatusOK {
+			t.Errorf("Expected status 200, got %d", status)
+		}
+		if got := headers.Get("X-Resource-Version"); got != "42" {
+			t.Errorf("Expected X-Resource-Version 42, got %q", got)
+		}
+		if mw.requestInfo.Response != "" {
+			t.Errorf("Expected empty body, got %q", mw.requestInfo.Response)
+		}
+		if got := mw.requestInfo.ResponseHeaders.Get("X-Resource-Version"); got != "42" {
+			t.Errorf("Expected middleware to see X-Resource-Version 42, got %q", got)
+		}
+	})
+
+	t.Run("missing resource returns status and typed error", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		defer server.Close()
+
+		client := NewClient()
+
+		headers, status, err := client.Head(context.Background(), server.URL, nil)
+		var notFound *ResourceNotFoundError
+		if !errors.As(err, &notFound) {
+			t.Fatalf("Expected ResourceNotFoundError, got %v", err)
+		}
+		if status != http.StatusNotFound {
+			t.Errorf("Expected status 404, got %d", status)
+		}
+		if headers == nil {
+			t.Error("Expected non-nil headers")
+		}
+	})
+
+	t.Run("connection failure returns no headers", func(t *testing.T) {
+		client := NewClient(WithRestConfig(Config{Timeout: time.Second}))
+
+		headers, status, err := client.Head(context.Background(), "http://127.0.0.1:1", nil)
+		if err == nil {
+			t.Fatal("Expected error for unreachable server")
+		}
+		if headers != nil || status != 0 {
+			t.Errorf("Expected nil headers and status 0, got %v and %d", headers, status)
+		}
+	})
+}
+
+func TestClient_Options(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodOptions {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		w.Header().Set("Allow", "GET, HEAD, OPTIONS")
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := NewClient()
+
+	headers, status, err := client.Options(context.Background(), server.URL, map[string]string{"Origin": "https://example.com"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if status != http.StatusNoContent {
+		t.Errorf("Expected status 204, got %d", status)
+	}
+	if got := headers.Get("Allow"); got != "GET, HEAD, OPTIONS" {
+		t.Errorf("Expected Allow header, got %q", got)
+	}
+}
+
+func TestClient_RetryCondition(t *testing.T) {
+	newServer := func(attempts *int32) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if atomic.AddInt32(attempts, 1) <= 2 {
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+			w.Write([]byte("ok"))
+		}))
+	}
+	config := Config{
+		RetryCount:       3,
+		RetryWaitTime:    10 * time.Millisecond,
+		RetryMaxWaitTime: 50 * time.Millisecond,
+		Timeout:          5 * time.Second,
+	}
+
+	t.Run("default condition does not retry 429", func(t *testing.T) {
+		var attempts int32
+		server := newServer(&attempts)
+		defer server.Close()
+
+		client := NewClient(WithRestConfig(config))
+
+		_, err := client.MakeRequest(context.Background(), http.MethodGet, server.URL, "", nil)
+		var tooMany *TooManyRequestsError
+		if !errors.As(err, &tooMany) {
+			t.Fatalf("Expected TooManyRequestsError, got %v", err)
+		}
+		if got := atomic.LoadInt32(&attempts); got != 1 {
+			t.Errorf("Expected 1 attempt, got %d", got)
+		}
+	})
+
+	t.Run("custom condition retries until success", func(t *testing.T) {
+		var attempts int32
+		server := newServer(&attempts)
+		defer server.Close()
+
+		client := NewClient(
+			WithRestConfig(config),
+			WithRetryCondition(func(resp *resty.Response, err error) bool {
+				return err != nil || resp.StatusCode() == http.StatusTooManyRequests
+			}),
+		)
+
+		response, err := client.MakeRequest(context.Background(), http.MethodGet, server.URL, "", nil)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if response.String() != "ok" {
+			t.Errorf("Expected body ok, got %q", response.String())
+		}
+		if got := atomic.LoadInt32(&attempts); got != 3 {
+			t.Errorf("Expected 3 attempts, got %d", got)
+		}
+	})
+}
+
+func TestClient_RetryAfterHeader(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	client := NewClient(
+		WithRestConfig(Config{
+			RetryCount:       1,
+			RetryWaitTime:    10 * time.Millisecond,
+			RetryMaxWaitTime: 5 * time.Second,
+			Timeout:          5 * time.Second,
+		}),
+		WithRetryAfterHeader(true),
+	)
+
+	start := time.Now()
+	_, err := client.MakeRequest(context.Background(), http.MethodGet, server.URL, "", nil)
+	elapsed := time.Since(start)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if got := atomic.LoadInt32(&attempts); got != 2 {
+		t.Errorf("Expected 2 attempts, got %d", got)
+	}
+	if elapsed < time.Second {
+		t.Errorf("Expected retry to wait for Retry-After (1s), waited %v", elapsed)
+	}
+}
+
+func TestClient_DoJSON(t *testing.T) {
+	type createUser struct {
+		Name  string `json:"name"`
+		Email string `json:"email"`
+	}
+	type user struct {
+		ID    int    `json:"id"`
+		Name  string `json:"name"`
+		Email string `json:"email"`
+	}
+
+	t.Run("POST with struct body", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPost {
+				t.Errorf("Expected method POST, got %s", r.Method)
+			}
+			if got := r.Header.Get("Content-Type"); got != "application/json" {
+				t.Errorf("Expected Content-Type application/json, got %q", got)
+			}
+			if got := r.Header.Get("Accept"); got != "application/json" {
+				t.Errorf("Expected Accept application/json, got %q", got)
+			}
+
+			var in createUser
+			if err := json.NewDecoder(r.Body).Decode(&in); err != nil {
+				t.Errorf("Failed to decode request body: %v", err)
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			_ = json.NewEncoder(w).Encode(user{ID: 7, Name: in.Name, Email: in.Email})
+		}))
+		defer server.Close()
+
+		middleware := &mockMiddleware{}
+		client := NewClient(WithMiddlewares(middleware))
+
+		var out user
+		response, err := client.DoJSON(context.Background(), http.MethodPost, server.URL+"/users",
+			createUser{Name: "Alice", Email: "alice@example.com"}, &out, nil)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if response.StatusCode() != http.StatusCreated {
+			t.Errorf("Expected status %d, got %d", http.StatusCreated, response.StatusCode())
+		}
+		if want := (user{ID: 7, Name: "Alice", Email: "alice@example.com"}); out != want {
+			t.Errorf("Expected %+v, got %+v", want, out)
+		}
+		if !middleware.beforeRequestCalled || !middleware.afterRequestCalled {
+			t.Error("Expected middleware to run")
+		}
+	})
+
+	t.Run("GET with nil body", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodGet {
+				t.Errorf("Expected method GET, got %s", r.Method)
+			}
+			if r.ContentLength > 0 {
+				t.Errorf("Expected no request body, got %d bytes", r.ContentLength)
+			}
+			if got := r.Header.Get("Content-Type"); got != "" {
+				t.Errorf("Expected no Content-Type without a body, got %q", got)
+			}
+			if got := r.Header.Get("Accept"); got != "application/json" {
+				t.Errorf("Expected Accept application/json, got %q", got)
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"id":1,"name":"Bob","email":"bob@example.com"}`))
+		}))
+		defer server.Close()
+
+		client := NewClient()
+
+		var out user
+		_, err := client.DoJSON(context.Background(), http.MethodGet, server.URL+"/users/1", nil, &out, nil)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if want := (user{ID: 1, Name: "Bob", Email: "bob@example.com"}); out != want {
+			t.Errorf("Expected %+v, got %+v", want, out)
+		}
+	})
+
+	t.Run("string body and header overrides", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if got := r.Header.Get("Content-Type"); got != "application/merge-patch+json" {
+				t.Errorf("Expected overridden Content-Type, got %q", got)
+			}
+			body, _ := io.ReadAll(r.Body)
+			if string(body) != `{"name":"Carol"}` {
+				t.Errorf("Expected string body to be sent as is, got %q", body)
+			}
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		client := NewClient()
+		headers := map[string]string{"content-type": "application/merge-patch+json"}
+
+		var out user
+		_, err := client.DoJSON(context.Background(), http.MethodPatch, server.URL+"/users/1", `{"name":"Carol"}`, &out, headers)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if len(headers) != 1 {
+			t.Errorf("Expected caller headers to be left unchanged, got %v", headers)
+		}
+	})
+
+	t.Run("error response is not decoded", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"id":99}`))
+		}))
+		defer server.Close()
+
+		client := NewClient()
+
+		var out user
+		_, err := client.DoJSON(context.Background(), http.MethodGet, server.URL+"/users/99", nil, &out, nil)
+		if !errors.Is(err, ErrResourceNotFound) {
+			t.Errorf("Expected ErrResourceNotFound, got %v", err)
+		}
+		if out.ID != 0 {
+			t.Errorf("Expected output to be untouched, got %+v", out)
+		}
+	})
+
+	t.Run("invalid response JSON", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`not json`))
+		}))
+		defer server.Close()
+
+		client := NewClient()
+
+		var out user
+		_, err := client.DoJSON(context.Background(), http.MethodGet, server.URL, nil, &out, nil)
+		if err == nil || !strings.Contains(err.Error(), "failed to unmarshal response body") {
+			t.Errorf("Expected unmarshal error, got %v", err)
+		}
+	})
+
+	t.Run("unmarshalable request body", func(t *testing.T) {
+		client := NewClient()
+
+		_, err := client.DoJSON(context.Background(), http.MethodPost, "http://example.invalid", make(chan int), nil, nil)
+		if err == nil || !strings.Contains(err.Error(), "failed to marshal request body") {
+			t.Errorf("Expected marshal error, got %v", err)
+		}
+	})
+}
+
+func TestClient_DownloadFile(t *testing.T) {
+	payload := strings.Repeat("0123456789", 50_000) // 500 KB, several copy chunks
+
+	t.Run("streams body to file with progress", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if got := r.Header.Get("Authorization"); got != "Bearer token" {
+				t.Errorf("Expected Authorization header, got %q", got)
+			}
+			w.Header().Set("Content-Length", fmt.Sprint(len(payload)))
+			_, _ = io.WriteString(w, payload)
+		}))
+		defer server.Close()
+
+		dest := filepath.Join(t.TempDir(), "backup.bin")
+		var calls int
+		var lastWritten, lastTotal int64
+		client := NewClient()
+		err := client.DownloadFile(context.Background(), server.URL, dest,
+			map[string]string{"Authorization": "Bearer token"},
+			func(written, total int64) {
+				if written < lastWritten {
+					t.Errorf("Progress went backwards: %d after %d", written, lastWritten)
+				}
+				calls++
+				lastWritten, lastTotal = written, total
+			})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		info, err := os.Stat(dest)
+		if err != nil {
+			t.Fatalf("Expected downloaded file, got %v", err)
+		}
+		if info.Size() != int64(len(payload)) {
+			t.Errorf("Expected file size %d, got %d", len(payload), info.Size())
+		}
+		if calls < 2 {
+			t.Errorf("Expected several progress callbacks, got %d", calls)
+		}
+		if lastWritten != int64(len(payload)) || lastTotal != int64(len(payload)) {
+			t.Errorf("Expected final progress %d/%d, got %d/%d", len(payload), len(payload), lastWritten, lastTotal)
+		}
+	})
+
+	t.Run("unknown length reports -1 total", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.(http.Flusher).Flush() // forces chunked encoding
+			_, _ = io.WriteString(w, payload)
+		}))
+		defer server.Close()
+
+		dest := filepath.Join(t.TempDir(), "backup.bin")
+		var lastTotal int64
+		client := NewClient()
+		err := client.DownloadFile(context.Background(), server.URL, dest, nil, func(written, total int64) {
+			lastTotal = total
+		})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if lastTotal != -1 {
+			t.Errorf("Expected total -1, got %d", lastTotal)
+		}
+	})
+
+	t.Run("error status leaves no file", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "no such backup", http.StatusNotFound)
+		}))
+		defer server.Close()
+
+		dir := t.TempDir()
+		client := NewClient()
+		err := client.DownloadFile(context.Background(), server.URL, filepath.Join(dir, "backup.bin"), nil, nil)
+
+		var notFound *ResourceNotFoundError
+		if !errors.As(err, &notFound) {
+			t.Fatalf("Expected ResourceNotFoundError, got %v", err)
+		}
+		if !strings.Contains(notFound.RespBody, "no such backup") {
+			t.Errorf("Expected response body in error, got %q", notFound.RespBody)
+		}
+		assertEmptyDir(t, dir)
+	})
+
+	t.Run("truncated body removes partial file", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Length", fmt.Sprint(len(payload)))
+			_, _ = io.WriteString(w, payload[:len(payload)/2])
+			// Returning early closes the connection short of Content-Length
+		}))
+		defer server.Close()
+
+		dir := t.TempDir()
+		client := NewClient()
+		err := client.DownloadFile(context.Background(), server.URL, filepath.Join(dir, "backup.bin"), nil, nil)
+		if err == nil {
+			t.Fatal("Expected error for truncated body")
+		}
+		assertEmptyDir(t, dir)
+	})
+
+	t.Run("client timeout covers reading the body", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Length", fmt.Sprint(len(payload)))
+			_, _ = io.WriteString(w, payload[:len(payload)/2])
+			w.(http.Flusher).Flush()
+			time.Sleep(300 * time.Millisecond)
+			_, _ = io.WriteString(w, payload[len(payload)/2:])
+		}))
+		defer server.Close()
+
+		dir := t.TempDir()
+		client := NewClient(WithRestConfig(Config{Timeout: 100 * time.Millisecond}))
+		err := client.DownloadFile(context.Background(), server.URL, filepath.Join(dir, "backup.bin"), nil, nil)
+		if err == nil {
+			t.Fatal("Expected Config.Timeout to cut off the download")
+		}
+		assertEmptyDir(t, dir)
+
+		// Without a client timeout, only ctx bounds the transfer
+		unbounded := NewClient(WithRestConfig(Config{Timeout: 0}))
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		dest := filepath.Join(dir, "backup.bin")
+		if err := unbounded.DownloadFile(ctx, server.URL, dest, nil, nil); err != nil {
+			t.Fatalf("Expected download without client timeout to succeed, got %v", err)
+		}
+		if info, err := os.Stat(dest); err != nil || info.Size() != int64(len(payload)) {
+			t.Errorf("Expected complete file, got %v, %v", info, err)
+		}
+	})
+}
+
+func assertEmptyDir(t *testing.T, dir string) {
+	t.Helper()
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("Failed to read dir: %v", err)
+	}
+	for _, entry := range entries {
+		t.Errorf("Expected no files left behind, found %s", entry.Name())
+	}
+}
@@ -1,6 +1,7 @@
 package rest
 
 import (
+	"net/http"
 	"time"
 
 	"github.com/jasoet/pkg/v2/otel"
@@ -17,6 +18,13 @@ type Config struct {
 	// 0 means unlimited. Default is 1024.
 	MaxResponseBodyLog int `yaml:"maxResponseBodyLog" mapstructure:"maxResponseBodyLog"`
 
+	// Transport connection pool settings. Zero values keep the defaults of
+	// http.DefaultTransport; the transport is only replaced when one is set.
+	MaxIdleConns        int           `yaml:"maxIdleConns" mapstructure:"maxIdleConns"`
+	MaxIdleConnsPerHost int           `yaml:"maxIdleConnsPerHost" mapstructure:"maxIdleConnsPerHost"`
+	MaxConnsPerHost     int           `yaml:"maxConnsPerHost" mapstructure:"maxConnsPerHost"`
+	IdleConnTimeout     time.Duration `yaml:"idleConnTimeout" mapstructure:"idleConnTimeout"`
+
 	// OpenTelemetry Configuration (optional - nil disables telemetry)
 	OTelConfig *otel.Config `yaml:"-" mapstructure:"-"` // Not serializable from config files
 }
@@ -31,3 +39,27 @@ func DefaultRestConfig() *Config {
 		MaxResponseBodyLog: 1024,
 	}
 }
+
+// hasTransportSettings reports whether any connection pool setting is configured.
+func (c *Config) hasTransportSettings() bool {
+	return c.MaxIdleConns != 0 || c.MaxIdleConnsPerHost != 0 || c.MaxConnsPerHost != 0 || c.IdleConnTimeout != 0
+}
+
+// transport returns a clone of http.DefaultTransport with the configured
+// connection pool settings applied.
+func (c *Config) transport() *http.Transport {
+	t := http.DefaultTransport.(*http.Transport).Clone()
+	if c.MaxIdleConns != 0 {
+		t.MaxIdleConns = c.MaxIdleConns
+	}
+	if c.MaxIdleConnsPerHost != 0 {
+		t.MaxIdleConnsPerHost = c.MaxIdleConnsPerHost
+	}
+	if c.MaxConnsPerHost != 0 {
+		t.MaxConnsPerHost = c.MaxConnsPerHost
+	}
+	if c.IdleConnTimeout != 0 {
+		t.IdleConnTimeout = c.IdleConnTimeout
+	}
+	return t
+}
@@ -0,0 +1,82 @@
+package rest
+
+import (
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// acceptEncoding is the Accept-Encoding header sent by WithAutoDecompress.
+const acceptEncoding = "gzip, deflate"
+
+// WithAutoDecompress asks servers for compressed responses by sending
+// Accept-Encoding: gzip, deflate (unless the request sets its own) and decodes
+// gzip and deflate response bodies before Response.Body returns them, so
+// middleware and callers always see the plain content. Decoded responses have
+// their Content-Encoding header removed and Content-Length set to the decoded
+// size. Other encodings are left untouched.
+func WithAutoDecompress() ClientOption {
+	return func(client *Client) {
+		client.autoDecompress = true
+	}
+}
+
+// decompressResponse decodes a gzip or deflate encoded response body in place.
+func decompressResponse(response *resty.Response) error {
+	if response == nil || response.RawResponse == nil {
+		return nil
+	}
+
+	body := response.Body()
+	switch strings.ToLower(strings.TrimSpace(response.Header().Get("Content-Encoding"))) {
+	case "gzip":
+		// resty already decodes gzip bodies with a known or unknown length
+		if response.RawResponse.ContentLength == 0 {
+			return nil
+		}
+	case "x-gzip":
+		reader, err := gzip.NewReader(bytes.NewReader(body))
+		if err != nil {
+			return fmt.Errorf("failed to decompress response body: %w", err)
+		}
+		if body, err = readDecoded(reader); err != nil {
+			return err
+		}
+	case "deflate":
+		// Servers send both zlib-wrapped (RFC 1950) and raw (RFC 1951) deflate
+		var reader io.ReadCloser
+		reader, err := zlib.NewReader(bytes.NewReader(body))
+		if err != nil {
+			reader = flate.NewReader(bytes.NewReader(body))
+		}
+		if body, err = readDecoded(reader); err != nil {
+			return err
+		}
+	default:
+		return nil
+	}
+
+	response.SetBody(body)
+	response.Header().Del("Content-Encoding")
+	response.Header().Set("Content-Length", strconv.Itoa(len(body)))
+	response.RawResponse.ContentLength = int64(len(body))
+	response.RawResponse.Uncompressed = true
+	return nil
+}
+
+// readDecoded reads and closes a decompressing reader.
+func readDecoded(reader io.ReadCloser) ([]byte, error) {
+	defer reader.Close()
+	body, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress response body: %w", err)
+	}
+	return body, nil
+}
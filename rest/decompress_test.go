@@ -0,0 +1,126 @@
+package rest
+
+import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestWithAutoDecompress(t *testing.T) {
+	payload := strings.Repeat(`{"message":"hello compressed world"}`, 50)
+
+	encode := func(t *testing.T, encoding string) []byte {
+		t.Helper()
+		var buf bytes.Buffer
+		switch encoding {
+		case "gzip", "x-gzip":
+			w := gzip.NewWriter(&buf)
+			_, _ = w.Write([]byte(payload))
+			_ = w.Close()
+		case "deflate":
+			w := zlib.NewWriter(&buf)
+			_, _ = w.Write([]byte(payload))
+			_ = w.Close()
+		}
+		return buf.Bytes()
+	}
+
+	for _, encoding := range []string{"gzip", "x-gzip", "deflate"} {
+		t.Run(encoding, func(t *testing.T) {
+			compressed := encode(t, encoding)
+			var gotAcceptEncoding string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotAcceptEncoding = r.Header.Get("Accept-Encoding")
+				w.Header().Set("Content-Encoding", encoding)
+				w.Header().Set("Content-Length", strconv.Itoa(len(compressed)))
+				_, _ = w.Write(compressed)
+			}))
+			defer server.Close()
+
+			middleware := &mockMiddleware{}
+			client := NewClient(WithAutoDecompress(), WithMiddleware(middleware))
+			resp, err := client.MakeRequest(context.Background(), http.MethodGet, server.URL, "", nil)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			if gotAcceptEncoding != acceptEncoding {
+				t.Errorf("Expected Accept-Encoding %q, got %q", acceptEncoding, gotAcceptEncoding)
+			}
+			if resp.String() != payload {
+				t.Errorf("Expected decompressed body, got %q", resp.String())
+			}
+			if len(resp.Body()) != len(payload) {
+				t.Errorf("Expected body length %d, got %d", len(payload), len(resp.Body()))
+			}
+			if got := resp.Header().Get("Content-Length"); got != strconv.Itoa(len(payload)) {
+				t.Errorf("Expected Content-Length %d, got %s", len(payload), got)
+			}
+			if got := resp.Header().Get("Content-Encoding"); got != "" {
+				t.Errorf("Expected Content-Encoding to be removed, got %q", got)
+			}
+			if string(middleware.requestInfo.ResponseBody) != payload {
+				t.Errorf("Expected middleware to see decompressed body, got %q", middleware.requestInfo.ResponseBody)
+			}
+		})
+	}
+
+	t.Run("keeps caller Accept-Encoding", func(t *testing.T) {
+		var gotAcceptEncoding string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			gotAcceptEncoding = r.Header.Get("Accept-Encoding")
+		}))
+		defer server.Close()
+
+		client := NewClient(WithAutoDecompress())
+		_, err := client.MakeRequest(context.Background(), http.MethodGet, server.URL, "", map[string]string{"accept-encoding": "identity"})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if gotAcceptEncoding != "identity" {
+			t.Errorf("Expected Accept-Encoding identity, got %q", gotAcceptEncoding)
+		}
+	})
+
+	t.Run("does not modify caller headers", func(t *testing.T) {
+		var gotAcceptEncoding string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			gotAcceptEncoding = r.Header.Get("Accept-Encoding")
+		}))
+		defer server.Close()
+
+		headers := map[string]string{"Accept": "application/json"}
+		client := NewClient(WithAutoDecompress())
+		if _, err := client.MakeRequest(context.Background(), http.MethodGet, server.URL, "", headers); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if gotAcceptEncoding != acceptEncoding {
+			t.Errorf("Expected Accept-Encoding %q, got %q", acceptEncoding, gotAcceptEncoding)
+		}
+		if _, ok := headers["Accept-Encoding"]; ok || len(headers) != 1 {
+			t.Errorf("Expected caller headers to be unchanged, got %v", headers)
+		}
+	})
+
+	t.Run("invalid compressed body", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Encoding", "x-gzip")
+			_, _ = w.Write([]byte("not gzip"))
+		}))
+		defer server.Close()
+
+		client := NewClient(WithAutoDecompress(), WithRestConfig(Config{RetryCount: 0, Timeout: DefaultRestConfig().Timeout}))
+		_, err := client.MakeRequest(context.Background(), http.MethodGet, server.URL, "", nil)
+		var execErr *ExecutionError
+		if !errors.As(err, &execErr) || !strings.Contains(execErr.Unwrap().Error(), "failed to decompress response body") {
+			t.Errorf("Expected ExecutionError wrapping decompression error, got %v", err)
+		}
+	})
+}
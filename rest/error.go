@@ -3,6 +3,10 @@ package rest
 import (
 	"errors"
 	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
 )
 
 // Sentinel errors for use with errors.Is.
@@ -11,6 +15,7 @@ var (
 	ErrResourceNotFound = errors.New("resource not found")
 	ErrServer           = errors.New("server error")
 	ErrResponse         = errors.New("response error")
+	ErrTooManyRequests  = errors.New("too many requests")
 )
 
 // UnauthorizedError represents an authentication or authorization failure (HTTP 401/403).
@@ -69,7 +74,7 @@ func NewServerError(statusCode int, msg string, respBody string) *ServerError {
 	}
 }
 
-// ResponseError represents a client-side HTTP error (HTTP 4xx, excluding 401/403/404).
+// ResponseError represents a client-side HTTP error (HTTP 4xx, excluding 401/403/404/429).
 type ResponseError struct {
 	StatusCode int
 	Msg        string
@@ -104,3 +109,57 @@ func NewResourceNotFoundError(statusCode int, msg string, respBody string) *Reso
 		RespBody:   respBody,
 	}
 }
+
+// TooManyRequestsError represents a 429 Too Many Requests response.
+// RetryAfter holds the delay requested by the server's Retry-After header,
+// or zero if the header was missing or invalid.
+// It matches both ErrTooManyRequests and ErrResponse with errors.Is.
+type TooManyRequestsError struct {
+	StatusCode int
+	Msg        string
+	RespBody   string
+	RetryAfter time.Duration
+}
+
+func (e *TooManyRequestsError) Error() string {
+	if e.RetryAfter > 0 {
+		return fmt.Sprintf("%s (retry after %s): %s", e.Msg, e.RetryAfter, e.RespBody)
+	}
+	return fmt.Sprintf("%s: %s", e.Msg, e.RespBody)
+}
+func (e *TooManyRequestsError) Unwrap() []error { return []error{ErrTooManyRequests, ErrResponse} }
+
+// NewTooManyRequestsError creates a new TooManyRequestsError
+func NewTooManyRequestsError(statusCode int, msg string, respBody string, retryAfter time.Duration) *TooManyRequestsError {
+	return &TooManyRequestsError{
+		StatusCode: statusCode,
+		Msg:        msg,
+		RespBody:   respBody,
+		RetryAfter: retryAfter,
+	}
+}
+
+// parseRetryAfter parses a Retry-After header value in either delta-seconds
+// ("120") or HTTP-date ("Wed, 21 Oct 2015 07:28:00 GMT") form, relative to now.
+// It returns zero for empty, invalid or past values.
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		if d := date.Sub(now); d > 0 {
+			return d
+		}
+	}
+
+	return 0
+}
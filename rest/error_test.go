@@ -2,7 +2,9 @@ package rest
 
 import (
 	"errors"
+	"net/http"
 	"testing"
+	"time"
 )
 
 func TestUnauthorizedError(t *testing.T) {
@@ -246,3 +248,67 @@ func TestResourceNotFoundError(t *testing.T) {
 		}
 	})
 }
+
+func TestTooManyRequestsError(t *testing.T) {
+	t.Run("Constructor", func(t *testing.T) {
+		err := NewTooManyRequestsError(429, "Too many requests", "slow down", 5*time.Second)
+
+		if err.StatusCode != 429 {
+			t.Errorf("Expected StatusCode 429, got %d", err.StatusCode)
+		}
+
+		if err.RetryAfter != 5*time.Second {
+			t.Errorf("Expected RetryAfter 5s, got %v", err.RetryAfter)
+		}
+	})
+
+	t.Run("Error method", func(t *testing.T) {
+		err := NewTooManyRequestsError(429, "Too many requests", "slow down", 5*time.Second)
+		expected := "Too many requests (retry after 5s): slow down"
+		if err.Error() != expected {
+			t.Errorf("Expected Error() to return %q, got %q", expected, err.Error())
+		}
+
+		err.RetryAfter = 0
+		expected = "Too many requests: slow down"
+		if err.Error() != expected {
+			t.Errorf("Expected Error() to return %q, got %q", expected, err.Error())
+		}
+	})
+
+	t.Run("Unwrap returns sentinels", func(t *testing.T) {
+		err := NewTooManyRequestsError(429, "test", "body", 0)
+		if !errors.Is(err, ErrTooManyRequests) {
+			t.Error("Expected errors.Is(err, ErrTooManyRequests) to be true")
+		}
+		if !errors.Is(err, ErrResponse) {
+			t.Error("Expected errors.Is(err, ErrResponse) to be true")
+		}
+	})
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2025, time.October, 21, 7, 28, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		value    string
+		expected time.Duration
+	}{
+		{"Delta seconds", "5", 5 * time.Second},
+		{"Delta seconds with whitespace", " 120 ", 2 * time.Minute},
+		{"HTTP date", now.Add(90 * time.Second).Format(http.TimeFormat), 90 * time.Second},
+		{"HTTP date in the past", now.Add(-time.Minute).Format(http.TimeFormat), 0},
+		{"Negative seconds", "-1", 0},
+		{"Empty", "", 0},
+		{"Invalid", "soon", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseRetryAfter(tt.value, now); got != tt.expected {
+				t.Errorf("parseRetryAfter(%q) = %v, want %v", tt.value, got, tt.expected)
+			}
+		})
+	}
+}
@@ -2,6 +2,9 @@ package rest
 
 import (
 	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
 	"time"
 
 	"github.com/go-resty/resty/v2"
@@ -10,17 +13,19 @@ import (
 )
 
 type RequestInfo struct {
-	Method     string
-	URL        string
-	Headers    map[string]string
-	Body       string
-	StartTime  time.Time
-	EndTime    time.Time
-	Duration   time.Duration
-	StatusCode int
-	Response   string
-	Error      error
-	TraceInfo  resty.TraceInfo
+	Method          string
+	URL             string
+	Headers         map[string]string
+	Body            string
+	StartTime       time.Time
+	EndTime         time.Time
+	Duration        time.Duration
+	StatusCode      int
+	Response        string // Response body, truncated to Config.MaxResponseBodyLog
+	ResponseBody    []byte // Full response body; must not be modified
+	ResponseHeaders http.Header
+	Error           error
+	TraceInfo       resty.TraceInfo
 }
 
 type Middleware interface {
@@ -28,12 +33,78 @@ type Middleware interface {
 	AfterRequest(ctx context.Context, info RequestInfo)
 }
 
-// LoggingMiddleware logs HTTP requests and responses
-type LoggingMiddleware struct{}
+// redactedValue replaces sensitive header and JSON field values in logs.
+const redactedValue = "***"
+
+// redactedBody replaces bodies that cannot be parsed for JSON field redaction.
+const redactedBody = "[redacted: body is not valid JSON]"
+
+// defaultRedactHeaders are always masked by LoggingMiddleware.
+var defaultRedactHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie"}
+
+// LoggingMiddleware logs HTTP requests and responses.
+// Method, URL, status code and duration are logged at info level; headers and
+// bodies are logged at debug level after redaction and truncation.
+type LoggingMiddleware struct {
+	maxBodyLog    int
+	redactHeaders map[string]struct{}
+	redactFields  []string
+}
+
+// LoggingOption configures LoggingMiddleware.
+type LoggingOption func(*LoggingMiddleware)
+
+// WithMaxBodyLog limits logged request and response bodies to bytes, appending a
+// truncation marker. Zero or negative disables truncation. Defaults to 1024.
+func WithMaxBodyLog(bytes int) LoggingOption {
+	return func(m *LoggingMiddleware) {
+		m.maxBodyLog = bytes
+	}
+}
+
+// WithRedactHeaders masks the values of the named headers (case-insensitive) in
+// logs. Authorization, Proxy-Authorization, Cookie and Set-Cookie are always masked.
+func WithRedactHeaders(names ...string) LoggingOption {
+	return func(m *LoggingMiddleware) {
+		for _, name := range names {
+			m.redactHeaders[http.CanonicalHeaderKey(name)] = struct{}{}
+		}
+	}
+}
+
+// WithRedactJSONFields masks JSON fields in logged request and response bodies.
+// A plain name such as "password" matches that key at any depth; a dotted path
+// such as "user.credentials.token" matches from the document root. Arrays are
+// traversed transparently. Bodies that are not valid JSON cannot be checked for
+// these fields, so they are replaced with a placeholder instead of being logged.
+func WithRedactJSONFields(paths ...string) LoggingOption {
+	return func(m *LoggingMiddleware) {
+		m.redactFields = append(m.redactFields, paths...)
+	}
+}
 
 // NewLoggingMiddleware creates a new LoggingMiddleware instance
-func NewLoggingMiddleware() *LoggingMiddleware {
-	return &LoggingMiddleware{}
+//
+// Example:
+//
+//	logging := rest.NewLoggingMiddleware(
+//	    rest.WithMaxBodyLog(512),
+//	    rest.WithRedactHeaders("X-API-Key"),
+//	    rest.WithRedactJSONFields("password", "data.token"))
+func NewLoggingMiddleware(opts ...LoggingOption) *LoggingMiddleware {
+	m := &LoggingMiddleware{
+		maxBodyLog:    1024,
+		redactHeaders: make(map[string]struct{}, len(defaultRedactHeaders)),
+	}
+	for _, name := range defaultRedactHeaders {
+		m.redactHeaders[name] = struct{}{}
+	}
+
+	for _, opt := range opts {
+		opt(m)
+	}
+
+	return m
 }
 
 // BeforeRequest returns the context unchanged; timing is handled via RequestInfo.
@@ -58,6 +129,105 @@ func (m *LoggingMiddleware) AfterRequest(ctx context.Context, info RequestInfo)
 			otel.F("status_code", info.StatusCode),
 			otel.F("duration", info.Duration))
 	}
+
+	sanitized := m.sanitize(info)
+	logger.Debug("Request details",
+		otel.F("method", sanitized.Method),
+		otel.F("url", sanitized.URL),
+		otel.F("request_headers", sanitized.Headers),
+		otel.F("request_body", sanitized.Body),
+		otel.F("response_body", sanitized.Response))
+}
+
+// sanitize returns a copy of info with sensitive headers and JSON fields masked
+// and bodies truncated to maxBodyLog. The response is redacted from the full
+// ResponseBody, because Response is already truncated and no longer valid JSON
+// once it exceeds Config.MaxResponseBodyLog.
+func (m *LoggingMiddleware) sanitize(info RequestInfo) RequestInfo {
+	if info.Headers != nil {
+		headers := make(map[string]string, len(info.Headers))
+		for k, v := range info.Headers {
+			if _, ok := m.redactHeaders[http.CanonicalHeaderKey(k)]; ok {
+				v = redactedValue
+			}
+			headers[k] = v
+		}
+		info.Headers = headers
+	}
+
+	info.Body = truncateBody(redactJSON(info.Body, m.redactFields), m.maxBodyLog)
+	response := info.Response
+	if info.ResponseBody != nil {
+		response = string(info.ResponseBody)
+	}
+	info.Response = truncateBody(redactJSON(response, m.redactFields), m.maxBodyLog)
+
+	return info
+}
+
+// redactJSON masks the given field paths in a JSON document. Input that is not
+// valid JSON is replaced with redactedBody, since it may contain the fields.
+func redactJSON(body string, paths []string) string {
+	if len(paths) == 0 || body == "" {
+		return body
+	}
+
+	var doc any
+	if err := json.Unmarshal([]byte(body), &doc); err != nil {
+		return redactedBody
+	}
+
+	for _, path := range paths {
+		if strings.Contains(path, ".") {
+			redactPath(doc, strings.Split(path, "."))
+		} else {
+			redactKey(doc, path)
+		}
+	}
+
+	redacted, err := json.Marshal(doc)
+	if err != nil {
+		return redactedBody
+	}
+	return string(redacted)
+}
+
+// redactKey masks key wherever it appears in v.
+func redactKey(v any, key string) {
+	switch val := v.(type) {
+	case map[string]any:
+		for k, child := range val {
+			if k == key {
+				val[k] = redactedValue
+				continue
+			}
+			redactKey(child, key)
+		}
+	case []any:
+		for _, child := range val {
+			redactKey(child, key)
+		}
+	}
+}
+
+// redactPath masks the field at path, starting from v.
+func redactPath(v any, path []string) {
+	switch val := v.(type) {
+	case map[string]any:
+		child, ok := val[path[0]]
+		if !ok {
+			return
+		}
+		if len(path) == 1 {
+			val[path[0]] = redactedValue
+			return
+		}
+		redactPath(child, path[1:])
+	case []any:
+		for _, child := range val {
+			redactPath(child, path)
+		}
+	}
 }
 
 // NoOpMiddleware is a middleware that does nothing - useful for testing and as a placeholder
@@ -2,7 +2,9 @@ package rest
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"strings"
 	"testing"
 	"time"
 )
@@ -55,6 +57,151 @@ func TestLoggingMiddleware(t *testing.T) {
 	})
 }
 
+func TestLoggingMiddleware_Sanitize(t *testing.T) {
+	t.Run("TruncatesLongBodies", func(t *testing.T) {
+		middleware := NewLoggingMiddleware(WithMaxBodyLog(10))
+		info := RequestInfo{
+			Body:     strings.Repeat("a", 50),
+			Response: strings.Repeat("b", 50),
+		}
+
+		got := middleware.sanitize(info)
+
+		if got.Body != strings.Repeat("a", 10)+"...(truncated)" {
+			t.Errorf("Expected truncated request body, got %q", got.Body)
+		}
+		if got.Response != strings.Repeat("b", 10)+"...(truncated)" {
+			t.Errorf("Expected truncated response body, got %q", got.Response)
+		}
+		if len(info.Body) != 50 {
+			t.Error("Expected original RequestInfo to be unchanged")
+		}
+	})
+
+	t.Run("ShortBodiesUnchanged", func(t *testing.T) {
+		middleware := NewLoggingMiddleware(WithMaxBodyLog(10))
+
+		got := middleware.sanitize(RequestInfo{Body: "short"})
+
+		if got.Body != "short" {
+			t.Errorf("Expected body to be unchanged, got %q", got.Body)
+		}
+	})
+
+	t.Run("RedactsHeaders", func(t *testing.T) {
+		middleware := NewLoggingMiddleware(WithRedactHeaders("x-api-key"))
+		info := RequestInfo{
+			Headers: map[string]string{
+				"Authorization": "Bearer secret",
+				"X-Api-Key":     "key-123",
+				"Content-Type":  "application/json",
+			},
+		}
+
+		got := middleware.sanitize(info)
+
+		if got.Headers["Authorization"] != "***" {
+			t.Errorf("Expected Authorization to be redacted by default, got %q", got.Headers["Authorization"])
+		}
+		if got.Headers["X-Api-Key"] != "***" {
+			t.Errorf("Expected X-Api-Key to be redacted, got %q", got.Headers["X-Api-Key"])
+		}
+		if got.Headers["Content-Type"] != "application/json" {
+			t.Errorf("Expected Content-Type to be kept, got %q", got.Headers["Content-Type"])
+		}
+		if info.Headers["Authorization"] != "Bearer secret" {
+			t.Error("Expected original headers to be unchanged")
+		}
+	})
+
+	t.Run("RedactsJSONFields", func(t *testing.T) {
+		middleware := NewLoggingMiddleware(WithRedactJSONFields("password", "data.token"))
+		info := RequestInfo{
+			Body:     `{"username":"alice","password":"hunter2","profile":{"password":"nested"}}`,
+			Response: `{"data":{"token":"abc","items":[{"token":"keep"}]},"token":"top"}`,
+		}
+
+		got := middleware.sanitize(info)
+
+		var body map[string]any
+		if err := json.Unmarshal([]byte(got.Body), &body); err != nil {
+			t.Fatalf("Expected redacted body to be valid JSON: %v", err)
+		}
+		if body["password"] != "***" {
+			t.Errorf("Expected password to be redacted, got %v", body["password"])
+		}
+		if body["profile"].(map[string]any)["password"] != "***" {
+			t.Errorf("Expected nested password to be redacted, got %v", body["profile"])
+		}
+		if body["username"] != "alice" {
+			t.Errorf("Expected username to be kept, got %v", body["username"])
+		}
+
+		var resp map[string]any
+		if err := json.Unmarshal([]byte(got.Response), &resp); err != nil {
+			t.Fatalf("Expected redacted response to be valid JSON: %v", err)
+		}
+		data := resp["data"].(map[string]any)
+		if data["token"] != "***" {
+			t.Errorf("Expected data.token to be redacted, got %v", data["token"])
+		}
+		if data["items"].([]any)[0].(map[string]any)["token"] != "keep" {
+			t.Errorf("Expected data.items[].token to be kept, got %v", data["items"])
+		}
+		if resp["token"] != "top" {
+			t.Errorf("Expected top-level token to be kept, got %v", resp["token"])
+		}
+	})
+
+	t.Run("MasksNonJSONBody", func(t *testing.T) {
+		middleware := NewLoggingMiddleware(WithRedactJSONFields("password"))
+
+		got := middleware.sanitize(RequestInfo{Body: "password=hunter2"})
+
+		if got.Body != redactedBody {
+			t.Errorf("Expected non-JSON body to be masked, got %q", got.Body)
+		}
+	})
+
+	t.Run("NonJSONBodyKeptWithoutRedactFields", func(t *testing.T) {
+		middleware := NewLoggingMiddleware()
+
+		got := middleware.sanitize(RequestInfo{Body: "plain text"})
+
+		if got.Body != "plain text" {
+			t.Errorf("Expected body to be unchanged, got %q", got.Body)
+		}
+	})
+
+	t.Run("RedactsFullResponseBody", func(t *testing.T) {
+		middleware := NewLoggingMiddleware(WithRedactJSONFields("password"))
+		full := `{"password":"hunter2","padding":"` + strings.Repeat("x", 2048) + `"}`
+		info := RequestInfo{
+			Response:     truncateBody(full, 1024),
+			ResponseBody: []byte(full),
+		}
+
+		got := middleware.sanitize(info)
+
+		if strings.Contains(got.Response, "hunter2") {
+			t.Errorf("Expected password to be redacted from a large response, got %q", got.Response)
+		}
+		if !strings.HasSuffix(got.Response, "...(truncated)") {
+			t.Errorf("Expected redacted response to be truncated, got %q", got.Response)
+		}
+	})
+
+	t.Run("RedactsBeforeTruncating", func(t *testing.T) {
+		middleware := NewLoggingMiddleware(WithMaxBodyLog(20), WithRedactJSONFields("password"))
+
+		got := middleware.sanitize(RequestInfo{Body: `{"password":"` + strings.Repeat("x", 40) + `"}`})
+
+		if strings.Contains(got.Body, "xxx") {
+			t.Errorf("Expected password to be redacted before truncation, got %q", got.Body)
+		}
+	})
+}
+
 func TestNoOpMiddleware(t *testing.T) {
 	middleware := NewNoOpMiddleware()
 
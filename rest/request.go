@@ -0,0 +1,162 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// pathParamPattern matches "{name}" placeholders in a request path.
+var pathParamPattern = regexp.MustCompile(`\{([^{}/]+)\}`)
+
+// Request builds a single HTTP request step by step and sends it through the
+// client's middleware chain, like MakeRequest. It is meant as the building block
+// for typed API clients, where each operation maps to a method, a path template
+// and its parameters. Create one with Client.NewRequest; a Request is not safe
+// for concurrent use.
+//
+// Example:
+//
+//	resp, err := client.NewRequest().
+//	    Method(http.MethodGet).
+//	    Path("/users/{id}").
+//	    PathParam("id", "1").
+//	    Query("limit", "10").
+//	    Header("X-Foo", "bar").
+//	    Do(ctx)
+type Request struct {
+	client     *Client
+	method     string
+	path       string
+	pathParams map[string]string
+	query      url.Values
+	headers    map[string]string
+	body       string
+	bodyErr    error
+}
+
+// NewRequest starts building a GET request sent by this client.
+func (c *Client) NewRequest() *Request {
+	return &Request{
+		client:     c,
+		method:     http.MethodGet,
+		pathParams: make(map[string]string),
+		query:      make(url.Values),
+		headers:    make(map[string]string),
+	}
+}
+
+// Method sets the HTTP method.
+func (r *Request) Method(method string) *Request {
+	r.method = method
+	return r
+}
+
+// Path sets the request path, which may contain "{name}" placeholders filled by
+// PathParam.
+func (r *Request) Path(path string) *Request {
+	r.path = path
+	return r
+}
+
+// PathParam sets the value substituted for the "{name}" placeholder in the
+// path. The value is escaped as a single path segment.
+func (r *Request) PathParam(name, value string) *Request {
+	r.pathParams[name] = value
+	return r
+}
+
+// Query adds a query parameter. Calling it again with the same name adds
+// another value rather than replacing the first.
+func (r *Request) Query(name, value string) *Request {
+	r.query.Add(name, value)
+	return r
+}
+
+// Header sets a request header.
+func (r *Request) Header(name, value string) *Request {
+	r.headers[name] = value
+	return r
+}
+
+// Body sets the request body. Strings and byte slices are sent as-is; any other
+// value is encoded as JSON and, unless a Content-Type header is set, sent with
+// Content-Type application/json. An encoding error is returned by Do.
+func (r *Request) Body(v any) *Request {
+	r.bodyErr = nil
+	switch b := v.(type) {
+	case nil:
+		r.body = ""
+	case string:
+		r.body = b
+	case []byte:
+		r.body = string(b)
+	default:
+		encoded, err := json.Marshal(b)
+		if err != nil {
+			r.body = ""
+			r.bodyErr = fmt.Errorf("failed to marshal request body: %w", err)
+			return r
+		}
+		r.body = string(encoded)
+		if !hasHeader(r.headers, "Content-Type") {
+			r.headers["Content-Type"] = "application/json"
+		}
+	}
+	return r
+}
+
+// URL returns the assembled request URL: the path with its placeholders
+// substituted, followed by the encoded query parameters. It fails when a
+// placeholder has no value.
+func (r *Request) URL() (string, error) {
+	var missing []string
+	path := pathParamPattern.ReplaceAllStringFunc(r.path, func(placeholder string) string {
+		name := placeholder[1 : len(placeholder)-1]
+		value, ok := r.pathParams[name]
+		if !ok {
+			missing = append(missing, name)
+			return placeholder
+		}
+		return url.PathEscape(value)
+	})
+	if len(missing) > 0 {
+		return "", fmt.Errorf("missing path parameter(s): %s", strings.Join(missing, ", "))
+	}
+
+	if len(r.query) > 0 {
+		separator := "?"
+		if strings.Contains(path, "?") {
+			separator = "&"
+		}
+		path += separator + r.query.Encode()
+	}
+
+	return path, nil
+}
+
+// Do sends the request through the client's middleware chain. Like MakeRequest,
+// non-success responses are returned as typed errors alongside the response.
+func (r *Request) Do(ctx context.Context) (*resty.Response, error) {
+	if r.bodyErr != nil {
+		return nil, r.bodyErr
+	}
+
+	requestURL, err := r.URL()
+	if err != nil {
+		return nil, err
+	}
+
+	headers := make(map[string]string, len(r.headers))
+	for k, v := range r.headers {
+		headers[k] = v
+	}
+
+	return r.client.doRequest(ctx, r.method, requestURL, r.body, headers, false)
+}
@@ -0,0 +1,68 @@
+package rest
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"strings"
+
+	"github.com/jasoet/pkg/v2/logging"
+)
+
+// RequestIDHeader is the header RequestIDMiddleware sets on outgoing requests.
+const RequestIDHeader = "X-Request-ID"
+
+// RequestIDMiddleware propagates a request ID to downstream services. It sets
+// the X-Request-ID header from the request ID in the context (see
+// logging.WithRequestID, which the server access log uses for every incoming
+// request), generating a new ID when the context has none. A header already set
+// by the caller is left unchanged.
+//
+// Example:
+//
+//	client := rest.NewClient(rest.WithMiddleware(rest.NewRequestIDMiddleware()))
+//
+//	// Inside a server handler, the incoming request ID is forwarded
+//	resp, err := client.MakeRequest(c.Request().Context(), http.MethodGet, url, "", nil)
+type RequestIDMiddleware struct {
+	generate func() string
+}
+
+// NewRequestIDMiddleware creates a RequestIDMiddleware.
+func NewRequestIDMiddleware() *RequestIDMiddleware {
+	return &RequestIDMiddleware{generate: newRequestID}
+}
+
+// BeforeRequest sets the X-Request-ID header. The returned context carries the
+// ID that was sent, so later middleware and loggers derived from it include it.
+func (m *RequestIDMiddleware) BeforeRequest(ctx context.Context, method string, url string, body string, headers map[string]string) context.Context {
+	if headers == nil {
+		return ctx
+	}
+
+	for name, value := range headers {
+		if strings.EqualFold(name, RequestIDHeader) && value != "" {
+			return ctx
+		}
+	}
+
+	requestID := logging.RequestIDFromContext(ctx)
+	if requestID == "" {
+		requestID = m.generate()
+		ctx = logging.WithRequestID(ctx, requestID)
+	}
+	headers[RequestIDHeader] = requestID
+
+	return ctx
+}
+
+// AfterRequest does nothing.
+func (m *RequestIDMiddleware) AfterRequest(ctx context.Context, info RequestInfo) {}
+
+// newRequestID returns a random 16-byte hex request ID, in the same format the
+// server package generates.
+func newRequestID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
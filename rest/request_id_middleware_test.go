@@ -0,0 +1,100 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/jasoet/pkg/v2/logging"
+)
+
+func TestRequestIDMiddleware(t *testing.T) {
+	var _ Middleware = &RequestIDMiddleware{}
+
+	t.Run("uses request ID from context", func(t *testing.T) {
+		m := NewRequestIDMiddleware()
+		ctx := logging.WithRequestID(context.Background(), "req-123")
+
+		headers := map[string]string{}
+		m.BeforeRequest(ctx, "GET", "/", "", headers)
+		if got := headers[RequestIDHeader]; got != "req-123" {
+			t.Errorf("Expected X-Request-ID req-123, got %q", got)
+		}
+	})
+
+	t.Run("generates request ID when context has none", func(t *testing.T) {
+		m := NewRequestIDMiddleware()
+
+		headers := map[string]string{}
+		ctx := m.BeforeRequest(context.Background(), "GET", "/", "", headers)
+		got := headers[RequestIDHeader]
+		if !regexp.MustCompile(`^[0-9a-f]{32}$`).MatchString(got) {
+			t.Fatalf("Expected generated 32-char hex request ID, got %q", got)
+		}
+		if ctxID := logging.RequestIDFromContext(ctx); ctxID != got {
+			t.Errorf("Expected returned context to carry %q, got %q", got, ctxID)
+		}
+
+		other := map[string]string{}
+		m.BeforeRequest(context.Background(), "GET", "/", "", other)
+		if other[RequestIDHeader] == got {
+			t.Errorf("Expected a new request ID per request, got %q twice", got)
+		}
+	})
+
+	t.Run("keeps header set by caller", func(t *testing.T) {
+		m := NewRequestIDMiddleware()
+		ctx := logging.WithRequestID(context.Background(), "req-123")
+
+		headers := map[string]string{"x-request-id": "caller-id"}
+		m.BeforeRequest(ctx, "GET", "/", "", headers)
+		if len(headers) != 1 || headers["x-request-id"] != "caller-id" {
+			t.Errorf("Expected caller header to be kept, got %v", headers)
+		}
+	})
+
+	t.Run("sends header on outgoing request", func(t *testing.T) {
+		received := make(chan string, 1)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			received <- r.Header.Get(RequestIDHeader)
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		client := NewClient(WithMiddleware(NewRequestIDMiddleware()))
+		ctx := logging.WithRequestID(context.Background(), "req-456")
+		if _, err := client.MakeRequest(ctx, http.MethodGet, server.URL, "", nil); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if got := <-received; got != "req-456" {
+			t.Errorf("Expected server to receive req-456, got %q", got)
+		}
+	})
+
+	t.Run("generates a new ID per request with a reused headers map", func(t *testing.T) {
+		received := make(chan string, 2)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			received <- r.Header.Get(RequestIDHeader)
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		client := NewClient(WithMiddleware(NewRequestIDMiddleware()))
+		headers := map[string]string{"Accept": "application/json"}
+		for range 2 {
+			if _, err := client.MakeRequest(context.Background(), http.MethodGet, server.URL, "", headers); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+		}
+
+		first, second := <-received, <-received
+		if first == "" || second == "" || first == second {
+			t.Errorf("Expected two distinct request IDs, got %q and %q", first, second)
+		}
+		if _, ok := headers[RequestIDHeader]; ok {
+			t.Errorf("Expected caller headers not to carry X-Request-ID, got %v", headers)
+		}
+	})
+}
@@ -0,0 +1,192 @@
+package rest
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestRequest_URL(t *testing.T) {
+	tests := []struct {
+		name    string
+		build   func(r *Request) *Request
+		want    string
+		wantErr string
+	}{
+		{
+			name: "substitutes path params",
+			build: func(r *Request) *Request {
+				return r.Path("http://example.com/users/{id}/posts/{postID}").PathParam("id", "1").PathParam("postID", "42")
+			},
+			want: "http://example.com/users/1/posts/42",
+		},
+		{
+			name: "escapes path params as a single segment",
+			build: func(r *Request) *Request {
+				return r.Path("http://example.com/files/{name}").PathParam("name", "a b/c?d")
+			},
+			want: "http://example.com/files/a%20b%2Fc%3Fd",
+		},
+		{
+			name: "encodes query values",
+			build: func(r *Request) *Request {
+				return r.Path("http://example.com/search").Query("q", "a&b=c d").Query("tag", "x").Query("tag", "y")
+			},
+			want: "http://example.com/search?q=a%26b%3Dc+d&tag=x&tag=y",
+		},
+		{
+			name: "appends to existing query",
+			build: func(r *Request) *Request {
+				return r.Path("http://example.com/search?page=2").Query("limit", "10")
+			},
+			want: "http://example.com/search?page=2&limit=10",
+		},
+		{
+			name: "keeps relative path",
+			build: func(r *Request) *Request {
+				return r.Path("/users/{id}").PathParam("id", "7").Query("limit", "10")
+			},
+			want: "/users/7?limit=10",
+		},
+		{
+			name: "missing path param",
+			build: func(r *Request) *Request {
+				return r.Path("/users/{id}/posts/{postID}").PathParam("id", "1")
+			},
+			wantErr: "missing path parameter(s): postID",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := NewClient()
+
+			got, err := tt.build(client.NewRequest()).URL()
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("Expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Expected URL %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestRequest_Do(t *testing.T) {
+	t.Run("sends method, URL, headers and body", func(t *testing.T) {
+		var gotMethod, gotURI, gotHeader, gotContentType, gotBody string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			gotMethod = r.Method
+			gotURI = r.RequestURI
+			gotHeader = r.Header.Get("X-Foo")
+			gotContentType = r.Header.Get("Content-Type")
+			body, _ := io.ReadAll(r.Body)
+			gotBody = string(body)
+			w.WriteHeader(http.StatusCreated)
+		}))
+		defer server.Close()
+
+		client := NewClient()
+
+		resp, err := client.NewRequest().
+			Method(http.MethodPost).
+			Path(server.URL+"/users/{id}/notes").
+			PathParam("id", "a/1").
+			Query("limit", "10").
+			Header("X-Foo", "bar").
+			Body(map[string]string{"text": "hello"}).
+			Do(context.Background())
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if resp.StatusCode() != http.StatusCreated {
+			t.Errorf("Expected status 201, got %d", resp.StatusCode())
+		}
+		if gotMethod != http.MethodPost {
+			t.Errorf("Expected POST, got %s", gotMethod)
+		}
+		if gotURI != "/users/a%2F1/notes?limit=10" {
+			t.Errorf("Expected URI /users/a%%2F1/notes?limit=10, got %s", gotURI)
+		}
+		if gotHeader != "bar" {
+			t.Errorf("Expected X-Foo bar, got %q", gotHeader)
+		}
+		if gotContentType != "application/json" {
+			t.Errorf("Expected Content-Type application/json, got %q", gotContentType)
+		}
+		if gotBody != `{"text":"hello"}` {
+			t.Errorf("Expected JSON body, got %q", gotBody)
+		}
+	})
+
+	t.Run("keeps caller content type for raw body", func(t *testing.T) {
+		var gotContentType, gotBody string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			gotContentType = r.Header.Get("Content-Type")
+			body, _ := io.ReadAll(r.Body)
+			gotBody = string(body)
+		}))
+		defer server.Close()
+
+		_, err := NewClient().NewRequest().
+			Method(http.MethodPut).
+			Path(server.URL).
+			Header("Content-Type", "text/plain").
+			Body("plain text").
+			Do(context.Background())
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if gotContentType != "text/plain" || gotBody != "plain text" {
+			t.Errorf("Expected text/plain body, got %q %q", gotContentType, gotBody)
+		}
+	})
+
+	t.Run("runs through middleware", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		middleware := &mockMiddleware{}
+		client := NewClient(WithMiddleware(middleware))
+		_, err := client.NewRequest().Path(server.URL+"/items/{id}").PathParam("id", "5").Do(context.Background())
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if !middleware.beforeRequestCalled || !middleware.afterRequestCalled {
+			t.Errorf("Expected middleware to be called, got before=%v after=%v", middleware.beforeRequestCalled, middleware.afterRequestCalled)
+		}
+		if middleware.url != server.URL+"/items/5" {
+			t.Errorf("Expected middleware to see assembled URL, got %q", middleware.url)
+		}
+	})
+
+	t.Run("returns error without sending", func(t *testing.T) {
+		called := false
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			called = true
+		}))
+		defer server.Close()
+
+		client := NewClient()
+		if _, err := client.NewRequest().Path(server.URL + "/users/{id}").Do(context.Background()); err == nil {
+			t.Error("Expected error for missing path parameter")
+		}
+		if _, err := client.NewRequest().Path(server.URL).Body(make(chan int)).Do(context.Background()); err == nil || !strings.Contains(err.Error(), "failed to marshal request body") {
+			t.Errorf("Expected marshal error, got %v", err)
+		}
+		if called {
+			t.Error("Expected no request to reach the server")
+		}
+	})
+}
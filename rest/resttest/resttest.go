@@ -0,0 +1,122 @@
+// Package resttest provides helpers for testing code that uses rest.Client
+// without starting an HTTP server.
+//
+// NewMockClient routes every request of a rest.Client to an in-process
+// http.Handler, and RecordingMiddleware captures the RequestInfo of each
+// request for assertions:
+//
+//	recorder := resttest.NewRecordingMiddleware()
+//	client := resttest.NewMockClient(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+//	    w.WriteHeader(http.StatusCreated)
+//	}), rest.WithMiddleware(recorder))
+//
+//	_, err := client.MakeRequest(ctx, http.MethodPost, "/users", `{"name":"alice"}`, nil)
+//	last, _ := recorder.Last()
+//	// last.URL == "/users", last.StatusCode == 201
+package resttest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+
+	"github.com/jasoet/pkg/v2/rest"
+)
+
+// BaseURL is the base URL of mock clients, so requests can use paths like
+// "/users" instead of absolute URLs.
+const BaseURL = "http://resttest.local"
+
+// Transport is an http.RoundTripper that serves requests with Handler in
+// process, without opening a network connection.
+type Transport struct {
+	Handler http.Handler
+}
+
+// RoundTrip serves req with the handler and returns the recorded response.
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		defer req.Body.Close()
+	}
+	if err := req.Context().Err(); err != nil {
+		return nil, err
+	}
+
+	// Make the request look like one received by a server
+	serverReq := req.Clone(req.Context())
+	if serverReq.Body == nil {
+		serverReq.Body = http.NoBody
+	}
+	if serverReq.Host == "" {
+		serverReq.Host = req.URL.Host
+	}
+	serverReq.RequestURI = req.URL.RequestURI()
+	serverReq.RemoteAddr = "192.0.2.1:1234"
+
+	recorder := httptest.NewRecorder()
+	t.Handler.ServeHTTP(recorder, serverReq)
+
+	resp := recorder.Result()
+	resp.Request = req
+	return resp, nil
+}
+
+// NewMockClient creates a rest.Client whose requests are served by handler
+// through a Transport. Options are applied as with rest.NewClient, and relative
+// request URLs are resolved against BaseURL.
+func NewMockClient(handler http.Handler, opts ...rest.ClientOption) *rest.Client {
+	client := rest.NewClient(opts...)
+	restClient := client.GetRestClient()
+	restClient.SetTransport(&Transport{Handler: handler})
+	restClient.SetBaseURL(BaseURL)
+	return client
+}
+
+// RecordingMiddleware is a rest.Middleware that records the RequestInfo of
+// every completed request. It is safe for concurrent use.
+type RecordingMiddleware struct {
+	mu       sync.Mutex
+	requests []rest.RequestInfo
+}
+
+// NewRecordingMiddleware creates an empty RecordingMiddleware.
+func NewRecordingMiddleware() *RecordingMiddleware {
+	return &RecordingMiddleware{}
+}
+
+// BeforeRequest returns the context unchanged.
+func (m *RecordingMiddleware) BeforeRequest(ctx context.Context, method string, url string, body string, headers map[string]string) context.Context {
+	return ctx
+}
+
+// AfterRequest records info.
+func (m *RecordingMiddleware) AfterRequest(ctx context.Context, info rest.RequestInfo) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.requests = append(m.requests, info)
+}
+
+// Requests returns the recorded requests in completion order.
+func (m *RecordingMiddleware) Requests() []rest.RequestInfo {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]rest.RequestInfo(nil), m.requests...)
+}
+
+// Last returns the most recently recorded request, or false if none was recorded.
+func (m *RecordingMiddleware) Last() (rest.RequestInfo, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if len(m.requests) == 0 {
+		return rest.RequestInfo{}, false
+	}
+	return m.requests[len(m.requests)-1], true
+}
+
+// Reset discards the recorded requests.
+func (m *RecordingMiddleware) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.requests = nil
+}
@@ -0,0 +1,155 @@
+package resttest
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/jasoet/pkg/v2/rest"
+)
+
+func TestNewMockClient(t *testing.T) {
+	t.Run("handler receives the request", func(t *testing.T) {
+		var gotMethod, gotURI, gotHost, gotHeader, gotBody string
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			gotMethod = r.Method
+			gotURI = r.RequestURI
+			gotHost = r.Host
+			gotHeader = r.Header.Get("X-Foo")
+			body, _ := io.ReadAll(r.Body)
+			gotBody = string(body)
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"id":1}`))
+		})
+
+		recorder := NewRecordingMiddleware()
+		client := NewMockClient(handler, rest.WithMiddleware(recorder))
+
+		resp, err := client.MakeRequest(context.Background(), http.MethodPost, "/users?notify=true", `{"name":"alice"}`, map[string]string{"X-Foo": "bar"})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if resp.StatusCode() != http.StatusCreated || resp.String() != `{"id":1}` {
+			t.Errorf("Expected 201 with body, got %d %q", resp.StatusCode(), resp.String())
+		}
+
+		if gotMethod != http.MethodPost {
+			t.Errorf("Expected POST, got %s", gotMethod)
+		}
+		if gotURI != "/users?notify=true" {
+			t.Errorf("Expected URI /users?notify=true, got %s", gotURI)
+		}
+		if gotHost != "resttest.local" {
+			t.Errorf("Expected host resttest.local, got %s", gotHost)
+		}
+		if gotHeader != "bar" {
+			t.Errorf("Expected X-Foo bar, got %q", gotHeader)
+		}
+		if gotBody != `{"name":"alice"}` {
+			t.Errorf("Expected request body, got %q", gotBody)
+		}
+
+		requests := recorder.Requests()
+		if len(requests) != 1 {
+			t.Fatalf("Expected 1 recorded request, got %d", len(requests))
+		}
+		info := requests[0]
+		if info.Method != http.MethodPost || info.URL != "/users?notify=true" {
+			t.Errorf("Expected recorded POST /users?notify=true, got %s %s", info.Method, info.URL)
+		}
+		if info.Headers["X-Foo"] != "bar" || info.Body != `{"name":"alice"}` {
+			t.Errorf("Expected recorded headers and body, got %v %q", info.Headers, info.Body)
+		}
+		if info.StatusCode != http.StatusCreated || string(info.ResponseBody) != `{"id":1}` {
+			t.Errorf("Expected recorded response, got %d %q", info.StatusCode, info.ResponseBody)
+		}
+		if info.ResponseHeaders.Get("Content-Type") != "application/json" {
+			t.Errorf("Expected recorded response headers, got %v", info.ResponseHeaders)
+		}
+	})
+
+	t.Run("absolute URL bypasses base URL", func(t *testing.T) {
+		var gotHost, gotPath string
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			gotHost = r.Host
+			gotPath = r.URL.Path
+		})
+
+		client := NewMockClient(handler)
+
+		_, err := client.NewRequest().Path("https://api.example.com/v1/users/{id}").PathParam("id", "7").Do(context.Background())
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if gotHost != "api.example.com" || gotPath != "/v1/users/7" {
+			t.Errorf("Expected api.example.com/v1/users/7, got %s%s", gotHost, gotPath)
+		}
+	})
+
+	t.Run("error responses are typed", func(t *testing.T) {
+		client := NewMockClient(http.NotFoundHandler())
+
+		_, err := client.MakeRequest(context.Background(), http.MethodGet, "/missing", "", nil)
+		var notFound *rest.ResourceNotFoundError
+		if !errors.As(err, &notFound) {
+			t.Errorf("Expected ResourceNotFoundError, got %v", err)
+		}
+	})
+
+	t.Run("canceled context", func(t *testing.T) {
+		called := false
+		client := NewMockClient(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			called = true
+		}))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		if _, err := client.MakeRequest(ctx, http.MethodGet, "/", "", nil); err == nil {
+			t.Error("Expected error for canceled context")
+		}
+		if called {
+			t.Error("Expected handler not to be called")
+		}
+	})
+}
+
+func TestRecordingMiddleware(t *testing.T) {
+	var _ rest.Middleware = &RecordingMiddleware{}
+
+	recorder := NewRecordingMiddleware()
+	if _, ok := recorder.Last(); ok {
+		t.Error("Expected no recorded request")
+	}
+
+	client := NewMockClient(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}), rest.WithMiddleware(recorder))
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, _ = client.MakeRequest(context.Background(), http.MethodGet, "/items", "", nil)
+		}()
+	}
+	wg.Wait()
+
+	if got := len(recorder.Requests()); got != 10 {
+		t.Errorf("Expected 10 recorded requests, got %d", got)
+	}
+
+	_, _ = client.MakeRequest(context.Background(), http.MethodDelete, "/items/1", "", nil)
+	last, ok := recorder.Last()
+	if !ok || last.Method != http.MethodDelete || last.URL != "/items/1" {
+		t.Errorf("Expected last request DELETE /items/1, got %v %s %s", ok, last.Method, last.URL)
+	}
+
+	recorder.Reset()
+	if got := len(recorder.Requests()); got != 0 {
+		t.Errorf("Expected no requests after Reset, got %d", got)
+	}
+}
@@ -0,0 +1,107 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/jasoet/pkg/v2/otel"
+)
+
+// tokenRefreshSkew is how long before expiry a cached token is refreshed.
+const tokenRefreshSkew = 30 * time.Second
+
+// TokenFetcher obtains a bearer token and its expiry time.
+// A zero expiry means the token does not expire.
+type TokenFetcher func(ctx context.Context) (token string, expiry time.Time, err error)
+
+// TokenRefreshMiddleware injects a bearer token into every request and keeps it
+// fresh. The token is fetched on first use, refreshed shortly before it expires,
+// and force-refreshed when a request returns 401 Unauthorized.
+//
+// Refreshes are serialized, so concurrent requests sharing an expired token
+// trigger a single fetch.
+type TokenRefreshMiddleware struct {
+	fetch TokenFetcher
+	now   func() time.Time
+
+	mu     sync.Mutex
+	token  string
+	expiry time.Time
+}
+
+// NewTokenRefreshMiddleware creates a TokenRefreshMiddleware that obtains tokens from fetch.
+//
+// Example:
+//
+//	auth := rest.NewTokenRefreshMiddleware(func(ctx context.Context) (string, time.Time, error) {
+//	    tok, err := oauthConfig.Token(ctx)
+//	    if err != nil {
+//	        return "", time.Time{}, err
+//	    }
+//	    return tok.AccessToken, tok.Expiry, nil
+//	})
+//	client.AddMiddleware(auth)
+func NewTokenRefreshMiddleware(fetch TokenFetcher) *TokenRefreshMiddleware {
+	return &TokenRefreshMiddleware{
+		fetch: fetch,
+		now:   time.Now,
+	}
+}
+
+// BeforeRequest sets the Authorization header, fetching a new token first if
+// none is cached or the cached one is about to expire. If fetching fails, the
+// error is logged and the request is sent without a token.
+func (m *TokenRefreshMiddleware) BeforeRequest(ctx context.Context, method string, url string, body string, headers map[string]string) context.Context {
+	m.mu.Lock()
+	if m.token == "" || m.expiringLocked() {
+		m.refreshLocked(ctx)
+	}
+	token := m.token
+	m.mu.Unlock()
+
+	if token != "" && headers != nil {
+		headers["Authorization"] = "Bearer " + token
+	}
+
+	return ctx
+}
+
+// AfterRequest force-refreshes the token when the request was rejected with
+// 401 Unauthorized. Concurrent 401s for the same token refresh only once.
+func (m *TokenRefreshMiddleware) AfterRequest(ctx context.Context, info RequestInfo) {
+	if info.StatusCode != http.StatusUnauthorized {
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// Skip if another request already replaced the rejected token
+	if info.Headers["Authorization"] != "Bearer "+m.token {
+		return
+	}
+
+	m.refreshLocked(ctx)
+}
+
+// expiringLocked reports whether the cached token expires within tokenRefreshSkew.
+// Callers must hold m.mu.
+func (m *TokenRefreshMiddleware) expiringLocked() bool {
+	return !m.expiry.IsZero() && !m.now().Add(tokenRefreshSkew).Before(m.expiry)
+}
+
+// refreshLocked fetches a new token. On failure the cached token is cleared so
+// the next request retries. Callers must hold m.mu.
+func (m *TokenRefreshMiddleware) refreshLocked(ctx context.Context) {
+	token, expiry, err := m.fetch(ctx)
+	if err != nil {
+		logger := otel.NewLogHelper(ctx, nil, "github.com/jasoet/pkg/v2/rest", "TokenRefreshMiddleware.refresh")
+		logger.Error(err, "Failed to fetch bearer token")
+		m.token, m.expiry = "", time.Time{}
+		return
+	}
+
+	m.token, m.expiry = token, expiry
+}
@@ -0,0 +1,210 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// fakeTokenSource issues numbered tokens and counts fetches
+type fakeTokenSource struct {
+	calls atomic.Int32
+	ttl   time.Duration
+	now   func() time.Time
+	err   error
+	delay time.Duration
+}
+
+func (f *fakeTokenSource) fetch(ctx context.Context) (string, time.Time, error) {
+	n := f.calls.Add(1)
+	if f.delay > 0 {
+		time.Sleep(f.delay)
+	}
+	if f.err != nil {
+		return "", time.Time{}, f.err
+	}
+	var expiry time.Time
+	if f.ttl > 0 {
+		expiry = f.now().Add(f.ttl)
+	}
+	return fmt.Sprintf("token-%d", n), expiry, nil
+}
+
+func TestTokenRefreshMiddleware(t *testing.T) {
+	ctx := context.Background()
+	var _ Middleware = &TokenRefreshMiddleware{}
+
+	t.Run("caches token until near expiry", func(t *testing.T) {
+		clock := time.Unix(1_700_000_000, 0)
+		source := &fakeTokenSource{ttl: 5 * time.Minute, now: func() time.Time { return clock }}
+		m := NewTokenRefreshMiddleware(source.fetch)
+		m.now = func() time.Time { return clock }
+
+		headers := map[string]string{}
+		m.BeforeRequest(ctx, "GET", "/", "", headers)
+		if got := headers["Authorization"]; got != "Bearer token-1" {
+			t.Fatalf("Expected Bearer token-1, got %q", got)
+		}
+
+		clock = clock.Add(4 * time.Minute)
+		m.BeforeRequest(ctx, "GET", "/", "", headers)
+		if calls := source.calls.Load(); calls != 1 {
+			t.Fatalf("Expected 1 fetch before expiry, got %d", calls)
+		}
+
+		// Within the refresh skew of expiry
+		clock = clock.Add(45 * time.Second)
+		m.BeforeRequest(ctx, "GET", "/", "", headers)
+		if calls := source.calls.Load(); calls != 2 {
+			t.Fatalf("Expected 2 fetches after expiry, got %d", calls)
+		}
+		if got := headers["Authorization"]; got != "Bearer token-2" {
+			t.Errorf("Expected Bearer token-2, got %q", got)
+		}
+	})
+
+	t.Run("zero expiry never refreshes on time", func(t *testing.T) {
+		source := &fakeTokenSource{now: time.Now}
+		m := NewTokenRefreshMiddleware(source.fetch)
+
+		for i := 0; i < 5; i++ {
+			m.BeforeRequest(ctx, "GET", "/", "", map[string]string{})
+		}
+		if calls := source.calls.Load(); calls != 1 {
+			t.Errorf("Expected 1 fetch, got %d", calls)
+		}
+	})
+
+	t.Run("401 forces a single refresh", func(t *testing.T) {
+		source := &fakeTokenSource{ttl: time.Hour, now: time.Now}
+		m := NewTokenRefreshMiddleware(source.fetch)
+
+		headers := map[string]string{}
+		m.BeforeRequest(ctx, "GET", "/", "", headers)
+
+		rejected := RequestInfo{StatusCode: http.StatusUnauthorized, Headers: map[string]string{"Authorization": headers["Authorization"]}}
+		m.AfterRequest(ctx, rejected)
+		if calls := source.calls.Load(); calls != 2 {
+			t.Fatalf("Expected 2 fetches after 401, got %d", calls)
+		}
+
+		// A second 401 for the already replaced token must not refresh again
+		m.AfterRequest(ctx, rejected)
+		if calls := source.calls.Load(); calls != 2 {
+			t.Fatalf("Expected stale 401 to be ignored, got %d fetches", calls)
+		}
+
+		m.BeforeRequest(ctx, "GET", "/", "", headers)
+		if got := headers["Authorization"]; got != "Bearer token-2" {
+			t.Errorf("Expected Bearer token-2, got %q", got)
+		}
+
+		m.AfterRequest(ctx, RequestInfo{StatusCode: http.StatusOK, Headers: headers})
+		m.AfterRequest(ctx, RequestInfo{StatusCode: http.StatusForbidden, Headers: headers})
+		if calls := source.calls.Load(); calls != 2 {
+			t.Errorf("Expected non-401 responses not to refresh, got %d fetches", calls)
+		}
+	})
+
+	t.Run("concurrent requests refresh once", func(t *testing.T) {
+		source := &fakeTokenSource{ttl: time.Hour, now: time.Now, delay: 20 * time.Millisecond}
+		m := NewTokenRefreshMiddleware(source.fetch)
+
+		var wg sync.WaitGroup
+		for i := 0; i < 50; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				headers := map[string]string{}
+				m.BeforeRequest(ctx, "GET", "/", "", headers)
+				if headers["Authorization"] != "Bearer token-1" {
+					t.Errorf("Expected Bearer token-1, got %q", headers["Authorization"])
+				}
+			}()
+		}
+		wg.Wait()
+
+		if calls := source.calls.Load(); calls != 1 {
+			t.Errorf("Expected 1 fetch for concurrent requests, got %d", calls)
+		}
+	})
+
+	t.Run("fetch error sends no token and retries", func(t *testing.T) {
+		source := &fakeTokenSource{now: time.Now, err: errors.New("idp unavailable")}
+		m := NewTokenRefreshMiddleware(source.fetch)
+
+		headers := map[string]string{}
+		m.BeforeRequest(ctx, "GET", "/", "", headers)
+		if _, ok := headers["Authorization"]; ok {
+			t.Error("Expected no Authorization header after fetch error")
+		}
+
+		source.err = nil
+		m.BeforeRequest(ctx, "GET", "/", "", headers)
+		if calls := source.calls.Load(); calls != 2 {
+			t.Errorf("Expected retry after failed fetch, got %d fetches", calls)
+		}
+		if got := headers["Authorization"]; got != "Bearer token-2" {
+			t.Errorf("Expected Bearer token-2, got %q", got)
+		}
+	})
+}
+
+func TestTokenRefreshMiddleware_WithClient(t *testing.T) {
+	var validToken atomic.Value
+	validToken.Store("Bearer token-2")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != validToken.Load().(string) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	source := &fakeTokenSource{ttl: time.Hour, now: time.Now}
+	client := NewClient(WithMiddleware(NewTokenRefreshMiddleware(source.fetch)))
+
+	// token-1 is rejected by the server, which forces a refresh
+	if _, err := client.MakeRequest(context.Background(), http.MethodGet, server.URL, "", nil); err == nil {
+		t.Fatal("Expected first request to fail with 401")
+	}
+
+	if _, err := client.MakeRequest(context.Background(), http.MethodGet, server.URL, "", nil); err != nil {
+		t.Fatalf("Expected second request to succeed with refreshed token, got %v", err)
+	}
+
+	if calls := source.calls.Load(); calls != 2 {
+		t.Errorf("Expected 2 fetches, got %d", calls)
+	}
+}
+
+func TestTokenRefreshMiddleware_DoesNotModifyCallerHeaders(t *testing.T) {
+	var gotAuthorization string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuthorization = r.Header.Get("Authorization")
+	}))
+	defer server.Close()
+
+	source := &fakeTokenSource{ttl: time.Hour, now: time.Now}
+	client := NewClient(WithMiddleware(NewTokenRefreshMiddleware(source.fetch)))
+
+	headers := map[string]string{"Accept": "application/json"}
+	if _, err := client.MakeRequest(context.Background(), http.MethodGet, server.URL, "", headers); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if gotAuthorization != "Bearer token-1" {
+		t.Errorf("Expected server to receive Bearer token-1, got %q", gotAuthorization)
+	}
+	if _, ok := headers["Authorization"]; ok || len(headers) != 1 {
+		t.Errorf("Expected caller headers to be unchanged, got %v", headers)
+	}
+}
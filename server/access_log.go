@@ -0,0 +1,79 @@
+package server
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog"
+
+	"github.com/jasoet/pkg/v2/logging"
+)
+
+// accessLogMiddleware logs one line per request through the logging package.
+// The request ID is taken from the X-Request-ID header or generated, echoed in
+// the response, and stored in the request context with logging.WithRequestID so
+// loggers created with logging.ContextLogger inside handlers include it and
+// rest.RequestIDMiddleware forwards it on outgoing calls.
+func accessLogMiddleware(skipper func(path string) bool) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+			path := req.URL.Path
+
+			if skipper != nil && skipper(path) {
+				return next(c)
+			}
+
+			requestID := req.Header.Get(echo.HeaderXRequestID)
+			if requestID == "" {
+				requestID = newRequestID()
+				req.Header.Set(echo.HeaderXRequestID, requestID)
+			}
+			c.Response().Header().Set(echo.HeaderXRequestID, requestID)
+
+			ctx := logging.WithRequestID(req.Context(), requestID)
+			c.SetRequest(req.WithContext(ctx))
+
+			start := time.Now()
+			err := next(c)
+			if err != nil {
+				// Commit the error response so the logged status matches what the client sees
+				c.Error(err)
+			}
+			duration := time.Since(start)
+
+			res := c.Response()
+			logger := logging.ContextLogger(ctx, "server.access")
+
+			var event *zerolog.Event
+			switch {
+			case res.Status >= 500:
+				event = logger.Error().Err(err)
+			case res.Status >= 400:
+				event = logger.Warn()
+			default:
+				event = logger.Info()
+			}
+
+			event.
+				Str("method", req.Method).
+				Str("path", path).
+				Int("status", res.Status).
+				Dur("duration", duration).
+				Str("remote_ip", c.RealIP()).
+				Int64("bytes_out", res.Size).
+				Msg("HTTP request")
+
+			return err
+		}
+	}
+}
+
+// newRequestID returns a random 16-byte hex request ID.
+func newRequestID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,157 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog"
+	zlog "github.com/rs/zerolog/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jasoet/pkg/v2/logging"
+)
+
+func captureAccessLog(t *testing.T) *bytes.Buffer {
+	t.Helper()
+
+	original := zlog.Logger
+	t.Cleanup(func() { zlog.Logger = original })
+
+	var buf bytes.Buffer
+	zlog.Logger = zerolog.New(&buf)
+	return &buf
+}
+
+func accessLogLines(t *testing.T, buf *bytes.Buffer) []map[string]any {
+	t.Helper()
+
+	var lines []map[string]any
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		var entry map[string]any
+		require.NoError(t, json.Unmarshal([]byte(line), &entry))
+		if entry["component"] == "server.access" {
+			lines = append(lines, entry)
+		}
+	}
+	return lines
+}
+
+func TestAccessLog(t *testing.T) {
+	buf := captureAccessLog(t)
+
+	var handlerRequestID any
+	var contextRequestID string
+	config := NewConfig(
+		WithAccessLog(),
+		WithAccessLogSkipper(func(path string) bool { return strings.HasPrefix(path, "/health") }),
+		WithEchoConfigurer(func(e *echo.Echo) {
+			e.GET("/users", func(c echo.Context) error {
+				contextRequestID = logging.RequestIDFromContext(c.Request().Context())
+
+				// Loggers derived from the request context carry the request ID
+				var handlerBuf bytes.Buffer
+				logger := logging.ContextLogger(c.Request().Context(), "handler").Output(&handlerBuf)
+				logger.Info().Msg("handling")
+				var entry map[string]any
+				if err := json.Unmarshal(handlerBuf.Bytes(), &entry); err == nil {
+					handlerRequestID = entry["request_id"]
+				}
+				return c.String(http.StatusOK, "alice,bob")
+			})
+			e.GET("/fail", func(c echo.Context) error {
+				return echo.NewHTTPError(http.StatusInternalServerError, "boom")
+			})
+		}),
+	)
+	e := setupEcho(config)
+
+	t.Run("logs request fields", func(t *testing.T) {
+		buf.Reset()
+		req := httptest.NewRequest(http.MethodGet, "/users", nil)
+		req.Header.Set(echo.HeaderXRealIP, "203.0.113.7")
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		lines := accessLogLines(t, buf)
+		require.Len(t, lines, 1)
+
+		entry := lines[0]
+		assert.Equal(t, "info", entry["level"])
+		assert.Equal(t, "HTTP request", entry["message"])
+		assert.Equal(t, http.MethodGet, entry["method"])
+		assert.Equal(t, "/users", entry["path"])
+		assert.Equal(t, float64(http.StatusOK), entry["status"])
+		assert.Contains(t, entry, "duration")
+		assert.Equal(t, "203.0.113.7", entry["remote_ip"])
+		assert.Equal(t, float64(len("alice,bob")), entry["bytes_out"])
+
+		requestID := rec.Header().Get(echo.HeaderXRequestID)
+		assert.NotEmpty(t, requestID, "Response should carry a generated request ID")
+		assert.Equal(t, requestID, entry["request_id"])
+		assert.Equal(t, requestID, handlerRequestID, "Handler logger should include the request ID")
+		assert.Equal(t, requestID, contextRequestID, "Handler context should carry the request ID")
+	})
+
+	t.Run("reuses incoming request ID", func(t *testing.T) {
+		buf.Reset()
+		req := httptest.NewRequest(http.MethodGet, "/users", nil)
+		req.Header.Set(echo.HeaderXRequestID, "req-123")
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+
+		lines := accessLogLines(t, buf)
+		require.Len(t, lines, 1)
+		assert.Equal(t, "req-123", lines[0]["request_id"])
+		assert.Equal(t, "req-123", rec.Header().Get(echo.HeaderXRequestID))
+	})
+
+	t.Run("logs handler errors with final status", func(t *testing.T) {
+		buf.Reset()
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/fail", nil))
+
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+		lines := accessLogLines(t, buf)
+		require.Len(t, lines, 1)
+		assert.Equal(t, "error", lines[0]["level"])
+		assert.Equal(t, float64(http.StatusInternalServerError), lines[0]["status"])
+		assert.Contains(t, lines[0]["error"], "boom")
+	})
+
+	t.Run("skipped paths are not logged", func(t *testing.T) {
+		buf.Reset()
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/health", nil))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Empty(t, accessLogLines(t, buf))
+		assert.Empty(t, rec.Header().Get(echo.HeaderXRequestID))
+	})
+}
+
+func TestAccessLog_DisabledByDefault(t *testing.T) {
+	buf := captureAccessLog(t)
+
+	e := setupEcho(NewConfig())
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/health", nil))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Empty(t, accessLogLines(t, buf))
+}
+
+func TestNewRequestID(t *testing.T) {
+	id := newRequestID()
+	assert.Len(t, id, 32)
+	assert.NotEqual(t, id, newRequestID())
+}
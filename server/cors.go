@@ -0,0 +1,54 @@
+package server
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+)
+
+// CORSConfig configures the CORS middleware installed when Config.CORS is set.
+type CORSConfig struct {
+	// AllowOrigins lists the origins allowed to make cross-origin requests,
+	// e.g. "https://app.example.com". Empty allows all origins ("*").
+	AllowOrigins []string `yaml:"allowOrigins" mapstructure:"allowOrigins"`
+
+	// AllowMethods lists the methods allowed in preflight responses.
+	// Empty uses Echo's default (GET, HEAD, PUT, PATCH, POST, DELETE).
+	AllowMethods []string `yaml:"allowMethods" mapstructure:"allowMethods"`
+
+	// AllowHeaders lists the request headers allowed in preflight responses.
+	// Empty reflects the headers requested by the browser.
+	AllowHeaders []string `yaml:"allowHeaders" mapstructure:"allowHeaders"`
+
+	// AllowCredentials lets browsers send cookies and auth headers. It requires
+	// explicit AllowOrigins: the server fails to start when AllowOrigins is empty
+	// or contains "*".
+	AllowCredentials bool `yaml:"allowCredentials" mapstructure:"allowCredentials"`
+
+	// MaxAge is how long browsers may cache preflight responses, rounded down
+	// to whole seconds. Zero omits the Access-Control-Max-Age header.
+	MaxAge time.Duration `yaml:"maxAge" mapstructure:"maxAge"`
+}
+
+// validate rejects credentials combined with a wildcard origin, which would let
+// any site make authenticated requests.
+func (c CORSConfig) validate() error {
+	if c.AllowCredentials && (len(c.AllowOrigins) == 0 || slices.Contains(c.AllowOrigins, "*")) {
+		return fmt.Errorf("CORS AllowCredentials requires explicit AllowOrigins, not a wildcard")
+	}
+	return nil
+}
+
+// middleware returns the Echo CORS middleware for this configuration.
+func (c CORSConfig) middleware() echo.MiddlewareFunc {
+	return middleware.CORSWithConfig(middleware.CORSConfig{
+		AllowOrigins:     c.AllowOrigins,
+		AllowMethods:     c.AllowMethods,
+		AllowHeaders:     c.AllowHeaders,
+		AllowCredentials: c.AllowCredentials,
+		MaxAge:           int(c.MaxAge / time.Second),
+	})
+}
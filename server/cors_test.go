@@ -0,0 +1,112 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCORS(t *testing.T) {
+	config := NewConfig(
+		WithCORS(CORSConfig{
+			AllowOrigins:     []string{"https://app.example.com"},
+			AllowMethods:     []string{http.MethodGet, http.MethodPost},
+			AllowHeaders:     []string{"Authorization", "Content-Type"},
+			AllowCredentials: true,
+			MaxAge:           10 * time.Minute,
+		}),
+		WithMiddleware(func(next echo.HandlerFunc) echo.HandlerFunc {
+			return func(c echo.Context) error {
+				if c.Request().Header.Get("Authorization") == "" {
+					return echo.ErrUnauthorized
+				}
+				return next(c)
+			}
+		}),
+		WithEchoConfigurer(func(e *echo.Echo) {
+			e.GET("/api/items", func(c echo.Context) error {
+				return c.String(http.StatusOK, "items")
+			})
+		}),
+	)
+	e := setupEcho(config)
+
+	t.Run("preflight from allowed origin", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodOptions, "/api/items", nil)
+		req.Header.Set(echo.HeaderOrigin, "https://app.example.com")
+		req.Header.Set(echo.HeaderAccessControlRequestMethod, http.MethodGet)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+		assert.Equal(t, "https://app.example.com", rec.Header().Get(echo.HeaderAccessControlAllowOrigin))
+		assert.Equal(t, "GET,POST", rec.Header().Get(echo.HeaderAccessControlAllowMethods))
+		assert.Equal(t, "Authorization,Content-Type", rec.Header().Get(echo.HeaderAccessControlAllowHeaders))
+		assert.Equal(t, "true", rec.Header().Get(echo.HeaderAccessControlAllowCredentials))
+		assert.Equal(t, "600", rec.Header().Get(echo.HeaderAccessControlMaxAge))
+	})
+
+	t.Run("preflight from other origin", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodOptions, "/api/items", nil)
+		req.Header.Set(echo.HeaderOrigin, "https://evil.example.com")
+		req.Header.Set(echo.HeaderAccessControlRequestMethod, http.MethodGet)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+
+		assert.Empty(t, rec.Header().Get(echo.HeaderAccessControlAllowOrigin))
+	})
+
+	t.Run("error response carries CORS headers", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/items", nil)
+		req.Header.Set(echo.HeaderOrigin, "https://app.example.com")
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+		assert.Equal(t, "https://app.example.com", rec.Header().Get(echo.HeaderAccessControlAllowOrigin))
+	})
+}
+
+func TestCORS_NotInstalledByDefault(t *testing.T) {
+	e := setupEcho(NewConfig())
+
+	req := httptest.NewRequest(http.MethodGet, "/health", nil)
+	req.Header.Set(echo.HeaderOrigin, "https://app.example.com")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Empty(t, rec.Header().Get(echo.HeaderAccessControlAllowOrigin))
+}
+
+func TestCORS_CredentialsRequireExplicitOrigins(t *testing.T) {
+	tests := []struct {
+		name    string
+		cors    CORSConfig
+		wantErr bool
+	}{
+		{name: "empty origins", cors: CORSConfig{AllowCredentials: true}, wantErr: true},
+		{name: "wildcard origin", cors: CORSConfig{AllowOrigins: []string{"https://app.example.com", "*"}, AllowCredentials: true}, wantErr: true},
+		{name: "explicit origins", cors: CORSConfig{AllowOrigins: []string{"https://app.example.com"}, AllowCredentials: true}},
+		{name: "wildcard without credentials", cors: CORSConfig{AllowOrigins: []string{"*"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newHTTPServer(NewConfig(WithPort(0), WithCORS(tt.cors)))
+			err := server.start()
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "AllowCredentials requires explicit AllowOrigins")
+				return
+			}
+			require.NoError(t, err)
+			assert.NoError(t, server.stop())
+		})
+	}
+}
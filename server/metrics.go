@@ -0,0 +1,147 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.opentelemetry.io/otel/metric"
+	semconv "go.opentelemetry.io/otel/semconv/v1.27.0"
+)
+
+// defaultMetricsPath is the metrics endpoint used when MetricsPath is unset.
+const defaultMetricsPath = "/metrics"
+
+// unmatchedRoute labels requests that did not match a registered route, so
+// arbitrary paths do not create new series.
+const unmatchedRoute = "unmatched"
+
+// metricsPath returns the path of the Prometheus endpoint.
+func (c Config) metricsPath() string {
+	if c.MetricsPath == "" {
+		return defaultMetricsPath
+	}
+	return c.MetricsPath
+}
+
+// httpMetrics records request counts and latencies by method, route and status
+// in a Prometheus registry and, when configured, through the OTel MeterProvider.
+type httpMetrics struct {
+	registry *prometheus.Registry
+	requests *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+
+	otelDuration metric.Float64Histogram
+}
+
+// newHTTPMetrics registers the request metrics in config.MetricsRegistry, or in
+// a new registry with the Go runtime and process collectors when it is nil.
+func newHTTPMetrics(config Config) (*httpMetrics, error) {
+	registry := config.MetricsRegistry
+	if registry == nil {
+		registry = prometheus.NewRegistry()
+		registry.MustRegister(
+			collectors.NewGoCollector(),
+			collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		)
+	}
+
+	m := &httpMetrics{
+		registry: registry,
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "http_server_requests_total",
+			Help: "Total number of HTTP requests handled, by method, route and status.",
+		}, []string{"method", "route", "status"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "http_server_request_duration_seconds",
+			Help:    "Duration of HTTP requests in seconds, by method, route and status.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"method", "route", "status"}),
+	}
+
+	var err error
+	if m.requests, err = registerCollector(registry, m.requests); err != nil {
+		return nil, fmt.Errorf("failed to register request counter: %w", err)
+	}
+	if m.duration, err = registerCollector(registry, m.duration); err != nil {
+		return nil, fmt.Errorf("failed to register request duration histogram: %w", err)
+	}
+
+	// Instrument creation errors only disable the OTel bridge
+	if config.OTelConfig != nil && config.OTelConfig.IsMetricsEnabled() {
+		histogram, err := config.OTelConfig.GetMeter("github.com/jasoet/pkg/v2/server").Float64Histogram(
+			"http.server.request.duration",
+			metric.WithUnit("s"),
+			metric.WithDescription("Duration of HTTP server requests."),
+		)
+		if err == nil {
+			m.otelDuration = histogram
+		}
+	}
+
+	return m, nil
+}
+
+// registerCollector registers c in registry. If an identical collector is
+// already registered, e.g. by another server sharing the registry, that one is
+// returned instead.
+func registerCollector[T prometheus.Collector](registry *prometheus.Registry, c T) (T, error) {
+	if err := registry.Register(c); err != nil {
+		var alreadyRegistered prometheus.AlreadyRegisteredError
+		if errors.As(err, &alreadyRegistered) {
+			if existing, ok := alreadyRegistered.ExistingCollector.(T); ok {
+				return existing, nil
+			}
+		}
+		return c, err
+	}
+	return c, nil
+}
+
+// middleware records every request. Handler errors are committed first so the
+// recorded status matches the response sent to the client.
+func (m *httpMetrics) middleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			start := time.Now()
+			err := next(c)
+			if err != nil {
+				c.Error(err)
+			}
+
+			route := c.Path()
+			if route == "" {
+				route = unmatchedRoute
+			}
+			m.record(c.Request().Context(), c.Request().Method, route, c.Response().Status, time.Since(start))
+
+			return err
+		}
+	}
+}
+
+// record adds one request to the Prometheus and OTel instruments.
+func (m *httpMetrics) record(ctx context.Context, method, route string, status int, duration time.Duration) {
+	code := strconv.Itoa(status)
+	m.requests.WithLabelValues(method, route, code).Inc()
+	m.duration.WithLabelValues(method, route, code).Observe(duration.Seconds())
+
+	if m.otelDuration != nil {
+		m.otelDuration.Record(ctx, duration.Seconds(), metric.WithAttributes(
+			semconv.HTTPRequestMethodKey.String(method),
+			semconv.HTTPRoute(route),
+			semconv.HTTPResponseStatusCode(status),
+		))
+	}
+}
+
+// handler serves the registry in the Prometheus text format.
+func (m *httpMetrics) handler() echo.HandlerFunc {
+	return echo.WrapHandler(promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{}))
+}
@@ -0,0 +1,134 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+
+	"github.com/jasoet/pkg/v2/otel"
+)
+
+// serve sends a request to e and returns the recorded response.
+func serve(e *echo.Echo, method, path string) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(method, path, nil))
+	return rec
+}
+
+func metricsRoutes(e *echo.Echo) {
+	e.GET("/users/:id", func(c echo.Context) error {
+		return c.String(http.StatusOK, c.Param("id"))
+	})
+	e.POST("/users", func(c echo.Context) error {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid user")
+	})
+}
+
+func TestMetrics(t *testing.T) {
+	e := setupEcho(NewConfig(WithMetrics(), WithEchoConfigurer(metricsRoutes)))
+
+	assert.Equal(t, http.StatusOK, serve(e, http.MethodGet, "/users/1").Code)
+
+	rec := serve(e, http.MethodGet, "/metrics")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Header().Get(echo.HeaderContentType), "text/plain")
+	body := rec.Body.String()
+	assert.Contains(t, body, "# TYPE http_server_requests_total counter")
+	assert.Contains(t, body, `http_server_requests_total{method="GET",route="/users/:id",status="200"} 1`)
+	assert.Contains(t, body, "# TYPE http_server_request_duration_seconds histogram")
+	assert.Contains(t, body, `http_server_request_duration_seconds_count{method="GET",route="/users/:id",status="200"} 1`)
+	assert.Contains(t, body, "go_goroutines", "Go runtime collector is registered")
+
+	t.Run("counter increments after a handled request", func(t *testing.T) {
+		serve(e, http.MethodGet, "/users/2")
+
+		body := serve(e, http.MethodGet, "/metrics").Body.String()
+		assert.Contains(t, body, `http_server_requests_total{method="GET",route="/users/:id",status="200"} 2`)
+	})
+
+	t.Run("records error status and unmatched routes", func(t *testing.T) {
+		assert.Equal(t, http.StatusBadRequest, serve(e, http.MethodPost, "/users").Code)
+		assert.Equal(t, http.StatusNotFound, serve(e, http.MethodGet, "/does/not/exist").Code)
+
+		body := serve(e, http.MethodGet, "/metrics").Body.String()
+		assert.Contains(t, body, `http_server_requests_total{method="POST",route="/users",status="400"} 1`)
+		assert.Contains(t, body, `http_server_requests_total{method="GET",route="unmatched",status="404"} 1`)
+		assert.NotContains(t, body, "/does/not/exist")
+	})
+}
+
+func TestMetrics_Disabled(t *testing.T) {
+	e := setupEcho(NewConfig(WithEchoConfigurer(metricsRoutes)))
+
+	assert.Equal(t, http.StatusNotFound, serve(e, http.MethodGet, "/metrics").Code)
+}
+
+func TestMetrics_CustomPathAndRegistry(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	jobs := prometheus.NewCounter(prometheus.CounterOpts{Name: "app_jobs_total", Help: "Jobs processed."})
+	registry.MustRegister(jobs)
+	jobs.Inc()
+
+	config := NewConfig(
+		WithMetrics(),
+		WithMetricsPath("/internal/metrics"),
+		WithMetricsRegistry(registry),
+		WithEchoConfigurer(metricsRoutes),
+	)
+	e := setupEcho(config)
+	serve(e, http.MethodGet, "/users/1")
+
+	// A second server sharing the registry records into the same series
+	other := setupEcho(config)
+	serve(other, http.MethodGet, "/users/1")
+
+	assert.Equal(t, http.StatusNotFound, serve(e, http.MethodGet, "/metrics").Code)
+
+	rec := serve(e, http.MethodGet, "/internal/metrics")
+	require.Equal(t, http.StatusOK, rec.Code)
+	body := rec.Body.String()
+	assert.Contains(t, body, "app_jobs_total 1")
+	assert.Contains(t, body, `http_server_requests_total{method="GET",route="/users/:id",status="200"} 2`)
+	assert.NotContains(t, body, "go_goroutines", "custom registry is served as-is")
+}
+
+func TestMetrics_OTelBridge(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	otelConfig := otel.NewConfig("test-service").
+		WithMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)))
+
+	e := setupEcho(NewConfig(WithMetrics(), WithOTelConfig(otelConfig), WithEchoConfigurer(metricsRoutes)))
+	serve(e, http.MethodGet, "/users/1")
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+
+	var histogram *metricdata.Histogram[float64]
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			if m.Name == "http.server.request.duration" {
+				data, ok := m.Data.(metricdata.Histogram[float64])
+				require.True(t, ok)
+				histogram = &data
+			}
+		}
+	}
+	require.NotNil(t, histogram, "OTel request duration histogram should be recorded")
+	require.Len(t, histogram.DataPoints, 1)
+
+	point := histogram.DataPoints[0]
+	assert.Equal(t, uint64(1), point.Count)
+	route, _ := point.Attributes.Value(attribute.Key("http.route"))
+	assert.Equal(t, "/users/:id", route.AsString())
+	status, _ := point.Attributes.Value(attribute.Key("http.response.status_code"))
+	assert.Equal(t, int64(200), status.AsInt64())
+}
@@ -0,0 +1,77 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+	"runtime/debug"
+
+	"github.com/labstack/echo/v4"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.27.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/jasoet/pkg/v2/logging"
+)
+
+// recoveryMiddleware recovers panics in handlers and turns them into a JSON
+// 500 Internal Server Error response. The panic is logged through the logging
+// package with the request method, path, request ID and stack trace, and
+// recorded on the span in the request context, if any. The panic value is not
+// included in the response to avoid leaking internals.
+func recoveryMiddleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) (err error) {
+			defer func() {
+				r := recover()
+				if r == nil {
+					return
+				}
+				// http.ErrAbortHandler is the documented way to abort a response
+				if r == http.ErrAbortHandler {
+					panic(r)
+				}
+				err = recoverPanic(c, r)
+			}()
+
+			return next(c)
+		}
+	}
+}
+
+// recoverPanic logs a recovered panic, records it on the active span and
+// returns the error rendered to the client.
+func recoverPanic(c echo.Context, r any) error {
+	panicErr, ok := r.(error)
+	if ok {
+		panicErr = fmt.Errorf("panic: %w", panicErr)
+	} else {
+		panicErr = fmt.Errorf("panic: %v", r)
+	}
+	stack := debug.Stack()
+
+	req := c.Request()
+	ctx := req.Context()
+
+	// The access log stores the request ID in the context; without it, fall
+	// back to the header
+	if logging.RequestIDFromContext(ctx) == "" {
+		if requestID := req.Header.Get(echo.HeaderXRequestID); requestID != "" {
+			ctx = logging.WithRequestID(ctx, requestID)
+		}
+	}
+
+	logger := logging.ContextLogger(ctx, "server.recovery")
+	logger.Error().
+		Err(panicErr).
+		Str("method", req.Method).
+		Str("path", req.URL.Path).
+		Str("stack", string(stack)).
+		Msg("Recovered from panic in HTTP handler")
+
+	if span := trace.SpanFromContext(ctx); span.IsRecording() {
+		span.RecordError(panicErr, trace.WithAttributes(semconv.ExceptionStacktrace(string(stack))))
+		span.SetStatus(codes.Error, panicErr.Error())
+	}
+
+	return echo.NewHTTPError(http.StatusInternalServerError).SetInternal(panicErr)
+}
@@ -0,0 +1,153 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/jasoet/pkg/v2/otel"
+)
+
+func recoveryLogLines(t *testing.T, output string) []map[string]any {
+	t.Helper()
+
+	var lines []map[string]any
+	for _, line := range strings.Split(strings.TrimSpace(output), "\n") {
+		if line == "" {
+			continue
+		}
+		var entry map[string]any
+		require.NoError(t, json.Unmarshal([]byte(line), &entry))
+		if entry["component"] == "server.recovery" {
+			lines = append(lines, entry)
+		}
+	}
+	return lines
+}
+
+func TestRecovery(t *testing.T) {
+	buf := captureAccessLog(t)
+
+	e := setupEcho(NewConfig(WithEchoConfigurer(func(e *echo.Echo) {
+		e.GET("/panic", func(c echo.Context) error {
+			panic("boom")
+		})
+	})))
+
+	req := httptest.NewRequest(http.MethodGet, "/panic", nil)
+	req.Header.Set(echo.HeaderXRequestID, "req-123")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	t.Run("responds with JSON 500", func(t *testing.T) {
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+		assert.Contains(t, rec.Header().Get(echo.HeaderContentType), echo.MIMEApplicationJSON)
+
+		var body map[string]any
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		assert.Equal(t, "Internal Server Error", body["message"])
+		assert.NotContains(t, rec.Body.String(), "boom", "panic value should not leak to the client")
+	})
+
+	t.Run("logs structured panic details", func(t *testing.T) {
+		lines := recoveryLogLines(t, buf.String())
+		require.Len(t, lines, 1)
+
+		entry := lines[0]
+		assert.Equal(t, "error", entry["level"])
+		assert.Equal(t, "panic: boom", entry["error"])
+		assert.Equal(t, http.MethodGet, entry["method"])
+		assert.Equal(t, "/panic", entry["path"])
+		assert.Equal(t, "req-123", entry["request_id"])
+		assert.Contains(t, entry["stack"], "recovery.go")
+	})
+
+	t.Run("server keeps serving", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/health", nil))
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+}
+
+func TestRecovery_AccessLogRecordsStatus(t *testing.T) {
+	buf := captureAccessLog(t)
+
+	e := setupEcho(NewConfig(WithAccessLog(), WithEchoConfigurer(func(e *echo.Echo) {
+		e.GET("/panic", func(c echo.Context) error {
+			panic("boom")
+		})
+	})))
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/panic", nil))
+	require.Equal(t, http.StatusInternalServerError, rec.Code)
+
+	recovered := recoveryLogLines(t, buf.String())
+	require.Len(t, recovered, 1)
+
+	access := accessLogLines(t, buf)
+	require.Len(t, access, 1)
+	assert.Equal(t, float64(http.StatusInternalServerError), access[0]["status"])
+	// The generated request ID is shared by both log lines
+	assert.Equal(t, rec.Header().Get(echo.HeaderXRequestID), recovered[0]["request_id"])
+	assert.Equal(t, access[0]["request_id"], recovered[0]["request_id"])
+}
+
+func TestRecovery_RecordsPanicOnSpan(t *testing.T) {
+	captureAccessLog(t)
+
+	recorder := tracetest.NewSpanRecorder()
+	otelConfig := otel.NewConfig("test-service").
+		WithTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+
+	e := setupEcho(NewConfig(
+		WithMiddleware(otel.EchoMiddleware(otelConfig)),
+		WithEchoConfigurer(func(e *echo.Echo) {
+			e.GET("/panic", func(c echo.Context) error {
+				panic("boom")
+			})
+		}),
+	))
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/panic", nil))
+	require.Equal(t, http.StatusInternalServerError, rec.Code)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	assert.Equal(t, otelcodes.Error, spans[0].Status().Code)
+
+	var exception bool
+	for _, event := range spans[0].Events() {
+		if event.Name != "exception" {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if attr.Key == "exception.message" && attr.Value.AsString() == "panic: boom" {
+				exception = true
+			}
+		}
+	}
+	assert.True(t, exception, "panic should be recorded on the span")
+}
+
+func TestRecovery_Disabled(t *testing.T) {
+	e := setupEcho(NewConfig(WithoutRecovery(), WithEchoConfigurer(func(e *echo.Echo) {
+		e.GET("/panic", func(c echo.Context) error {
+			panic("boom")
+		})
+	})))
+
+	assert.PanicsWithValue(t, "boom", func() {
+		e.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/panic", nil))
+	})
+}
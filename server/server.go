@@ -4,6 +4,7 @@ package server
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"net"
@@ -15,6 +16,7 @@ import (
 
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
+	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/jasoet/pkg/v2/otel"
 )
@@ -45,6 +47,50 @@ type Config struct {
 	EchoConfigurer EchoConfigurer
 
 	OTelConfig *otel.Config `yaml:"-" mapstructure:"-"`
+
+	// MaxBodyBytes limits request bodies; larger requests are rejected with
+	// 413 Request Entity Too Large. Zero or negative uses the 4 MiB default.
+	MaxBodyBytes int64 `yaml:"maxBodyBytes" mapstructure:"maxBodyBytes"`
+
+	// EnableAccessLog logs method, path, status, duration, remote IP, request ID
+	// and bytes written for every request through the logging package.
+	EnableAccessLog bool `yaml:"enableAccessLog" mapstructure:"enableAccessLog"`
+
+	// AccessLogSkipper returns true for request paths that should not be logged, such as "/health".
+	AccessLogSkipper func(path string) bool `yaml:"-" mapstructure:"-"`
+
+	// CORS installs a CORS middleware with the given settings. Nil installs none,
+	// leaving CORS to Middleware or EchoConfigurer.
+	CORS *CORSConfig `yaml:"cors" mapstructure:"cors"`
+
+	// CertFile and KeyFile enable HTTPS with the given PEM files. Both must be
+	// set. The files are checked for changes periodically and reloaded, so
+	// rotated certificates apply to new connections without a restart.
+	CertFile string `yaml:"certFile" mapstructure:"certFile"`
+	KeyFile  string `yaml:"keyFile" mapstructure:"keyFile"`
+
+	// WebSockets maps GET routes to WebSocket handlers. Their connections are
+	// closed during graceful shutdown, within ShutdownTimeout.
+	WebSockets map[string]*WebSocketHandler `yaml:"-" mapstructure:"-"`
+
+	// DisableRecovery turns off the built-in panic recovery. By default a
+	// panicking handler is logged with its stack trace, recorded on the active
+	// span and answered with a JSON 500 response.
+	DisableRecovery bool `yaml:"disableRecovery" mapstructure:"disableRecovery"`
+
+	// EnableMetrics serves Prometheus metrics on MetricsPath and records the
+	// count and latency of every request by method, route and status. When
+	// OTelConfig has metrics enabled, latencies are also recorded as the OTel
+	// http.server.request.duration histogram.
+	EnableMetrics bool `yaml:"enableMetrics" mapstructure:"enableMetrics"`
+
+	// MetricsPath is the path of the Prometheus endpoint. Empty uses "/metrics".
+	MetricsPath string `yaml:"metricsPath" mapstructure:"metricsPath"`
+
+	// MetricsRegistry receives the request metrics and is served on MetricsPath,
+	// so applications can register their own collectors in it. Nil uses a new
+	// registry with the Go runtime and process collectors.
+	MetricsRegistry *prometheus.Registry `yaml:"-" mapstructure:"-"`
 }
 
 // Option configures a Config during construction.
@@ -85,6 +131,71 @@ func WithOTelConfig(cfg *otel.Config) Option {
 	return func(c *Config) { c.OTelConfig = cfg }
 }
 
+// WithMaxBodyBytes sets the request body size limit in bytes.
+func WithMaxBodyBytes(n int64) Option {
+	return func(c *Config) { c.MaxBodyBytes = n }
+}
+
+// WithAccessLog enables the access-log middleware.
+func WithAccessLog() Option {
+	return func(c *Config) { c.EnableAccessLog = true }
+}
+
+// WithAccessLogSkipper sets a function that excludes request paths from the access log.
+func WithAccessLogSkipper(skipper func(path string) bool) Option {
+	return func(c *Config) { c.AccessLogSkipper = skipper }
+}
+
+// WithCORS installs a CORS middleware with the given settings.
+func WithCORS(cors CORSConfig) Option {
+	return func(c *Config) { c.CORS = &cors }
+}
+
+// WithTLS serves HTTPS with the given certificate and key files, reloading them
+// when they change.
+func WithTLS(certFile, keyFile string) Option {
+	return func(c *Config) {
+		c.CertFile = certFile
+		c.KeyFile = keyFile
+	}
+}
+
+// WithWebSocket serves h on the GET route path and closes its connections on shutdown.
+func WithWebSocket(path string, h *WebSocketHandler) Option {
+	return func(c *Config) {
+		if c.WebSockets == nil {
+			c.WebSockets = make(map[string]*WebSocketHandler)
+		}
+		c.WebSockets[path] = h
+	}
+}
+
+// WithRecovery enables recovery of panics in handlers (enabled by default).
+func WithRecovery() Option {
+	return func(c *Config) { c.DisableRecovery = false }
+}
+
+// WithoutRecovery disables panic recovery, e.g. to install a custom recovery
+// middleware through WithMiddleware.
+func WithoutRecovery() Option {
+	return func(c *Config) { c.DisableRecovery = true }
+}
+
+// WithMetrics serves Prometheus metrics on MetricsPath ("/metrics" by default).
+func WithMetrics() Option {
+	return func(c *Config) { c.EnableMetrics = true }
+}
+
+// WithMetricsPath sets the path of the Prometheus endpoint enabled by WithMetrics.
+func WithMetricsPath(path string) Option {
+	return func(c *Config) { c.MetricsPath = path }
+}
+
+// WithMetricsRegistry serves and records metrics in registry instead of a new one.
+func WithMetricsRegistry(registry *prometheus.Registry) Option {
+	return func(c *Config) { c.MetricsRegistry = registry }
+}
+
 // DefaultConfig returns a default server configuration.
 func DefaultConfig(port int, operation Operation, shutdown Shutdown) Config {
 	return Config{
@@ -106,9 +217,22 @@ func NewConfig(opts ...Option) Config {
 	return cfg
 }
 
+// defaultMaxBodyBytes is the request body limit used when MaxBodyBytes is unset.
+const defaultMaxBodyBytes = 4 << 20
+
+// bodyLimit returns the body limit in the format accepted by middleware.BodyLimit.
+func (c Config) bodyLimit() string {
+	limit := c.MaxBodyBytes
+	if limit <= 0 {
+		limit = defaultMaxBodyBytes
+	}
+	return fmt.Sprintf("%dB", limit)
+}
+
 type httpServer struct {
 	echo   *echo.Echo
 	config Config
+	certs  *certReloader
 }
 
 // setupEcho configures the Echo instance with middleware and health routes.
@@ -116,20 +240,52 @@ func setupEcho(config Config) *echo.Echo {
 	e := echo.New()
 	e.HideBanner = true
 
+	var metrics *httpMetrics
+	if config.EnableMetrics {
+		var err error
+		if metrics, err = newHTTPMetrics(config); err != nil {
+			logger := otel.NewLogHelper(context.Background(), config.OTelConfig, "github.com/jasoet/pkg/v2/server", "setupEcho")
+			logger.Error(err, "Failed to set up metrics, serving without them")
+		}
+	}
+
 	// Set HTTP timeouts to prevent slow-client and resource exhaustion attacks
 	e.Server.ReadHeaderTimeout = 5 * time.Second
 	e.Server.ReadTimeout = 30 * time.Second
 	e.Server.WriteTimeout = 30 * time.Second
 	e.Server.IdleTimeout = 120 * time.Second
 
-	// Enforce a default body size limit to prevent request body attacks
-	e.Use(middleware.BodyLimit("4M"))
+	// Metrics run outermost so rejected and failed requests are counted too
+	if metrics != nil {
+		e.Use(metrics.middleware())
+	}
+
+	// Enforce a body size limit to prevent request body attacks. Routes added by
+	// EchoConfigurer can apply a stricter middleware.BodyLimit of their own.
+	e.Use(middleware.BodyLimit(config.bodyLimit()))
+
+	// Access log wraps custom middleware so their latency and errors are included
+	if config.EnableAccessLog {
+		e.Use(accessLogMiddleware(config.AccessLogSkipper))
+	}
+
+	// CORS runs before custom middleware so preflight requests are answered
+	// without authentication and error responses still carry CORS headers
+	if config.CORS != nil {
+		e.Use(config.CORS.middleware())
+	}
 
 	// Add custom middleware
 	for _, m := range config.Middleware {
 		e.Use(m)
 	}
 
+	// Recovery runs innermost so the access log, CORS and custom middleware such
+	// as otel.EchoMiddleware observe the 500 response of a panicking handler
+	if !config.DisableRecovery {
+		e.Use(recoveryMiddleware())
+	}
+
 	// Health check endpoints are registered before user middleware. They are intentionally unauthenticated for Kubernetes probe compatibility.
 	// Register health-check routes (no generic "/" handler — library callers add their own routes)
 	e.GET("/health", func(c echo.Context) error {
@@ -144,6 +300,14 @@ func setupEcho(config Config) *echo.Echo {
 		return c.JSON(http.StatusOK, map[string]string{"status": "ALIVE"})
 	})
 
+	if metrics != nil {
+		e.GET(config.metricsPath(), metrics.handler())
+	}
+
+	for path, h := range config.WebSockets {
+		e.GET(path, h.Handle)
+	}
+
 	// Apply custom Echo configuration if provided
 	if config.EchoConfigurer != nil {
 		config.EchoConfigurer(e)
@@ -165,6 +329,24 @@ func (s *httpServer) start() error {
 		return fmt.Errorf("invalid port: %d (must be 0-65535)", s.config.Port)
 	}
 
+	if (s.config.CertFile == "") != (s.config.KeyFile == "") {
+		return fmt.Errorf("both CertFile and KeyFile are required for TLS")
+	}
+
+	if s.config.CORS != nil {
+		if err := s.config.CORS.validate(); err != nil {
+			return err
+		}
+	}
+
+	if s.config.CertFile != "" {
+		certs, err := newCertReloader(s.config.CertFile, s.config.KeyFile, s.config.OTelConfig)
+		if err != nil {
+			return err
+		}
+		s.certs = certs
+	}
+
 	if s.config.Operation != nil {
 		s.config.Operation(s.echo)
 	}
@@ -177,9 +359,15 @@ func (s *httpServer) start() error {
 	if err != nil {
 		return fmt.Errorf("failed to listen on port %d: %w", s.config.Port, err)
 	}
+	if s.certs != nil {
+		ln = tls.NewListener(ln, &tls.Config{
+			MinVersion:     tls.VersionTLS12,
+			GetCertificate: s.certs.GetCertificate,
+		})
+	}
 	s.echo.Listener = ln
 
-	logger.Info("Starting server", otel.F("address", ln.Addr().String()))
+	logger.Info("Starting server", otel.F("address", ln.Addr().String()), otel.F("tls", s.certs != nil))
 
 	go func() {
 		if err := s.echo.Start(""); err != nil && !errors.Is(err, http.ErrServerClosed) {
@@ -202,7 +390,19 @@ func (s *httpServer) stop() error {
 		s.config.Shutdown(s.echo)
 	}
 
-	return s.echo.Shutdown(ctx)
+	// Hijacked WebSocket connections are not tracked by http.Server.Shutdown
+	var errs []error
+	for path, h := range s.config.WebSockets {
+		if err := h.Shutdown(ctx); err != nil {
+			logger.Error(err, "Failed to close WebSocket connections", otel.F("path", path))
+			errs = append(errs, fmt.Errorf("failed to close WebSocket connections on %s: %w", path, err))
+		}
+	}
+
+	if err := s.echo.Shutdown(ctx); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
 }
 
 // StartWithConfig starts the HTTP server with the given configuration and
@@ -399,3 +399,52 @@ func TestSetupEcho_HasTimeouts(t *testing.T) {
 	assert.Equal(t, 30*time.Second, e.Server.WriteTimeout, "WriteTimeout should be 30s")
 	assert.Equal(t, 120*time.Second, e.Server.IdleTimeout, "IdleTimeout should be 120s")
 }
+
+func TestMaxBodyBytes(t *testing.T) {
+	config := NewConfig(
+		WithMaxBodyBytes(16),
+		WithEchoConfigurer(func(e *echo.Echo) {
+			e.POST("/upload", func(c echo.Context) error {
+				body, err := io.ReadAll(c.Request().Body)
+				if err != nil {
+					return err
+				}
+				return c.String(http.StatusOK, string(body))
+			})
+		}),
+	)
+	e := setupEcho(config)
+
+	t.Run("body under limit", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/upload", strings.NewReader("small"))
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "small", rec.Body.String())
+	})
+
+	t.Run("body over limit", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/upload", strings.NewReader(strings.Repeat("x", 17)))
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+	})
+
+	t.Run("streamed body over limit", func(t *testing.T) {
+		// Without Content-Length the limit is enforced while reading
+		req := httptest.NewRequest(http.MethodPost, "/upload", io.MultiReader(strings.NewReader(strings.Repeat("x", 32))))
+		req.ContentLength = -1
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+	})
+}
+
+func TestConfigBodyLimit(t *testing.T) {
+	assert.Equal(t, "4194304B", NewConfig().bodyLimit(), "Default should be 4 MiB")
+	assert.Equal(t, "1024B", NewConfig(WithMaxBodyBytes(1024)).bodyLimit())
+	assert.Equal(t, "4194304B", NewConfig(WithMaxBodyBytes(-1)).bodyLimit())
+}
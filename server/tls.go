@@ -0,0 +1,118 @@
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/jasoet/pkg/v2/otel"
+)
+
+// defaultCertCheckInterval is how often the certificate files are checked for changes.
+const defaultCertCheckInterval = 10 * time.Second
+
+// certReloader serves a certificate loaded from disk and reloads it when the
+// certificate or key file changes, so rotated certificates are used for new
+// connections without a restart. Files are checked during TLS handshakes at
+// most once per interval; established connections keep their certificate.
+//
+// If a reload fails (e.g. the key is not yet written), the previous certificate
+// is kept and the reload is retried on the next check.
+type certReloader struct {
+	certFile   string
+	keyFile    string
+	interval   time.Duration
+	otelConfig *otel.Config
+
+	mu        sync.Mutex
+	cert      *tls.Certificate
+	certMod   time.Time
+	keyMod    time.Time
+	lastCheck time.Time
+}
+
+// newCertReloader loads the initial certificate. Unlike later reloads, a failure
+// here is returned so the server does not start without a certificate.
+func newCertReloader(certFile, keyFile string, otelConfig *otel.Config) (*certReloader, error) {
+	r := &certReloader{
+		certFile:   certFile,
+		keyFile:    keyFile,
+		interval:   defaultCertCheckInterval,
+		otelConfig: otelConfig,
+	}
+
+	certMod, keyMod, err := r.modTimes()
+	if err != nil {
+		return nil, err
+	}
+	if err := r.load(certMod, keyMod); err != nil {
+		return nil, err
+	}
+	r.lastCheck = time.Now()
+
+	return r, nil
+}
+
+// GetCertificate implements tls.Config.GetCertificate.
+func (r *certReloader) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if time.Since(r.lastCheck) >= r.interval {
+		r.lastCheck = time.Now()
+		r.reloadIfChanged()
+	}
+
+	return r.cert, nil
+}
+
+// reloadIfChanged reloads the certificate when either file's modification time
+// differs from the loaded one. The caller must hold r.mu.
+func (r *certReloader) reloadIfChanged() {
+	logger := otel.NewLogHelper(context.Background(), r.otelConfig, "github.com/jasoet/pkg/v2/server", "certReloader.reload")
+
+	certMod, keyMod, err := r.modTimes()
+	if err != nil {
+		logger.Error(err, "Failed to check TLS certificate files")
+		return
+	}
+	if certMod.Equal(r.certMod) && keyMod.Equal(r.keyMod) {
+		return
+	}
+
+	if err := r.load(certMod, keyMod); err != nil {
+		logger.Error(err, "Failed to reload TLS certificate, keeping the current one")
+		return
+	}
+	logger.Info("Reloaded TLS certificate", otel.F("certFile", r.certFile))
+}
+
+// load reads the key pair and records the modification times it was read at.
+func (r *certReloader) load(certMod, keyMod time.Time) error {
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return fmt.Errorf("failed to load TLS certificate %s: %w", r.certFile, err)
+	}
+
+	r.cert = &cert
+	r.certMod = certMod
+	r.keyMod = keyMod
+	return nil
+}
+
+// modTimes returns the modification times of the certificate and key files,
+// following symlinks so rotations that swap a link target are detected.
+func (r *certReloader) modTimes() (time.Time, time.Time, error) {
+	certInfo, err := os.Stat(r.certFile)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("failed to stat TLS certificate: %w", err)
+	}
+	keyInfo, err := os.Stat(r.keyFile)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("failed to stat TLS key: %w", err)
+	}
+	return certInfo.ModTime(), keyInfo.ModTime(), nil
+}
@@ -0,0 +1,144 @@
+package server
+
+import (
+	"bufio"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeTestCert writes a self-signed certificate for localhost with the given
+// common name to certFile and keyFile.
+func writeTestCert(t *testing.T, certFile, keyFile, commonName string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: commonName},
+		DNSNames:     []string{"localhost"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600))
+}
+
+// touch moves the modification time of files forward so a rewrite within the
+// filesystem's timestamp granularity is still detected.
+func touch(t *testing.T, files ...string) {
+	t.Helper()
+	future := time.Now().Add(time.Minute)
+	for _, f := range files {
+		require.NoError(t, os.Chtimes(f, future, future))
+	}
+}
+
+func dialTLS(t *testing.T, addr string) *tls.Conn {
+	t.Helper()
+	conn, err := tls.Dial("tcp", addr, &tls.Config{InsecureSkipVerify: true}) //nolint:gosec // self-signed test certificate
+	require.NoError(t, err)
+	return conn
+}
+
+func peerCommonName(conn *tls.Conn) string {
+	return conn.ConnectionState().PeerCertificates[0].Subject.CommonName
+}
+
+func TestTLSCertificateReload(t *testing.T) {
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "tls.crt")
+	keyFile := filepath.Join(dir, "tls.key")
+	writeTestCert(t, certFile, keyFile, "original")
+
+	server := newHTTPServer(NewConfig(WithTLS(certFile, keyFile)))
+	require.NoError(t, server.start())
+	defer func() { _ = server.stop() }()
+	server.certs.mu.Lock()
+	server.certs.interval = 0
+	server.certs.mu.Unlock()
+
+	addr := server.echo.Listener.Addr().String()
+
+	existing := dialTLS(t, addr)
+	defer existing.Close()
+	assert.Equal(t, "original", peerCommonName(existing))
+
+	writeTestCert(t, certFile, keyFile, "rotated")
+	touch(t, certFile, keyFile)
+
+	rotated := dialTLS(t, addr)
+	defer rotated.Close()
+	assert.Equal(t, "rotated", peerCommonName(rotated), "new connections should use the rotated certificate")
+
+	// The existing connection keeps its certificate and still serves requests
+	assert.Equal(t, "original", peerCommonName(existing))
+	req, err := http.NewRequest(http.MethodGet, "https://"+addr+"/health", nil)
+	require.NoError(t, err)
+	require.NoError(t, req.Write(existing))
+	resp, err := http.ReadResponse(bufio.NewReader(existing), req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestTLSCertificateReload_KeepsCertOnInvalidFiles(t *testing.T) {
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "tls.crt")
+	keyFile := filepath.Join(dir, "tls.key")
+	writeTestCert(t, certFile, keyFile, "original")
+
+	reloader, err := newCertReloader(certFile, keyFile, nil)
+	require.NoError(t, err)
+	reloader.interval = 0
+
+	// A half-finished rotation: the key no longer matches the certificate
+	otherDir := t.TempDir()
+	writeTestCert(t, filepath.Join(otherDir, "tls.crt"), keyFile, "other")
+	touch(t, keyFile)
+
+	cert, err := reloader.GetCertificate(nil)
+	require.NoError(t, err)
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	require.NoError(t, err)
+	assert.Equal(t, "original", leaf.Subject.CommonName)
+}
+
+func TestTLSConfigValidation(t *testing.T) {
+	t.Run("key file missing", func(t *testing.T) {
+		server := newHTTPServer(NewConfig(WithTLS("cert.pem", "")))
+		err := server.start()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "both CertFile and KeyFile are required")
+	})
+
+	t.Run("unreadable certificate", func(t *testing.T) {
+		dir := t.TempDir()
+		server := newHTTPServer(NewConfig(WithTLS(filepath.Join(dir, "missing.crt"), filepath.Join(dir, "missing.key"))))
+		err := server.start()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to stat TLS certificate")
+	})
+}
@@ -0,0 +1,247 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/labstack/echo/v4"
+)
+
+// webSocketWriteTimeout bounds each write so a stalled client cannot block
+// broadcasts or shutdown.
+const webSocketWriteTimeout = 10 * time.Second
+
+// WebSocketConn is a connection upgraded by a WebSocketHandler. Its methods are
+// safe for concurrent use.
+type WebSocketConn struct {
+	conn    *websocket.Conn
+	request *http.Request
+	writeMu sync.Mutex
+}
+
+// Request returns the HTTP request that was upgraded, e.g. to read query
+// parameters or authentication set by middleware.
+func (c *WebSocketConn) Request() *http.Request {
+	return c.request
+}
+
+// WriteMessage sends a message of the given type (websocket.TextMessage or
+// websocket.BinaryMessage) to the client.
+func (c *WebSocketConn) WriteMessage(messageType int, data []byte) error {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	if err := c.conn.SetWriteDeadline(time.Now().Add(webSocketWriteTimeout)); err != nil {
+		return err
+	}
+	return c.conn.WriteMessage(messageType, data)
+}
+
+// WriteJSON sends v encoded as JSON in a text message.
+func (c *WebSocketConn) WriteJSON(v any) error {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	if err := c.conn.SetWriteDeadline(time.Now().Add(webSocketWriteTimeout)); err != nil {
+		return err
+	}
+	return c.conn.WriteJSON(v)
+}
+
+// Close closes the underlying network connection without a close handshake.
+func (c *WebSocketConn) Close() error {
+	return c.conn.Close()
+}
+
+// closeGoingAway starts the close handshake with a "going away" status.
+func (c *WebSocketConn) closeGoingAway() {
+	msg := websocket.FormatCloseMessage(websocket.CloseGoingAway, "server shutting down")
+	_ = c.conn.WriteControl(websocket.CloseMessage, msg, time.Now().Add(time.Second))
+}
+
+// WebSocketMessageFunc is called for every message received on a connection.
+// messageType is websocket.TextMessage or websocket.BinaryMessage. Calls for one
+// connection are sequential.
+type WebSocketMessageFunc func(conn *WebSocketConn, messageType int, data []byte)
+
+// WebSocketOption configures a WebSocketHandler.
+type WebSocketOption func(*WebSocketHandler)
+
+// WithWebSocketCheckOrigin sets the function that accepts or rejects the
+// handshake based on the request Origin. By default only same-origin requests
+// (or requests without an Origin header) are accepted.
+func WithWebSocketCheckOrigin(check func(r *http.Request) bool) WebSocketOption {
+	return func(h *WebSocketHandler) { h.upgrader.CheckOrigin = check }
+}
+
+// WithWebSocketOnConnect sets a callback run for each new connection before any
+// message is read, e.g. to send an initial snapshot.
+func WithWebSocketOnConnect(fn func(conn *WebSocketConn)) WebSocketOption {
+	return func(h *WebSocketHandler) { h.onConnect = fn }
+}
+
+// WebSocketHandler upgrades requests to WebSocket connections and tracks them so
+// they can be broadcast to and closed on shutdown. Register it with WithWebSocket
+// so the server closes its connections within ShutdownTimeout, or mount Handle
+// on a route yourself and call Shutdown when stopping.
+//
+// Example:
+//
+//	updates := server.NewWebSocketHandler(nil)
+//	cfg := server.NewConfig(
+//	    server.WithPort(8080),
+//	    server.WithWebSocket("/ws/dashboard", updates),
+//	)
+//	go func() {
+//	    for stats := range statsCh {
+//	        payload, _ := json.Marshal(stats)
+//	        _ = updates.Broadcast(websocket.TextMessage, payload)
+//	    }
+//	}()
+type WebSocketHandler struct {
+	upgrader  websocket.Upgrader
+	onMessage WebSocketMessageFunc
+	onConnect func(conn *WebSocketConn)
+
+	mu       sync.Mutex
+	conns    map[*WebSocketConn]struct{}
+	active   sync.WaitGroup
+	shutdown bool
+}
+
+// NewWebSocketHandler creates a handler that passes received messages to
+// onMessage. onMessage may be nil for push-only endpoints; incoming messages
+// are then discarded.
+func NewWebSocketHandler(onMessage WebSocketMessageFunc, opts ...WebSocketOption) *WebSocketHandler {
+	h := &WebSocketHandler{
+		onMessage: onMessage,
+		conns:     make(map[*WebSocketConn]struct{}),
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
+}
+
+// Handle is the Echo handler that upgrades the request and serves the
+// connection until the client disconnects or the handler is shut down.
+// Requests arriving after Shutdown are rejected with 503 Service Unavailable.
+func (h *WebSocketHandler) Handle(c echo.Context) error {
+	h.mu.Lock()
+	shutdown := h.shutdown
+	h.mu.Unlock()
+	if shutdown {
+		return echo.NewHTTPError(http.StatusServiceUnavailable, "server is shutting down")
+	}
+
+	ws, err := h.upgrader.Upgrade(c.Response(), c.Request(), nil)
+	if err != nil {
+		// The upgrader has already written an HTTP error response
+		return nil
+	}
+	conn := &WebSocketConn{conn: ws, request: c.Request()}
+	defer func() { _ = ws.Close() }()
+
+	if !h.track(conn) {
+		conn.closeGoingAway()
+		return nil
+	}
+	defer h.untrack(conn)
+
+	if h.onConnect != nil {
+		h.onConnect(conn)
+	}
+
+	for {
+		messageType, data, err := ws.ReadMessage()
+		if err != nil {
+			// Close frames and network errors both end the connection
+			return nil
+		}
+		if h.onMessage != nil {
+			h.onMessage(conn, messageType, data)
+		}
+	}
+}
+
+// Broadcast sends a message to every open connection. Connections that fail to
+// receive it are closed, and their errors are returned joined.
+func (h *WebSocketHandler) Broadcast(messageType int, data []byte) error {
+	var errs []error
+	for _, conn := range h.snapshot() {
+		if err := conn.WriteMessage(messageType, data); err != nil {
+			_ = conn.Close()
+			errs = append(errs, fmt.Errorf("failed to write to %s: %w", conn.conn.RemoteAddr(), err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Len returns the number of open connections.
+func (h *WebSocketHandler) Len() int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return len(h.conns)
+}
+
+// Shutdown stops accepting connections, sends a "going away" close frame to
+// every open connection and waits for them to finish. Connections still open
+// when ctx is done are closed forcibly and ctx.Err() is returned.
+func (h *WebSocketHandler) Shutdown(ctx context.Context) error {
+	h.mu.Lock()
+	h.shutdown = true
+	h.mu.Unlock()
+
+	conns := h.snapshot()
+	for _, conn := range conns {
+		conn.closeGoingAway()
+	}
+
+	done := make(chan struct{})
+	go func() {
+		h.active.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		for _, conn := range h.snapshot() {
+			_ = conn.Close()
+		}
+		return ctx.Err()
+	}
+}
+
+// track registers conn unless the handler has been shut down.
+func (h *WebSocketHandler) track(conn *WebSocketConn) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.shutdown {
+		return false
+	}
+	h.conns[conn] = struct{}{}
+	h.active.Add(1)
+	return true
+}
+
+func (h *WebSocketHandler) untrack(conn *WebSocketConn) {
+	h.mu.Lock()
+	delete(h.conns, conn)
+	h.mu.Unlock()
+	h.active.Done()
+}
+
+func (h *WebSocketHandler) snapshot() []*WebSocketConn {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	conns := make([]*WebSocketConn, 0, len(h.conns))
+	for conn := range h.conns {
+		conns = append(conns, conn)
+	}
+	return conns
+}
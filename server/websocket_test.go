@@ -0,0 +1,164 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func dialWebSocket(t *testing.T, url string) *websocket.Conn {
+	t.Helper()
+	conn, resp, err := websocket.DefaultDialer.Dial(url, nil)
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	t.Cleanup(func() { _ = conn.Close() })
+	return conn
+}
+
+func readWebSocket(t *testing.T, conn *websocket.Conn) string {
+	t.Helper()
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(2*time.Second)))
+	_, data, err := conn.ReadMessage()
+	require.NoError(t, err)
+	return string(data)
+}
+
+func waitForConnections(t *testing.T, h *WebSocketHandler, n int) {
+	t.Helper()
+	require.Eventually(t, func() bool { return h.Len() == n }, 2*time.Second, 10*time.Millisecond)
+}
+
+func TestWebSocketHandler_EchoAndBroadcast(t *testing.T) {
+	echoHandler := func(conn *WebSocketConn, messageType int, data []byte) {
+		_ = conn.WriteMessage(messageType, append([]byte("echo: "), data...))
+	}
+	ws := NewWebSocketHandler(echoHandler)
+
+	e := echo.New()
+	e.GET("/ws", ws.Handle)
+	srv := httptest.NewServer(e)
+	defer srv.Close()
+	url := "ws" + strings.TrimPrefix(srv.URL, "http") + "/ws"
+
+	client1 := dialWebSocket(t, url)
+	client2 := dialWebSocket(t, url)
+	waitForConnections(t, ws, 2)
+
+	require.NoError(t, client1.WriteMessage(websocket.TextMessage, []byte("hello")))
+	assert.Equal(t, "echo: hello", readWebSocket(t, client1))
+
+	require.NoError(t, ws.Broadcast(websocket.TextMessage, []byte("update")))
+	assert.Equal(t, "update", readWebSocket(t, client1))
+	assert.Equal(t, "update", readWebSocket(t, client2))
+
+	require.NoError(t, client2.Close())
+	waitForConnections(t, ws, 1)
+}
+
+func TestWebSocketHandler_OnConnect(t *testing.T) {
+	ws := NewWebSocketHandler(nil, WithWebSocketOnConnect(func(conn *WebSocketConn) {
+		_ = conn.WriteJSON(map[string]string{"user": conn.Request().URL.Query().Get("user")})
+	}))
+
+	e := echo.New()
+	e.GET("/ws", ws.Handle)
+	srv := httptest.NewServer(e)
+	defer srv.Close()
+
+	client := dialWebSocket(t, "ws"+strings.TrimPrefix(srv.URL, "http")+"/ws?user=alice")
+	assert.JSONEq(t, `{"user":"alice"}`, readWebSocket(t, client))
+}
+
+func TestWebSocketHandler_CheckOrigin(t *testing.T) {
+	ws := NewWebSocketHandler(nil, WithWebSocketCheckOrigin(func(r *http.Request) bool {
+		return r.Header.Get("Origin") == "https://dashboard.example.com"
+	}))
+
+	e := echo.New()
+	e.GET("/ws", ws.Handle)
+	srv := httptest.NewServer(e)
+	defer srv.Close()
+	url := "ws" + strings.TrimPrefix(srv.URL, "http") + "/ws"
+
+	_, resp, err := websocket.DefaultDialer.Dial(url, http.Header{"Origin": {"https://evil.example.com"}})
+	require.Error(t, err)
+	require.NotNil(t, resp)
+	_ = resp.Body.Close()
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	conn, resp, err := websocket.DefaultDialer.Dial(url, http.Header{"Origin": {"https://dashboard.example.com"}})
+	require.NoError(t, err)
+	_ = resp.Body.Close()
+	_ = conn.Close()
+}
+
+func TestWebSocketHandler_ClosedOnServerShutdown(t *testing.T) {
+	ws := NewWebSocketHandler(nil)
+	config := NewConfig(
+		WithPort(0),
+		WithShutdownTimeout(2*time.Second),
+		WithWebSocket("/ws", ws),
+	)
+	server := newHTTPServer(config)
+	require.NoError(t, server.start())
+
+	url := "ws://" + server.echo.Listener.Addr().String() + "/ws"
+	closeErrs := make(chan error, 2)
+	for range 2 {
+		client := dialWebSocket(t, url)
+		// Reading answers the server's close frame, completing the handshake
+		go func() {
+			_, _, err := client.ReadMessage()
+			closeErrs <- err
+		}()
+	}
+	waitForConnections(t, ws, 2)
+
+	start := time.Now()
+	require.NoError(t, server.stop())
+	assert.Less(t, time.Since(start), time.Second, "shutdown should not wait for the timeout")
+	assert.Equal(t, 0, ws.Len())
+
+	for range 2 {
+		select {
+		case err := <-closeErrs:
+			assert.True(t, websocket.IsCloseError(err, websocket.CloseGoingAway), "expected going-away close, got %v", err)
+		case <-time.After(2 * time.Second):
+			t.Fatal("client connection was not closed")
+		}
+	}
+}
+
+func TestWebSocketHandler_ShutdownTimeoutForcesClose(t *testing.T) {
+	ws := NewWebSocketHandler(nil)
+
+	e := echo.New()
+	e.GET("/ws", ws.Handle)
+	srv := httptest.NewServer(e)
+	defer srv.Close()
+
+	// A client that never reads cannot answer the close handshake
+	dialWebSocket(t, "ws"+strings.TrimPrefix(srv.URL, "http")+"/ws")
+	waitForConnections(t, ws, 1)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	err := ws.Shutdown(ctx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	waitForConnections(t, ws, 0)
+
+	// New connections are rejected after shutdown
+	_, resp, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(srv.URL, "http")+"/ws", nil)
+	require.Error(t, err)
+	require.NotNil(t, resp)
+	_ = resp.Body.Close()
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+}
@@ -0,0 +1,155 @@
+package temporal
+
+import (
+	"fmt"
+	"time"
+
+	sdktemporal "go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+// ActivityOptionsBuilder builds validated workflow.ActivityOptions, so activity
+// timeouts and retry policies are configured the same way across a codebase.
+// Create one with NewActivityOptions; the zero value is not usable.
+type ActivityOptionsBuilder struct {
+	options workflow.ActivityOptions
+}
+
+// NewActivityOptions starts building activity options. At least one of
+// StartToClose or ScheduleToClose must be set before Build.
+//
+// Example:
+//
+//	options, err := temporal.NewActivityOptions().
+//	    StartToClose(time.Minute).
+//	    WithRetry(5, time.Second, 2.0).
+//	    HeartbeatTimeout(10 * time.Second).
+//	    Build()
+//	if err != nil {
+//	    return err
+//	}
+//	ctx = workflow.WithActivityOptions(ctx, options)
+func NewActivityOptions() *ActivityOptionsBuilder {
+	return &ActivityOptionsBuilder{}
+}
+
+// StartToClose sets the maximum time of a single activity attempt.
+func (b *ActivityOptionsBuilder) StartToClose(d time.Duration) *ActivityOptionsBuilder {
+	b.options.StartToCloseTimeout = d
+	return b
+}
+
+// ScheduleToClose sets the maximum time of the whole activity, including all
+// retry attempts.
+func (b *ActivityOptionsBuilder) ScheduleToClose(d time.Duration) *ActivityOptionsBuilder {
+	b.options.ScheduleToCloseTimeout = d
+	return b
+}
+
+// ScheduleToStart sets how long the activity may wait in the task queue before
+// a worker picks it up.
+func (b *ActivityOptionsBuilder) ScheduleToStart(d time.Duration) *ActivityOptionsBuilder {
+	b.options.ScheduleToStartTimeout = d
+	return b
+}
+
+// HeartbeatTimeout sets the maximum time between activity heartbeats.
+func (b *ActivityOptionsBuilder) HeartbeatTimeout(d time.Duration) *ActivityOptionsBuilder {
+	b.options.HeartbeatTimeout = d
+	return b
+}
+
+// TaskQueue runs the activity on taskQueue instead of the workflow's task queue.
+func (b *ActivityOptionsBuilder) TaskQueue(taskQueue string) *ActivityOptionsBuilder {
+	b.options.TaskQueue = taskQueue
+	return b
+}
+
+// WithRetry sets the retry policy: up to maxAttempts attempts (0 means
+// unlimited), waiting initialInterval before the first retry and multiplying
+// the wait by backoff after each one.
+func (b *ActivityOptionsBuilder) WithRetry(maxAttempts int32, initialInterval time.Duration, backoff float64) *ActivityOptionsBuilder {
+	policy := b.retryPolicy()
+	policy.MaximumAttempts = maxAttempts
+	policy.InitialInterval = initialInterval
+	policy.BackoffCoefficient = backoff
+	return b
+}
+
+// MaxRetryInterval caps the wait between retries.
+func (b *ActivityOptionsBuilder) MaxRetryInterval(d time.Duration) *ActivityOptionsBuilder {
+	b.retryPolicy().MaximumInterval = d
+	return b
+}
+
+// NonRetryableErrors lists application error types that fail the activity
+// without retrying.
+func (b *ActivityOptionsBuilder) NonRetryableErrors(errorTypes ...string) *ActivityOptionsBuilder {
+	policy := b.retryPolicy()
+	policy.NonRetryableErrorTypes = append(policy.NonRetryableErrorTypes, errorTypes...)
+	return b
+}
+
+// NoRetry runs the activity at most once.
+func (b *ActivityOptionsBuilder) NoRetry() *ActivityOptionsBuilder {
+	b.retryPolicy().MaximumAttempts = 1
+	return b
+}
+
+// Build validates the options and returns them. It fails when neither
+// StartToClose nor ScheduleToClose is set, when a timeout or retry setting is
+// negative, when StartToClose exceeds ScheduleToClose, when HeartbeatTimeout
+// exceeds the time an attempt may run, or when the retry backoff is below 1.
+func (b *ActivityOptionsBuilder) Build() (workflow.ActivityOptions, error) {
+	o := b.options
+
+	if o.StartToCloseTimeout < 0 || o.ScheduleToCloseTimeout < 0 || o.ScheduleToStartTimeout < 0 || o.HeartbeatTimeout < 0 {
+		return workflow.ActivityOptions{}, fmt.Errorf("activity timeouts must not be negative")
+	}
+	if o.StartToCloseTimeout == 0 && o.ScheduleToCloseTimeout == 0 {
+		return workflow.ActivityOptions{}, fmt.Errorf("either StartToClose or ScheduleToClose timeout is required")
+	}
+	if o.ScheduleToCloseTimeout > 0 && o.StartToCloseTimeout > o.ScheduleToCloseTimeout {
+		return workflow.ActivityOptions{}, fmt.Errorf("StartToClose timeout %v exceeds ScheduleToClose timeout %v", o.StartToCloseTimeout, o.ScheduleToCloseTimeout)
+	}
+	if limit := attemptLimit(o); o.HeartbeatTimeout > 0 && o.HeartbeatTimeout > limit {
+		return workflow.ActivityOptions{}, fmt.Errorf("heartbeat timeout %v exceeds activity timeout %v", o.HeartbeatTimeout, limit)
+	}
+
+	if p := o.RetryPolicy; p != nil {
+		if p.MaximumAttempts < 0 {
+			return workflow.ActivityOptions{}, fmt.Errorf("retry maximum attempts must not be negative")
+		}
+		if p.InitialInterval < 0 || p.MaximumInterval < 0 {
+			return workflow.ActivityOptions{}, fmt.Errorf("retry intervals must not be negative")
+		}
+		if p.BackoffCoefficient != 0 && p.BackoffCoefficient < 1 {
+			return workflow.ActivityOptions{}, fmt.Errorf("retry backoff coefficient must be at least 1, got %v", p.BackoffCoefficient)
+		}
+		if p.MaximumInterval > 0 && p.MaximumInterval < p.InitialInterval {
+			return workflow.ActivityOptions{}, fmt.Errorf("retry maximum interval %v is shorter than initial interval %v", p.MaximumInterval, p.InitialInterval)
+		}
+
+		policy := *p
+		policy.NonRetryableErrorTypes = append([]string(nil), p.NonRetryableErrorTypes...)
+		o.RetryPolicy = &policy
+	}
+
+	return o, nil
+}
+
+// retryPolicy returns the retry policy being built, creating it if needed.
+func (b *ActivityOptionsBuilder) retryPolicy() *sdktemporal.RetryPolicy {
+	if b.options.RetryPolicy == nil {
+		b.options.RetryPolicy = &sdktemporal.RetryPolicy{}
+	}
+	return b.options.RetryPolicy
+}
+
+// attemptLimit returns the longest a single attempt may run.
+func attemptLimit(o workflow.ActivityOptions) time.Duration {
+	if o.StartToCloseTimeout > 0 {
+		return o.StartToCloseTimeout
+	}
+	return o.ScheduleToCloseTimeout
+}
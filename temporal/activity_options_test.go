@@ -0,0 +1,123 @@
+package temporal
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sdktemporal "go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+func TestActivityOptionsBuilder(t *testing.T) {
+	t.Run("builds timeouts and retry policy", func(t *testing.T) {
+		options, err := NewActivityOptions().
+			StartToClose(time.Minute).
+			ScheduleToClose(10*time.Minute).
+			ScheduleToStart(30*time.Second).
+			HeartbeatTimeout(10*time.Second).
+			TaskQueue("io").
+			WithRetry(5, time.Second, 2.0).
+			MaxRetryInterval(30 * time.Second).
+			NonRetryableErrors("ValidationError").
+			Build()
+		require.NoError(t, err)
+
+		assert.Equal(t, workflow.ActivityOptions{
+			TaskQueue:              "io",
+			StartToCloseTimeout:    time.Minute,
+			ScheduleToCloseTimeout: 10 * time.Minute,
+			ScheduleToStartTimeout: 30 * time.Second,
+			HeartbeatTimeout:       10 * time.Second,
+			RetryPolicy: &sdktemporal.RetryPolicy{
+				MaximumAttempts:        5,
+				InitialInterval:        time.Second,
+				BackoffCoefficient:     2.0,
+				MaximumInterval:        30 * time.Second,
+				NonRetryableErrorTypes: []string{"ValidationError"},
+			},
+		}, options)
+	})
+
+	t.Run("schedule to close alone is enough", func(t *testing.T) {
+		options, err := NewActivityOptions().ScheduleToClose(time.Hour).Build()
+		require.NoError(t, err)
+		assert.Equal(t, time.Hour, options.ScheduleToCloseTimeout)
+		assert.Nil(t, options.RetryPolicy, "server default retry policy applies without WithRetry")
+	})
+
+	t.Run("no retry", func(t *testing.T) {
+		options, err := NewActivityOptions().StartToClose(time.Minute).NoRetry().Build()
+		require.NoError(t, err)
+		require.NotNil(t, options.RetryPolicy)
+		assert.Equal(t, int32(1), options.RetryPolicy.MaximumAttempts)
+	})
+
+	t.Run("built options do not share the retry policy", func(t *testing.T) {
+		builder := NewActivityOptions().StartToClose(time.Minute).WithRetry(3, time.Second, 2.0)
+		first, err := builder.Build()
+		require.NoError(t, err)
+
+		second, err := builder.WithRetry(10, time.Second, 2.0).Build()
+		require.NoError(t, err)
+		assert.Equal(t, int32(3), first.RetryPolicy.MaximumAttempts)
+		assert.Equal(t, int32(10), second.RetryPolicy.MaximumAttempts)
+	})
+}
+
+func TestActivityOptionsBuilder_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		builder *ActivityOptionsBuilder
+		wantErr string
+	}{
+		{
+			name:    "no start to close or schedule to close",
+			builder: NewActivityOptions().HeartbeatTimeout(time.Second),
+			wantErr: "either StartToClose or ScheduleToClose timeout is required",
+		},
+		{
+			name:    "negative timeout",
+			builder: NewActivityOptions().StartToClose(-time.Second),
+			wantErr: "activity timeouts must not be negative",
+		},
+		{
+			name:    "start to close exceeds schedule to close",
+			builder: NewActivityOptions().StartToClose(time.Hour).ScheduleToClose(time.Minute),
+			wantErr: "exceeds ScheduleToClose timeout",
+		},
+		{
+			name:    "heartbeat exceeds start to close",
+			builder: NewActivityOptions().StartToClose(time.Minute).HeartbeatTimeout(time.Hour),
+			wantErr: "heartbeat timeout 1h0m0s exceeds activity timeout 1m0s",
+		},
+		{
+			name:    "negative maximum attempts",
+			builder: NewActivityOptions().StartToClose(time.Minute).WithRetry(-1, time.Second, 2.0),
+			wantErr: "retry maximum attempts must not be negative",
+		},
+		{
+			name:    "negative initial interval",
+			builder: NewActivityOptions().StartToClose(time.Minute).WithRetry(3, -time.Second, 2.0),
+			wantErr: "retry intervals must not be negative",
+		},
+		{
+			name:    "backoff below one",
+			builder: NewActivityOptions().StartToClose(time.Minute).WithRetry(3, time.Second, 0.5),
+			wantErr: "retry backoff coefficient must be at least 1",
+		},
+		{
+			name:    "maximum interval below initial interval",
+			builder: NewActivityOptions().StartToClose(time.Minute).WithRetry(3, time.Minute, 2.0).MaxRetryInterval(time.Second),
+			wantErr: "retry maximum interval 1s is shorter than initial interval 1m0s",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.builder.Build()
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
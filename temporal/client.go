@@ -2,6 +2,7 @@ package temporal
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"time"
 
@@ -20,6 +21,74 @@ func NewClient(config *Config) (client.Client, error) {
 		otel.F("hostPort", config.HostPort),
 		otel.F("namespace", config.Namespace))
 
+	clientOption, err := newClientOptions(config)
+	if err != nil {
+		logger.Error(err, "Failed to build Temporal client options")
+		return nil, err
+	}
+
+	logger.Debug("Connecting to Temporal server")
+	c, err := client.Dial(clientOption)
+	if err != nil {
+		logger.Error(err, "Failed to connect to Temporal server")
+		return nil, err
+	}
+
+	logger.Debug("Successfully connected to Temporal server")
+	return c, nil
+}
+
+// defaultHealthCheckTimeout bounds a health check whose context has no deadline.
+const defaultHealthCheckTimeout = 5 * time.Second
+
+// Ping connects to the Temporal server described by the config, checks that
+// its frontend is serving and closes the connection again. It is meant for
+// startup or readiness checks when no client is kept around; with a
+// WorkflowManager use WorkflowManager.HealthCheck instead. If ctx has no
+// deadline, the check times out after 5s.
+func (c *Config) Ping(ctx context.Context) error {
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, defaultHealthCheckTimeout)
+		defer cancel()
+	}
+
+	clientOption, err := newClientOptions(c)
+	if err != nil {
+		return err
+	}
+
+	temporalClient, err := client.DialContext(ctx, clientOption)
+	if err != nil {
+		return fmt.Errorf("connect to temporal server: %w", err)
+	}
+	defer temporalClient.Close()
+
+	return checkHealth(ctx, temporalClient)
+}
+
+// checkHealth calls the gRPC health check of the Temporal frontend, applying
+// defaultHealthCheckTimeout if ctx has no deadline.
+func checkHealth(ctx context.Context, temporalClient client.Client) error {
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, defaultHealthCheckTimeout)
+		defer cancel()
+	}
+
+	if _, err := temporalClient.CheckHealth(ctx, &client.CheckHealthRequest{}); err != nil {
+		return fmt.Errorf("temporal health check: %w", err)
+	}
+	return nil
+}
+
+// newClientOptions builds the Temporal client options from the config, including
+// TLS/API-key authentication, OTel instrumentation, client interceptors and the
+// headers provider.
+func newClientOptions(config *Config) (client.Options, error) {
+	ctx := context.Background()
+	logger := otel.NewLogHelper(ctx, nil, "github.com/jasoet/pkg/v2/temporal", "temporal.newClientOptions")
+
 	// Create a zerolog logger for Temporal SDK's logger adapter
 	zerologLogger := zerolog.New(zerolog.ConsoleWriter{Out: os.Stderr, TimeFormat: time.RFC3339}).
 		With().
@@ -33,6 +102,22 @@ func NewClient(config *Config) (client.Client, error) {
 		Logger:    NewZerologAdapter(zerologLogger),
 	}
 
+	// Configure TLS if any TLS setting is provided; plaintext otherwise
+	tlsConfig, err := config.tlsConfig()
+	if err != nil {
+		return client.Options{}, fmt.Errorf("build TLS config: %w", err)
+	}
+	if tlsConfig != nil {
+		clientOption.ConnectionOptions.TLS = tlsConfig
+		logger.Debug("TLS enabled for Temporal client", otel.F("serverName", tlsConfig.ServerName))
+	}
+
+	// Configure API key credentials (TLS is auto-enabled by the SDK)
+	if config.APIKey != "" {
+		clientOption.Credentials = client.NewAPIKeyStaticCredentials(config.APIKey)
+		logger.Debug("API key credentials configured for Temporal client")
+	}
+
 	// Add OTel tracing interceptor if configured
 	if config.OTelConfig != nil && config.OTelConfig.IsTracingEnabled() {
 		tracerOpts := temporalotel.TracerOptions{
@@ -47,6 +132,17 @@ func NewClient(config *Config) (client.Client, error) {
 		}
 	}
 
+	// User interceptors run inside the tracing interceptor, so headers they add
+	// are written within the client span
+	if len(config.ClientInterceptors) > 0 {
+		clientOption.Interceptors = append(clientOption.Interceptors, config.ClientInterceptors...)
+		logger.Debug("Client interceptors added to Temporal client", otel.F("count", len(config.ClientInterceptors)))
+	}
+
+	if config.HeadersProvider != nil {
+		clientOption.HeadersProvider = config.HeadersProvider
+	}
+
 	// Add OTel metrics handler if configured
 	if config.OTelConfig != nil && config.OTelConfig.IsMetricsEnabled() {
 		meter := config.OTelConfig.GetMeter("temporal-sdk-go")
@@ -61,13 +157,5 @@ func NewClient(config *Config) (client.Client, error) {
 		logger.Debug("OTel metrics handler added to Temporal client")
 	}
 
-	logger.Debug("Connecting to Temporal server")
-	c, err := client.Dial(clientOption)
-	if err != nil {
-		logger.Error(err, "Failed to connect to Temporal server")
-		return nil, err
-	}
-
-	logger.Debug("Successfully connected to Temporal server")
-	return c, nil
+	return clientOption, nil
 }
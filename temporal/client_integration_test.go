@@ -4,6 +4,7 @@ package temporal
 
 import (
 	"context"
+	"sync"
 	"testing"
 	"time"
 
@@ -11,6 +12,8 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.temporal.io/api/enums/v1"
 	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/converter"
+	"go.temporal.io/sdk/interceptor"
 
 	"github.com/jasoet/pkg/v2/temporal/testcontainer"
 )
@@ -104,6 +107,104 @@ func TestClientOperations(t *testing.T) {
 	})
 }
 
+// recordingInterceptor records the client calls it intercepts and adds a
+// "tenant" header to started workflows.
+type recordingInterceptor struct {
+	interceptor.ClientInterceptorBase
+
+	mu      sync.Mutex
+	methods []string
+}
+
+func (r *recordingInterceptor) InterceptClient(next interceptor.ClientOutboundInterceptor) interceptor.ClientOutboundInterceptor {
+	i := &recordingOutboundInterceptor{recorder: r}
+	i.Next = next
+	return i
+}
+
+func (r *recordingInterceptor) record(method string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.methods = append(r.methods, method)
+}
+
+func (r *recordingInterceptor) recorded() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]string(nil), r.methods...)
+}
+
+type recordingOutboundInterceptor struct {
+	interceptor.ClientOutboundInterceptorBase
+	recorder *recordingInterceptor
+}
+
+func (i *recordingOutboundInterceptor) ExecuteWorkflow(ctx context.Context, in *interceptor.ClientExecuteWorkflowInput) (client.WorkflowRun, error) {
+	i.recorder.record("ExecuteWorkflow")
+	payload, err := converter.GetDefaultDataConverter().ToPayload("acme")
+	if err != nil {
+		return nil, err
+	}
+	interceptor.Header(ctx)["tenant"] = payload
+	return i.Next.ExecuteWorkflow(ctx, in)
+}
+
+func TestClientInterceptorsIntegration(t *testing.T) {
+	ctx := context.Background()
+
+	container, _, containerCleanup, err := testcontainer.Setup(
+		ctx,
+		testcontainer.ClientConfig{Namespace: "default"},
+		testcontainer.Options{Logger: t},
+	)
+	require.NoError(t, err, "Failed to setup temporal container")
+	defer containerCleanup()
+
+	recorder := &recordingInterceptor{}
+	var headerCalls sync.Map
+	config := (&Config{
+		HostPort:  container.HostPort(),
+		Namespace: "default",
+	}).
+		WithClientInterceptors(recorder).
+		WithHeadersProvider(HeadersProviderFunc(func(ctx context.Context) (map[string]string, error) {
+			headerCalls.Store("called", true)
+			return map[string]string{"x-team": "analytics"}, nil
+		}))
+
+	temporalClient, err := NewClient(config)
+	require.NoError(t, err)
+	defer temporalClient.Close()
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	// No worker is needed: starting a workflow only records it on the server
+	run, err := temporalClient.ExecuteWorkflow(ctx, client.StartWorkflowOptions{
+		ID:        "test-interceptor-workflow-" + time.Now().Format("20060102-150405.000"),
+		TaskQueue: "test-interceptor-queue",
+	}, "InterceptedWorkflow")
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"ExecuteWorkflow"}, recorder.recorded())
+	_, called := headerCalls.Load("called")
+	assert.True(t, called, "headers provider should be invoked for outgoing RPCs")
+
+	// The header added by the interceptor reaches the workflow
+	iter := temporalClient.GetWorkflowHistory(ctx, run.GetID(), run.GetRunID(), false, enums.HISTORY_EVENT_FILTER_TYPE_ALL_EVENT)
+	require.True(t, iter.HasNext())
+	event, err := iter.Next()
+	require.NoError(t, err)
+	started := event.GetWorkflowExecutionStartedEventAttributes()
+	require.NotNil(t, started)
+
+	var tenant string
+	require.NoError(t, converter.GetDefaultDataConverter().FromPayload(started.GetHeader().GetFields()["tenant"], &tenant))
+	assert.Equal(t, "acme", tenant)
+
+	require.NoError(t, temporalClient.TerminateWorkflow(ctx, run.GetID(), run.GetRunID(), "test cleanup"))
+}
+
 // TestWorkflowExecution tests basic workflow execution functionality
 func TestWorkflowExecution(t *testing.T) {
 	ctx := context.Background()
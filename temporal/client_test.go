@@ -1,11 +1,24 @@
 package temporal
 
 import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.temporal.io/sdk/interceptor"
 
 	"github.com/jasoet/pkg/v2/otel"
 )
@@ -75,3 +88,188 @@ func TestNewClient_OTelConfigTracingDisabled(t *testing.T) {
 		c.Close()
 	}
 }
+
+// writeTestCertificate writes a self-signed PEM certificate and key to a temp
+// directory and returns their paths.
+func writeTestCertificate(t *testing.T) (certPath, keyPath string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "temporal-test"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	certPath = filepath.Join(dir, "client.pem")
+	keyPath = filepath.Join(dir, "client.key")
+	require.NoError(t, os.WriteFile(certPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+	require.NoError(t, os.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600))
+	return certPath, keyPath
+}
+
+func TestNewClientOptions_Plaintext(t *testing.T) {
+	opts, err := newClientOptions(DefaultConfig())
+	require.NoError(t, err)
+
+	assert.Equal(t, "localhost:7233", opts.HostPort)
+	assert.Equal(t, "default", opts.Namespace)
+	assert.Nil(t, opts.ConnectionOptions.TLS)
+	assert.Nil(t, opts.Credentials)
+}
+
+func TestNewClientOptions_TLS(t *testing.T) {
+	certPath, keyPath := writeTestCertificate(t)
+
+	config := &Config{
+		HostPort:      "my-ns.tmprl.cloud:7233",
+		Namespace:     "my-ns",
+		TLSCertPath:   certPath,
+		TLSKeyPath:    keyPath,
+		TLSCACertPath: certPath,
+		ServerName:    "my-ns.tmprl.cloud",
+	}
+
+	opts, err := newClientOptions(config)
+	require.NoError(t, err)
+
+	tlsConfig := opts.ConnectionOptions.TLS
+	require.NotNil(t, tlsConfig)
+	assert.Len(t, tlsConfig.Certificates, 1)
+	assert.NotNil(t, tlsConfig.RootCAs)
+	assert.Equal(t, "my-ns.tmprl.cloud", tlsConfig.ServerName)
+	assert.Nil(t, opts.Credentials)
+}
+
+func TestNewClientOptions_ServerNameOnly(t *testing.T) {
+	opts, err := newClientOptions(&Config{HostPort: "localhost:7233", ServerName: "temporal.internal"})
+	require.NoError(t, err)
+
+	require.NotNil(t, opts.ConnectionOptions.TLS)
+	assert.Equal(t, "temporal.internal", opts.ConnectionOptions.TLS.ServerName)
+	assert.Empty(t, opts.ConnectionOptions.TLS.Certificates)
+}
+
+func TestNewClientOptions_APIKey(t *testing.T) {
+	opts, err := newClientOptions(&Config{HostPort: "my-ns.tmprl.cloud:7233", Namespace: "my-ns", APIKey: "secret"})
+	require.NoError(t, err)
+
+	assert.NotNil(t, opts.Credentials)
+	// TLS is enabled by the SDK when the API key credentials are applied
+	assert.Nil(t, opts.ConnectionOptions.TLS)
+}
+
+func TestNewClientOptions_InvalidTLS(t *testing.T) {
+	certPath, keyPath := writeTestCertificate(t)
+
+	tests := []struct {
+		name     string
+		config   *Config
+		errorMsg string
+	}{
+		{
+			name:     "missing key path",
+			config:   &Config{TLSCertPath: certPath},
+			errorMsg: "both TLS cert path and key path are required",
+		},
+		{
+			name:     "missing cert file",
+			config:   &Config{TLSCertPath: "missing.pem", TLSKeyPath: keyPath},
+			errorMsg: "load TLS key pair",
+		},
+		{
+			name:     "missing CA file",
+			config:   &Config{TLSCACertPath: "missing-ca.pem"},
+			errorMsg: "read TLS CA cert",
+		},
+		{
+			name:     "invalid CA file",
+			config:   &Config{TLSCACertPath: keyPath},
+			errorMsg: "no valid certificates found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newClientOptions(tt.config)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errorMsg)
+
+			// NewClient surfaces the same error without dialing
+			c, err := NewClient(tt.config)
+			assert.Error(t, err)
+			assert.Nil(t, c)
+		})
+	}
+}
+
+func TestConfigPing_Unreachable(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	hostPort := listener.Addr().String()
+	require.NoError(t, listener.Close())
+
+	config := &Config{HostPort: hostPort, Namespace: "default"}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	start := time.Now()
+	err = config.Ping(ctx)
+	assert.Error(t, err)
+	assert.Less(t, time.Since(start), 3*time.Second, "Ping should honor the context deadline")
+}
+
+func TestWorkflowManagerHealthCheck_Closed(t *testing.T) {
+	wm := &WorkflowManager{namespace: "default"}
+	wm.Close()
+
+	err := wm.HealthCheck(context.Background())
+	assert.ErrorContains(t, err, "closed")
+}
+
+func TestNewClientOptions_InterceptorsAndHeaders(t *testing.T) {
+	first := &interceptor.ClientInterceptorBase{}
+	second := &interceptor.ClientInterceptorBase{}
+	provider := HeadersProviderFunc(func(ctx context.Context) (map[string]string, error) {
+		return map[string]string{"authorization": "Bearer token"}, nil
+	})
+
+	config := DefaultConfig().
+		WithClientInterceptors(first).
+		WithClientInterceptors(second).
+		WithHeadersProvider(provider)
+	config.OTelConfig = otel.NewConfig("test").WithTracerProvider(sdktrace.NewTracerProvider())
+
+	opts, err := newClientOptions(config)
+	require.NoError(t, err)
+
+	// The tracing interceptor wraps the user interceptors, which keep their order
+	require.Len(t, opts.Interceptors, 3)
+	assert.Same(t, first, opts.Interceptors[1])
+	assert.Same(t, second, opts.Interceptors[2])
+
+	require.NotNil(t, opts.HeadersProvider)
+	headers, err := opts.HeadersProvider.GetHeaders(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"authorization": "Bearer token"}, headers)
+}
+
+func TestNewClientOptions_NoInterceptors(t *testing.T) {
+	opts, err := newClientOptions(DefaultConfig())
+	require.NoError(t, err)
+
+	assert.Empty(t, opts.Interceptors)
+	assert.Nil(t, opts.HeadersProvider)
+}
@@ -1,13 +1,83 @@
 package temporal
 
 import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"strings"
+
+	"go.temporal.io/sdk/interceptor"
+
 	"github.com/jasoet/pkg/v2/otel"
 )
 
+// Config holds the connection settings for a Temporal client.
 type Config struct {
-	HostPort   string       `yaml:"hostPort" mapstructure:"hostPort"`
-	Namespace  string       `yaml:"namespace" mapstructure:"namespace"`
+	HostPort  string `yaml:"hostPort" mapstructure:"hostPort"`
+	Namespace string `yaml:"namespace" mapstructure:"namespace"`
+
+	// TLS settings for mTLS connections (e.g. Temporal Cloud). When all are empty
+	// the client connects in plaintext.
+	TLSCertPath   string `yaml:"tlsCertPath" mapstructure:"tlsCertPath"`
+	TLSKeyPath    string `yaml:"tlsKeyPath" mapstructure:"tlsKeyPath"`
+	TLSCACertPath string `yaml:"tlsCACertPath" mapstructure:"tlsCACertPath"`
+	ServerName    string `yaml:"serverName" mapstructure:"serverName"`
+
+	// APIKey authenticates with an API key instead of (or in addition to) mTLS.
+	// TLS is enabled automatically when an API key is set.
+	APIKey string `yaml:"apiKey" mapstructure:"apiKey"`
+
 	OTelConfig *otel.Config `yaml:"-" mapstructure:"-"`
+
+	// ClientInterceptors are installed on every client created from the config,
+	// after the OTel tracing interceptor. Use them to add workflow headers,
+	// inject auth tokens or record calls.
+	ClientInterceptors []interceptor.ClientInterceptor `yaml:"-" mapstructure:"-"`
+
+	// HeadersProvider supplies gRPC metadata sent with every outgoing RPC to the
+	// Temporal server, e.g. for an authenticating proxy in front of it.
+	HeadersProvider HeadersProvider `yaml:"-" mapstructure:"-"`
+}
+
+// HeadersProvider returns the gRPC metadata to send with an outgoing RPC. It is
+// called for every request, so implementations can refresh short-lived tokens.
+// It has the same method set as the Temporal SDK's client HeadersProvider.
+type HeadersProvider interface {
+	GetHeaders(ctx context.Context) (map[string]string, error)
+}
+
+// HeadersProviderFunc adapts a function to a HeadersProvider.
+//
+// Example:
+//
+//	cfg.WithHeadersProvider(temporal.HeadersProviderFunc(func(ctx context.Context) (map[string]string, error) {
+//	    token, err := tokens.Get(ctx)
+//	    if err != nil {
+//	        return nil, err
+//	    }
+//	    return map[string]string{"authorization": "Bearer " + token}, nil
+//	}))
+type HeadersProviderFunc func(ctx context.Context) (map[string]string, error)
+
+// GetHeaders calls f.
+func (f HeadersProviderFunc) GetHeaders(ctx context.Context) (map[string]string, error) {
+	return f(ctx)
+}
+
+// WithClientInterceptors appends client interceptors and returns the config for
+// chaining. Interceptors run in the order given.
+func (c *Config) WithClientInterceptors(interceptors ...interceptor.ClientInterceptor) *Config {
+	c.ClientInterceptors = append(c.ClientInterceptors, interceptors...)
+	return c
+}
+
+// WithHeadersProvider sets the provider of gRPC metadata for outgoing RPCs and
+// returns the config for chaining.
+func (c *Config) WithHeadersProvider(provider HeadersProvider) *Config {
+	c.HeadersProvider = provider
+	return c
 }
 
 // DefaultConfig returns a Config with sensible defaults. It is a pure factory
@@ -18,3 +88,103 @@ func DefaultConfig() *Config {
 		Namespace: "default",
 	}
 }
+
+// ConfigFromEnv returns DefaultConfig overridden by the environment variables
+// that are set and non-empty:
+//
+//   - TEMPORAL_HOST: HostPort
+//   - TEMPORAL_NAMESPACE: Namespace
+//   - TEMPORAL_TLS_CERT, TEMPORAL_TLS_KEY: TLSCertPath, TLSKeyPath
+//   - TEMPORAL_TLS_CA: TLSCACertPath
+//   - TEMPORAL_TLS_SERVER_NAME: ServerName
+//   - TEMPORAL_API_KEY: APIKey
+//
+// The TLS and API key variable names match those of the Temporal CLI.
+//
+// Like DefaultConfig it performs no I/O beyond reading the environment; call
+// Validate to check the result.
+//
+// Example:
+//
+//	cfg := temporal.ConfigFromEnv()
+//	cfg.OTelConfig = otelConfig
+//	if err := cfg.Validate(); err != nil {
+//	    return err
+//	}
+//	c, err := temporal.NewClient(cfg)
+func ConfigFromEnv() *Config {
+	config := DefaultConfig()
+
+	fields := []struct {
+		env   string
+		field *string
+	}{
+		{"TEMPORAL_HOST", &config.HostPort},
+		{"TEMPORAL_NAMESPACE", &config.Namespace},
+		{"TEMPORAL_TLS_CERT", &config.TLSCertPath},
+		{"TEMPORAL_TLS_KEY", &config.TLSKeyPath},
+		{"TEMPORAL_TLS_CA", &config.TLSCACertPath},
+		{"TEMPORAL_TLS_SERVER_NAME", &config.ServerName},
+		{"TEMPORAL_API_KEY", &config.APIKey},
+	}
+	for _, f := range fields {
+		if value := os.Getenv(f.env); value != "" {
+			*f.field = value
+		}
+	}
+
+	return config
+}
+
+// Validate checks that the config has a host and namespace and that the mTLS
+// certificate and key are set together.
+func (c *Config) Validate() error {
+	if strings.TrimSpace(c.HostPort) == "" {
+		return fmt.Errorf("hostPort is required")
+	}
+	if strings.TrimSpace(c.Namespace) == "" {
+		return fmt.Errorf("namespace is required")
+	}
+	if (c.TLSCertPath == "") != (c.TLSKeyPath == "") {
+		return fmt.Errorf("both TLS cert path and key path are required for mTLS")
+	}
+	return nil
+}
+
+// tlsConfig builds the TLS configuration from the certificate paths and server
+// name. It returns nil when no TLS setting is configured.
+func (c *Config) tlsConfig() (*tls.Config, error) {
+	if c.TLSCertPath == "" && c.TLSKeyPath == "" && c.TLSCACertPath == "" && c.ServerName == "" {
+		return nil, nil
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: c.ServerName,
+	}
+
+	if c.TLSCertPath != "" || c.TLSKeyPath != "" {
+		if c.TLSCertPath == "" || c.TLSKeyPath == "" {
+			return nil, fmt.Errorf("both TLS cert path and key path are required for mTLS")
+		}
+		cert, err := tls.LoadX509KeyPair(c.TLSCertPath, c.TLSKeyPath)
+		if err != nil {
+			return nil, fmt.Errorf("load TLS key pair: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	if c.TLSCACertPath != "" {
+		caPEM, err := os.ReadFile(c.TLSCACertPath)
+		if err != nil {
+			return nil, fmt.Errorf("read TLS CA cert: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caPEM) {
+			return nil, fmt.Errorf("no valid certificates found in TLS CA cert %q", c.TLSCACertPath)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	return tlsConfig, nil
+}
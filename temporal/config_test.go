@@ -0,0 +1,91 @@
+package temporal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigFromEnv(t *testing.T) {
+	t.Run("defaults without environment", func(t *testing.T) {
+		for _, key := range []string{
+			"TEMPORAL_HOST", "TEMPORAL_NAMESPACE", "TEMPORAL_TLS_CERT", "TEMPORAL_TLS_KEY",
+			"TEMPORAL_TLS_CA", "TEMPORAL_TLS_SERVER_NAME", "TEMPORAL_API_KEY",
+		} {
+			t.Setenv(key, "")
+		}
+
+		assert.Equal(t, DefaultConfig(), ConfigFromEnv())
+	})
+
+	t.Run("reads all variables", func(t *testing.T) {
+		t.Setenv("TEMPORAL_HOST", "my-ns.a1b2c.tmprl.cloud:7233")
+		t.Setenv("TEMPORAL_NAMESPACE", "my-ns.a1b2c")
+		t.Setenv("TEMPORAL_TLS_CERT", "/certs/client.pem")
+		t.Setenv("TEMPORAL_TLS_KEY", "/certs/client.key")
+		t.Setenv("TEMPORAL_TLS_CA", "/certs/ca.pem")
+		t.Setenv("TEMPORAL_TLS_SERVER_NAME", "my-ns.a1b2c.tmprl.cloud")
+		t.Setenv("TEMPORAL_API_KEY", "secret-key")
+
+		config := ConfigFromEnv()
+		assert.Equal(t, &Config{
+			HostPort:      "my-ns.a1b2c.tmprl.cloud:7233",
+			Namespace:     "my-ns.a1b2c",
+			TLSCertPath:   "/certs/client.pem",
+			TLSKeyPath:    "/certs/client.key",
+			TLSCACertPath: "/certs/ca.pem",
+			ServerName:    "my-ns.a1b2c.tmprl.cloud",
+			APIKey:        "secret-key",
+		}, config)
+		require.NoError(t, config.Validate())
+	})
+
+	t.Run("unset variables keep defaults", func(t *testing.T) {
+		t.Setenv("TEMPORAL_HOST", "")
+		t.Setenv("TEMPORAL_NAMESPACE", "production")
+
+		config := ConfigFromEnv()
+		assert.Equal(t, "localhost:7233", config.HostPort)
+		assert.Equal(t, "production", config.Namespace)
+	})
+}
+
+func TestConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  Config
+		wantErr string
+	}{
+		{
+			name:   "valid",
+			config: Config{HostPort: "localhost:7233", Namespace: "default"},
+		},
+		{
+			name:    "missing host",
+			config:  Config{Namespace: "default"},
+			wantErr: "hostPort is required",
+		},
+		{
+			name:    "blank namespace",
+			config:  Config{HostPort: "localhost:7233", Namespace: "  "},
+			wantErr: "namespace is required",
+		},
+		{
+			name:    "cert without key",
+			config:  Config{HostPort: "localhost:7233", Namespace: "default", TLSCertPath: "/certs/client.pem"},
+			wantErr: "both TLS cert path and key path are required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.wantErr)
+			}
+		})
+	}
+}
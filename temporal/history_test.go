@@ -0,0 +1,125 @@
+package temporal
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/sdk/client"
+)
+
+// historyClient is a client.Client that serves a fixed event history.
+type historyClient struct {
+	client.Client
+	events []*historypb.HistoryEvent
+	err    error
+}
+
+func (c *historyClient) GetWorkflowHistory(context.Context, string, string, bool, enums.HistoryEventFilterType) client.HistoryEventIterator {
+	return &historyIterator{events: c.events, err: c.err}
+}
+
+type historyIterator struct {
+	events []*historypb.HistoryEvent
+	err    error
+}
+
+func (it *historyIterator) HasNext() bool { return len(it.events) > 0 || it.err != nil }
+
+func (it *historyIterator) Next() (*historypb.HistoryEvent, error) {
+	if it.err != nil {
+		return nil, it.err
+	}
+	event := it.events[0]
+	it.events = it.events[1:]
+	return event, nil
+}
+
+func newHistoryTestManager(events []*historypb.HistoryEvent, err error) *WorkflowManager {
+	return &WorkflowManager{
+		client:    &historyClient{events: events, err: err},
+		namespace: "default",
+	}
+}
+
+func testHistoryEvents() []*historypb.HistoryEvent {
+	return []*historypb.HistoryEvent{
+		{EventId: 1, EventType: enums.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED},
+		{EventId: 2, EventType: enums.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED},
+		{EventId: 3, EventType: enums.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED},
+	}
+}
+
+// decodedEvent holds the fields of an exported event checked by the tests.
+type decodedEvent struct {
+	EventID   string `json:"eventId"`
+	EventType string `json:"eventType"`
+}
+
+func TestExportHistory_NDJSON(t *testing.T) {
+	wm := newHistoryTestManager(testHistoryEvents(), nil)
+
+	var buf bytes.Buffer
+	require.NoError(t, wm.ExportHistory(context.Background(), "wf-1", "", &buf))
+
+	var events []decodedEvent
+	scanner := bufio.NewScanner(&buf)
+	for scanner.Scan() {
+		var event decodedEvent
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &event))
+		events = append(events, event)
+	}
+	require.NoError(t, scanner.Err())
+
+	assert.Equal(t, []decodedEvent{
+		{EventID: "1", EventType: "EVENT_TYPE_WORKFLOW_EXECUTION_STARTED"},
+		{EventID: "2", EventType: "EVENT_TYPE_WORKFLOW_TASK_SCHEDULED"},
+		{EventID: "3", EventType: "EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED"},
+	}, events)
+}
+
+func TestExportHistory_JSONArrayWithFilter(t *testing.T) {
+	wm := newHistoryTestManager(testHistoryEvents(), nil)
+
+	var buf bytes.Buffer
+	err := wm.ExportHistory(context.Background(), "wf-1", "", &buf,
+		WithHistoryJSONArray(),
+		WithHistoryEventTypes(enums.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED),
+		WithHistoryEventTypes(enums.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED),
+	)
+	require.NoError(t, err)
+
+	var events []decodedEvent
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &events))
+	assert.Equal(t, []decodedEvent{
+		{EventID: "1", EventType: "EVENT_TYPE_WORKFLOW_EXECUTION_STARTED"},
+		{EventID: "3", EventType: "EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED"},
+	}, events)
+}
+
+func TestExportHistory_EmptyJSONArray(t *testing.T) {
+	wm := newHistoryTestManager(testHistoryEvents(), nil)
+
+	var buf bytes.Buffer
+	err := wm.ExportHistory(context.Background(), "wf-1", "", &buf,
+		WithHistoryJSONArray(),
+		WithHistoryEventTypes(enums.EVENT_TYPE_ACTIVITY_TASK_FAILED),
+	)
+	require.NoError(t, err)
+	assert.JSONEq(t, `[]`, buf.String())
+}
+
+func TestExportHistory_IteratorError(t *testing.T) {
+	wm := newHistoryTestManager(nil, errors.New("workflow not found"))
+
+	var buf bytes.Buffer
+	err := wm.ExportHistory(context.Background(), "missing", "", &buf)
+	assert.ErrorContains(t, err, `get workflow history "missing": workflow not found`)
+}
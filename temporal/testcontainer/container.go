@@ -5,6 +5,7 @@ package testcontainer
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/testcontainers/testcontainers-go"
@@ -40,6 +41,20 @@ type Options struct {
 	// to ensure Temporal is fully initialized.
 	// Default: 3 seconds
 	InitialWaitTime time.Duration
+
+	// LogTailLines is the number of trailing container log lines attached to the
+	// returned error when the container fails to start.
+	// Default: 50
+	LogTailLines int
+
+	// Verbose forwards container stdout/stderr to Logger as it arrives.
+	// Has no effect when Logger is nil.
+	Verbose bool
+
+	// ExternalHostPort is the address of an already running Temporal server
+	// (e.g. "temporal:7233"). When set, no container is started and the
+	// returned Container only reports this address; Terminate is a no-op.
+	ExternalHostPort string
 }
 
 // Container represents a running Temporal server test container.
@@ -51,6 +66,20 @@ type Container struct {
 // Start creates and starts a Temporal server container for testing.
 // It returns a Container instance that can be used to connect to the server.
 func Start(ctx context.Context, opts Options) (*Container, error) {
+	// Reuse an existing server instead of starting a container
+	if opts.ExternalHostPort != "" {
+		if opts.Logger != nil {
+			opts.Logger.Logf("Using external Temporal server at %s", opts.ExternalHostPort)
+		}
+		return &Container{hostPort: opts.ExternalHostPort}, nil
+	}
+
+	return start(ctx, opts, []string{"server", "start-dev", "--ip", "0.0.0.0"})
+}
+
+// start runs the container with the given command. It is separated from Start
+// so that tests can exercise failure paths with a custom command.
+func start(ctx context.Context, opts Options, cmd []string) (*Container, error) {
 	// Apply defaults
 	if opts.Image == "" {
 		opts.Image = "temporalio/temporal:latest"
@@ -61,6 +90,9 @@ func Start(ctx context.Context, opts Options) (*Container, error) {
 	if opts.InitialWaitTime == 0 {
 		opts.InitialWaitTime = 3 * time.Second
 	}
+	if opts.LogTailLines == 0 {
+		opts.LogTailLines = 50
+	}
 
 	// Build exposed ports list
 	exposedPorts := []string{"7233/tcp", "8233/tcp"}
@@ -71,15 +103,29 @@ func Start(ctx context.Context, opts Options) (*Container, error) {
 	req := testcontainers.ContainerRequest{
 		Image:        opts.Image,
 		ExposedPorts: exposedPorts,
-		Cmd:          []string{"server", "start-dev", "--ip", "0.0.0.0"},
+		Cmd:          cmd,
 		WaitingFor:   wait.ForListeningPort("7233/tcp").WithStartupTimeout(opts.StartupTimeout),
 	}
 
+	if opts.Verbose && opts.Logger != nil {
+		req.LogConsumerCfg = &testcontainers.LogConsumerConfig{
+			Consumers: []testcontainers.LogConsumer{&loggerConsumer{logger: opts.Logger}},
+		}
+	}
+
 	container, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
 		ContainerRequest: req,
 		Started:          true,
 	})
 	if err != nil {
+		// The container may have been created even though it failed to become
+		// ready; attach its output to the error and remove it.
+		if container != nil {
+			if tail := tailContainerLogs(container, opts.LogTailLines); tail != "" {
+				err = fmt.Errorf("%w\ncontainer logs (last %d lines):\n%s", err, opts.LogTailLines, tail)
+			}
+			_ = container.Terminate(context.Background())
+		}
 		return nil, fmt.Errorf("failed to start temporal container: %w", err)
 	}
 
@@ -119,7 +165,42 @@ func (c *Container) HostPort() string {
 	return c.hostPort
 }
 
+// IsExternal returns true if the Container points to an external Temporal
+// server rather than a container started by this package.
+func (c *Container) IsExternal() bool {
+	return c.Container == nil
+}
+
 // Terminate stops and removes the Temporal container.
+// It is a no-op for an external Temporal server.
 func (c *Container) Terminate(ctx context.Context) error {
+	if c.IsExternal() {
+		return nil
+	}
 	return c.Container.Terminate(ctx)
 }
+
+// loggerConsumer forwards container log lines to a Logger.
+type loggerConsumer struct {
+	logger Logger
+}
+
+// Accept implements testcontainers.LogConsumer.
+func (c *loggerConsumer) Accept(l testcontainers.Log) {
+	c.logger.Logf("[temporal %s] %s", l.LogType, strings.TrimRight(string(l.Content), "\n"))
+}
+
+// tailContainerLogs returns the last n lines of the container's stdout/stderr,
+// or an empty string if the logs cannot be read.
+func tailContainerLogs(container testcontainers.Container, n int) string {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	logs, err := container.Logs(ctx)
+	if err != nil {
+		return ""
+	}
+	defer logs.Close()
+
+	return tailLines(logs, n)
+}
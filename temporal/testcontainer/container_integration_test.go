@@ -0,0 +1,111 @@
+//go:build integration
+
+package testcontainer
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// startFakeTemporalServer serves only the gRPC health service, reporting the
+// Temporal workflow service as SERVING. That is enough for client.Dial and
+// CheckHealth, so external server tests do not need Docker.
+func startFakeTemporalServer(t *testing.T) string {
+	t.Helper()
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	healthServer := health.NewServer()
+	healthServer.SetServingStatus("temporal.api.workflowservice.v1.WorkflowService", healthpb.HealthCheckResponse_SERVING)
+
+	server := grpc.NewServer()
+	healthpb.RegisterHealthServer(server, healthServer)
+	go func() {
+		_ = server.Serve(lis)
+	}()
+	t.Cleanup(server.Stop)
+
+	return lis.Addr().String()
+}
+
+func TestStartExternal(t *testing.T) {
+	ctx := context.Background()
+
+	container, err := Start(ctx, Options{ExternalHostPort: "temporal:7233", Logger: t})
+	require.NoError(t, err)
+	require.NotNil(t, container)
+
+	assert.True(t, container.IsExternal(), "No container should be started for an external server")
+	assert.Nil(t, container.Container)
+	assert.Equal(t, "temporal:7233", container.HostPort())
+
+	// Terminate must be safe to call, even repeatedly
+	assert.NoError(t, container.Terminate(ctx))
+	assert.NoError(t, container.Terminate(ctx))
+}
+
+func TestSetupExternal(t *testing.T) {
+	ctx := context.Background()
+	hostPort := startFakeTemporalServer(t)
+
+	container, temporalClient, cleanup, err := Setup(
+		ctx,
+		ClientConfig{Namespace: "default"},
+		Options{ExternalHostPort: hostPort, Logger: t},
+	)
+	require.NoError(t, err)
+	require.NotNil(t, temporalClient)
+
+	assert.True(t, container.IsExternal())
+	assert.Equal(t, hostPort, container.HostPort())
+
+	_, err = temporalClient.CheckHealth(ctx, nil)
+	require.NoError(t, err)
+
+	// Cleanup must be safe and must not stop the external server
+	cleanup()
+
+	conn, err := grpc.NewClient(hostPort, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer func() { _ = conn.Close() }()
+	_, err = healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{})
+	assert.NoError(t, err, "External server should still be running after cleanup")
+}
+
+func TestStartFailureIncludesContainerLogs(t *testing.T) {
+	ctx := context.Background()
+
+	// The command prints a marker and exits, so the port wait strategy fails
+	_, err := start(ctx, Options{
+		Image:          "busybox:latest",
+		StartupTimeout: 15 * time.Second,
+		LogTailLines:   5,
+		Logger:         t,
+		Verbose:        true,
+	}, []string{"sh", "-c", "echo temporal-startup-failure-marker; exit 1"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "container logs (last 5 lines)")
+	assert.Contains(t, err.Error(), "temporal-startup-failure-marker")
+}
+
+func TestStartBadImageTag(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := Start(ctx, Options{
+		Image:          "temporalio/temporal:this-tag-does-not-exist",
+		StartupTimeout: 15 * time.Second,
+		Logger:         t,
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to start temporal container")
+}
@@ -75,6 +75,19 @@
 //   - Logger: Optional logger for container events (can be *testing.T)
 //   - ExtraPorts: Additional ports to expose
 //   - InitialWaitTime: Extra time to wait after startup (default: 3s)
+//   - LogTailLines: Container log lines attached to the error on startup failure (default: 50)
+//   - Verbose: Forward container output to Logger as it arrives
+//   - ExternalHostPort: Reuse an already running Temporal server instead of starting a container
+//
+// # Reusing an External Server
+//
+// In CI environments with a shared Temporal instance, set ExternalHostPort to skip Docker
+// entirely. The return signature is unchanged, so tests do not need to branch:
+//
+//	container, client, cleanup, err := testcontainer.Setup(ctx,
+//	    testcontainer.ClientConfig{Namespace: "default"},
+//	    testcontainer.Options{ExternalHostPort: os.Getenv("TEMPORAL_HOST_PORT")},
+//	)
 //
 // # Logging
 //
@@ -36,6 +36,8 @@ type ClientConfig struct {
 // 3. Returns a cleanup function that closes the client and terminates the container
 //
 // This function is ideal for integration tests where you need both container and client.
+// When opts.ExternalHostPort is set, no container is started: the client connects to
+// the given address and the cleanup function only closes the client.
 //
 // Example:
 //
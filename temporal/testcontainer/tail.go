@@ -0,0 +1,27 @@
+package testcontainer
+
+import (
+	"bufio"
+	"io"
+	"strings"
+)
+
+// tailLines returns the last n lines read from r, joined by newlines. Unlike
+// the rest of the package, this file has no integration build tag, so its unit
+// test runs without Docker.
+func tailLines(r io.Reader, n int) string {
+	if n <= 0 {
+		return ""
+	}
+
+	lines := make([]string, 0, n)
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		if len(lines) == n {
+			lines = lines[1:]
+		}
+		lines = append(lines, scanner.Text())
+	}
+
+	return strings.Join(lines, "\n")
+}
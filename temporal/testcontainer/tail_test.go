@@ -0,0 +1,17 @@
+package testcontainer
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTailLines(t *testing.T) {
+	input := "line1\nline2\nline3\nline4\n"
+
+	assert.Equal(t, "line3\nline4", tailLines(strings.NewReader(input), 2))
+	assert.Equal(t, "line1\nline2\nline3\nline4", tailLines(strings.NewReader(input), 10))
+	assert.Empty(t, tailLines(strings.NewReader(input), 0))
+	assert.Empty(t, tailLines(strings.NewReader(""), 5))
+}
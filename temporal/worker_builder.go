@@ -0,0 +1,196 @@
+package temporal
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"runtime"
+	"strings"
+	"sync"
+
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/worker"
+	"go.temporal.io/sdk/workflow"
+
+	"github.com/jasoet/pkg/v2/otel"
+)
+
+// WorkerOption configures the SDK worker options used by WorkerBuilder.
+type WorkerOption func(*worker.Options)
+
+// WithMaxConcurrentActivityExecutionSize limits how many activities the worker runs at once.
+func WithMaxConcurrentActivityExecutionSize(size int) WorkerOption {
+	return func(o *worker.Options) {
+		o.MaxConcurrentActivityExecutionSize = size
+	}
+}
+
+// WithMaxConcurrentWorkflowTaskExecutionSize limits how many workflow tasks the worker runs at once.
+func WithMaxConcurrentWorkflowTaskExecutionSize(size int) WorkerOption {
+	return func(o *worker.Options) {
+		o.MaxConcurrentWorkflowTaskExecutionSize = size
+	}
+}
+
+// WithWorkerOptions replaces the SDK worker options. Apply it before other
+// WorkerOptions, as it overwrites any values they set.
+func WithWorkerOptions(options worker.Options) WorkerOption {
+	return func(o *worker.Options) {
+		*o = options
+	}
+}
+
+// WorkerBuilder wraps an SDK worker for a single task queue. Registration
+// problems such as duplicate names or invalid function signatures, which the
+// SDK reports by panicking, are returned as errors instead.
+type WorkerBuilder struct {
+	taskQueue string
+	worker    worker.Worker
+
+	mu      sync.Mutex
+	started bool
+}
+
+// NewWorker creates a WorkerBuilder polling taskQueue with the given client.
+// The caller keeps ownership of the client.
+//
+// Example:
+//
+//	w := temporal.NewWorker(client, "orders",
+//	    temporal.WithMaxConcurrentActivityExecutionSize(10))
+//	if err := w.RegisterWorkflow(OrderWorkflow); err != nil {
+//	    return err
+//	}
+//	if err := w.RegisterActivity(&OrderActivities{}); err != nil {
+//	    return err
+//	}
+//	if err := w.Start(ctx); err != nil {
+//	    return err
+//	}
+//	defer w.Stop()
+func NewWorker(c client.Client, taskQueue string, opts ...WorkerOption) *WorkerBuilder {
+	var options worker.Options
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	return &WorkerBuilder{
+		taskQueue: taskQueue,
+		worker:    worker.New(c, taskQueue, options),
+	}
+}
+
+// RegisterWorkflow registers a workflow function under its function name.
+func (b *WorkerBuilder) RegisterWorkflow(wf interface{}) error {
+	return b.RegisterWorkflowWithOptions(wf, workflow.RegisterOptions{})
+}
+
+// RegisterWorkflowWithOptions registers a workflow function, e.g. under a custom name.
+func (b *WorkerBuilder) RegisterWorkflowWithOptions(wf interface{}, options workflow.RegisterOptions) error {
+	name := registrationName(wf, options.Name)
+	return b.register("workflow", name, func() {
+		b.worker.RegisterWorkflowWithOptions(wf, options)
+	})
+}
+
+// RegisterActivity registers an activity function, or every exported method of
+// an activity struct pointer.
+func (b *WorkerBuilder) RegisterActivity(a interface{}) error {
+	return b.RegisterActivityWithOptions(a, activity.RegisterOptions{})
+}
+
+// RegisterActivityWithOptions registers an activity function or struct. For
+// structs, options.Name is used as a prefix for each method name.
+func (b *WorkerBuilder) RegisterActivityWithOptions(a interface{}, options activity.RegisterOptions) error {
+	name := registrationName(a, options.Name)
+	return b.register("activity", name, func() {
+		b.worker.RegisterActivityWithOptions(a, options)
+	})
+}
+
+// register runs fn, converting SDK registration panics into errors.
+func (b *WorkerBuilder) register(kind, name string, fn func()) (err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.started {
+		return fmt.Errorf("cannot register %s %q on task queue %q: worker already started", kind, name, b.taskQueue)
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("failed to register %s %q on task queue %q: %v", kind, name, b.taskQueue, r)
+		}
+	}()
+
+	fn()
+	return nil
+}
+
+// Start starts polling the task queue. The ctx parameter is used for logging only.
+func (b *WorkerBuilder) Start(ctx context.Context) error {
+	logger := otel.NewLogHelper(ctx, nil, "github.com/jasoet/pkg/v2/temporal", "WorkerBuilder.Start")
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.started {
+		return fmt.Errorf("worker for task queue %q already started", b.taskQueue)
+	}
+
+	logger.Debug("Starting Temporal worker", otel.F("taskQueue", b.taskQueue))
+
+	if err := b.worker.Start(); err != nil {
+		logger.Error(err, "Failed to start Temporal worker", otel.F("taskQueue", b.taskQueue))
+		return fmt.Errorf("start worker for task queue %q: %w", b.taskQueue, err)
+	}
+	b.started = true
+
+	logger.Debug("Temporal worker started successfully", otel.F("taskQueue", b.taskQueue))
+	return nil
+}
+
+// Stop stops the worker, waiting for in-flight tasks up to the worker's stop timeout.
+// It is a no-op if the worker was not started.
+func (b *WorkerBuilder) Stop() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if !b.started {
+		return
+	}
+	b.worker.Stop()
+	b.started = false
+}
+
+// Worker returns the underlying SDK worker.
+func (b *WorkerBuilder) Worker() worker.Worker {
+	return b.worker
+}
+
+// TaskQueue returns the task queue the worker polls.
+func (b *WorkerBuilder) TaskQueue() string {
+	return b.taskQueue
+}
+
+// registrationName returns the name the SDK registers fn under, for error messages.
+func registrationName(fn interface{}, override string) string {
+	v := reflect.ValueOf(fn)
+	switch {
+	case v.Kind() == reflect.Func && override != "":
+		return override
+	case v.Kind() == reflect.Func:
+		fullName := runtime.FuncForPC(v.Pointer()).Name()
+		elements := strings.Split(fullName, ".")
+		return strings.TrimSuffix(elements[len(elements)-1], "-fm")
+	case v.IsValid():
+		t := v.Type()
+		if t.Kind() == reflect.Pointer {
+			t = t.Elem()
+		}
+		return override + t.Name()
+	default:
+		return override
+	}
+}
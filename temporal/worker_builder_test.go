@@ -0,0 +1,102 @@
+package temporal
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/workflow"
+)
+
+func builderTestWorkflow(ctx workflow.Context, name string) (string, error) {
+	return "Hello, " + name, nil
+}
+
+func builderTestActivity(ctx context.Context, name string) (string, error) {
+	return "Hello, " + name, nil
+}
+
+type builderTestActivities struct{}
+
+func (a *builderTestActivities) Greet(ctx context.Context, name string) (string, error) {
+	return "Hello, " + name, nil
+}
+
+func newTestWorkerBuilder(t *testing.T, opts ...WorkerOption) *WorkerBuilder {
+	t.Helper()
+
+	// A lazy client does not connect until it is used, so registration can be tested offline
+	c, err := client.NewLazyClient(client.Options{HostPort: "localhost:7233"})
+	require.NoError(t, err)
+	t.Cleanup(c.Close)
+
+	return NewWorker(c, "builder-test-queue", opts...)
+}
+
+func TestWorkerBuilder_Register(t *testing.T) {
+	b := newTestWorkerBuilder(t)
+
+	require.NoError(t, b.RegisterWorkflow(builderTestWorkflow))
+	require.NoError(t, b.RegisterWorkflowWithOptions(builderTestWorkflow, workflow.RegisterOptions{Name: "AliasWorkflow"}))
+	require.NoError(t, b.RegisterActivity(builderTestActivity))
+	require.NoError(t, b.RegisterActivity(&builderTestActivities{}))
+	assert.Equal(t, "builder-test-queue", b.TaskQueue())
+	assert.NotNil(t, b.Worker())
+}
+
+func TestWorkerBuilder_DuplicateWorkflow(t *testing.T) {
+	b := newTestWorkerBuilder(t)
+
+	require.NoError(t, b.RegisterWorkflow(builderTestWorkflow))
+	err := b.RegisterWorkflow(builderTestWorkflow)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `failed to register workflow "builderTestWorkflow" on task queue "builder-test-queue"`)
+	assert.Contains(t, err.Error(), "already registered")
+}
+
+func TestWorkerBuilder_DuplicateActivity(t *testing.T) {
+	b := newTestWorkerBuilder(t)
+
+	require.NoError(t, b.RegisterActivity(&builderTestActivities{}))
+	err := b.RegisterActivityWithOptions(&builderTestActivities{}, activity.RegisterOptions{})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `failed to register activity "builderTestActivities"`)
+	assert.Contains(t, err.Error(), "already registered")
+}
+
+func TestWorkerBuilder_InvalidWorkflow(t *testing.T) {
+	b := newTestWorkerBuilder(t)
+
+	// Workflows must take workflow.Context as their first parameter
+	err := b.RegisterWorkflow(func(name string) error { return nil })
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to register workflow")
+}
+
+func TestWorkerBuilder_StopWithoutStart(t *testing.T) {
+	b := newTestWorkerBuilder(t)
+	b.Stop() // must not panic
+}
+
+func TestWorkerOptions(t *testing.T) {
+	b := newTestWorkerBuilder(t,
+		WithMaxConcurrentActivityExecutionSize(5),
+		WithMaxConcurrentWorkflowTaskExecutionSize(3),
+	)
+	assert.NotNil(t, b.Worker())
+}
+
+func TestRegistrationName(t *testing.T) {
+	assert.Equal(t, "builderTestWorkflow", registrationName(builderTestWorkflow, ""))
+	assert.Equal(t, "Custom", registrationName(builderTestWorkflow, "Custom"))
+	assert.Equal(t, "Greet", registrationName((&builderTestActivities{}).Greet, ""))
+	assert.Equal(t, "builderTestActivities", registrationName(&builderTestActivities{}, ""))
+	assert.Equal(t, "Prefix_builderTestActivities", registrationName(&builderTestActivities{}, "Prefix_"))
+	assert.Equal(t, "builderTestActivities", registrationName((*builderTestActivities)(nil), ""))
+}
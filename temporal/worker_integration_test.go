@@ -399,3 +399,50 @@ func TestWorkerConfiguration(t *testing.T) {
 		assert.Len(t, wm.GetWorkers(), initialWorkerCount+len(taskQueues))
 	})
 }
+
+func TestWorkerBuilderEndToEnd(t *testing.T) {
+	ctx := context.Background()
+
+	_, temporalClient, cleanup, err := testcontainer.Setup(
+		ctx,
+		testcontainer.ClientConfig{Namespace: "default"},
+		testcontainer.Options{Logger: t},
+	)
+	require.NoError(t, err, "Failed to setup temporal container")
+	defer cleanup()
+
+	taskQueue := "test-worker-builder-queue"
+	w := NewWorker(temporalClient, taskQueue,
+		WithMaxConcurrentActivityExecutionSize(2),
+		WithMaxConcurrentWorkflowTaskExecutionSize(2),
+	)
+
+	require.NoError(t, w.RegisterWorkflow(SampleWorkflow))
+	require.NoError(t, w.RegisterActivity(SampleActivity))
+
+	// Duplicates are reported before the worker starts
+	err = w.RegisterWorkflow(SampleWorkflow)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "already registered")
+
+	require.NoError(t, w.Start(ctx))
+	defer w.Stop()
+
+	// Registration is rejected once the worker is running
+	err = w.RegisterActivity(FailingTestActivity)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "worker already started")
+
+	workflowCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	run, err := temporalClient.ExecuteWorkflow(workflowCtx, client.StartWorkflowOptions{
+		ID:        fmt.Sprintf("test-worker-builder-workflow-%d", time.Now().UnixNano()),
+		TaskQueue: taskQueue,
+	}, SampleWorkflow, "builder")
+	require.NoError(t, err)
+
+	var result string
+	require.NoError(t, run.Get(workflowCtx, &result))
+	assert.Equal(t, "Processed: builder", result)
+}
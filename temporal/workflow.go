@@ -2,15 +2,20 @@ package temporal
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"regexp"
 	"sort"
+	"sync/atomic"
 	"time"
 
 	"go.temporal.io/api/common/v1"
 	"go.temporal.io/api/enums/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
 	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/converter"
+	sdktemporal "go.temporal.io/sdk/temporal"
 
 	"github.com/jasoet/pkg/v2/otel"
 )
@@ -20,6 +25,7 @@ type WorkflowManager struct {
 	client     client.Client
 	ownsClient bool
 	namespace  string
+	closed     atomic.Bool
 }
 
 // WorkflowDetails contains detailed information about a workflow execution
@@ -113,6 +119,7 @@ func (wm *WorkflowManager) Close() {
 	logger := otel.NewLogHelper(ctx, nil, "github.com/jasoet/pkg/v2/temporal", "WorkflowManager.Close")
 
 	logger.Debug("Closing Workflow Manager")
+	wm.closed.Store(true)
 
 	if wm.ownsClient && wm.client != nil {
 		logger.Debug("Closing Temporal client")
@@ -128,6 +135,25 @@ func (wm *WorkflowManager) GetClient() client.Client {
 	return wm.client
 }
 
+// HealthCheck verifies that the Temporal frontend is reachable and serving, e.g.
+// for a readiness probe. If ctx has no deadline, the check times out after 5s.
+// It returns an error once the manager is closed.
+//
+// Example:
+//
+//	healthManager.RegisterCheck("temporal", func() grpc.HealthCheckResult {
+//	    if err := wm.HealthCheck(context.Background()); err != nil {
+//	        return grpc.HealthCheckResult{Status: grpc.HealthStatusDown, Error: err.Error()}
+//	    }
+//	    return grpc.HealthCheckResult{Status: grpc.HealthStatusUp}
+//	})
+func (wm *WorkflowManager) HealthCheck(ctx context.Context) error {
+	if wm.closed.Load() {
+		return fmt.Errorf("workflow manager is closed")
+	}
+	return checkHealth(ctx, wm.client)
+}
+
 // ListWorkflows lists workflows with pagination and optional query filter
 func (wm *WorkflowManager) ListWorkflows(ctx context.Context, pageSize int, query string) ([]*WorkflowDetails, error) {
 	logger := otel.NewLogHelper(ctx, nil, "github.com/jasoet/pkg/v2/temporal", "WorkflowManager.ListWorkflows")
@@ -150,25 +176,74 @@ func (wm *WorkflowManager) ListWorkflows(ctx context.Context, pageSize int, quer
 
 	workflows := make([]*WorkflowDetails, 0, len(response.Executions))
 	for _, exec := range response.Executions {
-		details := &WorkflowDetails{
-			WorkflowID:    exec.Execution.WorkflowId,
-			RunID:         exec.Execution.RunId,
-			WorkflowType:  exec.Type.Name,
-			Status:        exec.Status,
-			StartTime:     exec.StartTime.AsTime(),
-			HistoryLength: exec.HistoryLength,
+		workflows = append(workflows, newWorkflowDetails(exec))
+	}
+
+	logger.Debug("Workflows listed successfully", otel.F("count", len(workflows)))
+	return workflows, nil
+}
+
+// ListAllWorkflows iterates over every workflow matching the visibility query,
+// transparently following next-page tokens. Iteration stops when all pages are
+// exhausted or when yield returns false. An empty query matches all workflows.
+func (wm *WorkflowManager) ListAllWorkflows(ctx context.Context, pageSize int, query string, yield func(*WorkflowDetails) bool) error {
+	logger := otel.NewLogHelper(ctx, nil, "github.com/jasoet/pkg/v2/temporal", "WorkflowManager.ListAllWorkflows")
+
+	logger.Debug("Listing all workflows",
+		otel.F("pageSize", pageSize),
+		otel.F("query", query))
+
+	var nextPageToken []byte
+	count := 0
+	for {
+		request := &workflowservice.ListWorkflowExecutionsRequest{
+			Namespace:     wm.namespace,
+			PageSize:      int32(pageSize),
+			NextPageToken: nextPageToken,
+			Query:         query,
+		}
+
+		response, err := wm.client.WorkflowService().ListWorkflowExecutions(ctx, request)
+		if err != nil {
+			logger.Error(err, "Failed to list workflow executions", otel.F("yielded", count))
+			return fmt.Errorf("list workflow executions: %w", err)
 		}
 
-		if exec.CloseTime != nil {
-			details.CloseTime = exec.CloseTime.AsTime()
-			details.ExecutionTime = details.CloseTime.Sub(details.StartTime)
+		for _, exec := range response.Executions {
+			count++
+			if !yield(newWorkflowDetails(exec)) {
+				logger.Debug("Workflow iteration stopped by caller", otel.F("yielded", count))
+				return nil
+			}
 		}
 
-		workflows = append(workflows, details)
+		nextPageToken = response.NextPageToken
+		if len(nextPageToken) == 0 {
+			break
+		}
 	}
 
-	logger.Debug("Workflows listed successfully", otel.F("count", len(workflows)))
-	return workflows, nil
+	logger.Debug("All workflows listed successfully", otel.F("count", count))
+	return nil
+}
+
+// newWorkflowDetails converts a visibility record into WorkflowDetails
+func newWorkflowDetails(exec *workflowpb.WorkflowExecutionInfo) *WorkflowDetails {
+	details := &WorkflowDetails{
+		WorkflowID:    exec.Execution.WorkflowId,
+		RunID:         exec.Execution.RunId,
+		WorkflowType:  exec.Type.Name,
+		Status:        exec.Status,
+		StartTime:     exec.StartTime.AsTime(),
+		HistoryLength: exec.HistoryLength,
+	}
+
+	if exec.CloseTime != nil {
+		details.CloseTime = exec.CloseTime.AsTime()
+		details.ExecutionTime = details.CloseTime.Sub(details.StartTime)
+	}
+
+	return details
 }
 
 // DescribeWorkflow retrieves detailed information about a specific workflow execution
@@ -298,6 +373,76 @@ func (wm *WorkflowManager) TerminateWorkflow(ctx context.Context, workflowID, ru
 	return nil
 }
 
+// batchPageSize is the visibility page size used by the *ByQuery operations
+const batchPageSize = 100
+
+// CancelWorkflowsByQuery requests cancellation of every running workflow that
+// matches the visibility query, e.g. "WorkflowType='OrderWorkflow'". Matching
+// workflows that are already closed are skipped. It returns how many workflows
+// were canceled; failures for individual workflows are joined into the error.
+func (wm *WorkflowManager) CancelWorkflowsByQuery(ctx context.Context, query string) (int, error) {
+	return wm.applyByQuery(ctx, "WorkflowManager.CancelWorkflowsByQuery", query, func(wf *WorkflowDetails) error {
+		return wm.CancelWorkflow(ctx, wf.WorkflowID, wf.RunID)
+	})
+}
+
+// TerminateWorkflowsByQuery terminates every running workflow that matches the
+// visibility query with the given reason. Matching workflows that are already
+// closed are skipped. It returns how many workflows were terminated; failures
+// for individual workflows are joined into the error.
+func (wm *WorkflowManager) TerminateWorkflowsByQuery(ctx context.Context, query, reason string) (int, error) {
+	return wm.applyByQuery(ctx, "WorkflowManager.TerminateWorkflowsByQuery", query, func(wf *WorkflowDetails) error {
+		return wm.TerminateWorkflow(ctx, wf.WorkflowID, wf.RunID, reason)
+	})
+}
+
+// applyByQuery runs op on every running workflow matching query. Matches are
+// collected before op runs, so closing workflows does not shift the pages being read.
+func (wm *WorkflowManager) applyByQuery(ctx context.Context, function, query string, op func(*WorkflowDetails) error) (int, error) {
+	logger := otel.NewLogHelper(ctx, nil, "github.com/jasoet/pkg/v2/temporal", function)
+
+	// An empty query matches every workflow in the namespace
+	if query == "" {
+		return 0, fmt.Errorf("query is required")
+	}
+
+	var matches []*WorkflowDetails
+	err := wm.ListAllWorkflows(ctx, batchPageSize, query, func(wf *WorkflowDetails) bool {
+		if wf.Status == enums.WORKFLOW_EXECUTION_STATUS_RUNNING {
+			matches = append(matches, wf)
+		}
+		return true
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	logger.Debug("Applying operation to matching workflows",
+		otel.F("query", query),
+		otel.F("matches", len(matches)))
+
+	count := 0
+	var errs []error
+	for _, wf := range matches {
+		if err := op(wf); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		count++
+	}
+
+	if len(errs) > 0 {
+		err := errors.Join(errs...)
+		logger.Error(err, "Operation failed for some workflows",
+			otel.F("succeeded", count),
+			otel.F("failed", len(errs)))
+		return count, err
+	}
+
+	logger.Debug("Operation applied to matching workflows", otel.F("count", count))
+	return count, nil
+}
+
 // SignalWorkflow sends a signal to a running workflow
 func (wm *WorkflowManager) SignalWorkflow(ctx context.Context, workflowID, runID, signalName string, arg interface{}) error {
 	logger := otel.NewLogHelper(ctx, nil, "github.com/jasoet/pkg/v2/temporal", "WorkflowManager.SignalWorkflow")
@@ -321,8 +466,34 @@ func (wm *WorkflowManager) SignalWorkflow(ctx context.Context, workflowID, runID
 	return nil
 }
 
-// QueryWorkflow queries a running workflow for custom data
-func (wm *WorkflowManager) QueryWorkflow(ctx context.Context, workflowID, runID, queryType string, args ...interface{}) (interface{}, error) {
+// SignalWithStartWorkflow sends a signal to a workflow, starting it first if it is not running
+func (wm *WorkflowManager) SignalWithStartWorkflow(ctx context.Context, workflowID, signalName string, signalArg interface{},
+	options client.StartWorkflowOptions, workflow interface{}, workflowArgs ...interface{},
+) (client.WorkflowRun, error) {
+	logger := otel.NewLogHelper(ctx, nil, "github.com/jasoet/pkg/v2/temporal", "WorkflowManager.SignalWithStartWorkflow")
+
+	logger.Debug("Signaling workflow with start",
+		otel.F("workflowID", workflowID),
+		otel.F("signalName", signalName),
+		otel.F("taskQueue", options.TaskQueue))
+
+	run, err := wm.client.SignalWithStartWorkflow(ctx, workflowID, signalName, signalArg, options, workflow, workflowArgs...)
+	if err != nil {
+		logger.Error(err, "Failed to signal with start workflow",
+			otel.F("workflowID", workflowID),
+			otel.F("signalName", signalName))
+		return nil, fmt.Errorf("signal with start workflow %q with %q: %w", workflowID, signalName, err)
+	}
+
+	logger.Debug("Workflow signaled with start successfully",
+		otel.F("workflowID", workflowID),
+		otel.F("runID", run.GetRunID()))
+	return run, nil
+}
+
+// QueryWorkflow queries a running workflow for custom data. Use Get on the
+// returned value to decode the query result.
+func (wm *WorkflowManager) QueryWorkflow(ctx context.Context, workflowID, runID, queryType string, args ...interface{}) (converter.EncodedValue, error) {
 	logger := otel.NewLogHelper(ctx, nil, "github.com/jasoet/pkg/v2/temporal", "WorkflowManager.QueryWorkflow")
 
 	logger.Debug("Querying workflow",
@@ -344,6 +515,88 @@ func (wm *WorkflowManager) QueryWorkflow(ctx context.Context, workflowID, runID,
 	return result, nil
 }
 
+// UpdateWorkflow invokes the update handler updateName on a running workflow and
+// waits for it to complete. Use Get on the returned value to decode the handler's
+// result. An update rejected by its validator or failed by its handler is
+// returned as an error. Use UpdateWorkflowWithOptions to return at an earlier
+// stage or to set an update ID.
+//
+// Example:
+//
+//	value, err := wm.UpdateWorkflow(ctx, "order-123", "", "add-item", item)
+//	if err != nil {
+//	    return err
+//	}
+//	var total float64
+//	err = value.Get(&total)
+func (wm *WorkflowManager) UpdateWorkflow(ctx context.Context, workflowID, runID, updateName string, args ...interface{}) (converter.EncodedValue, error) {
+	handle, err := wm.UpdateWorkflowWithOptions(ctx, client.UpdateWorkflowOptions{
+		WorkflowID:   workflowID,
+		RunID:        runID,
+		UpdateName:   updateName,
+		Args:         args,
+		WaitForStage: client.WorkflowUpdateStageCompleted,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The handle of a completed update holds its outcome, so Get does not call the server
+	if err := handle.Get(ctx, nil); err != nil {
+		otel.NewLogHelper(ctx, nil, "github.com/jasoet/pkg/v2/temporal", "WorkflowManager.UpdateWorkflow").
+			Error(err, "Workflow update failed",
+				otel.F("workflowID", workflowID),
+				otel.F("updateName", updateName))
+		return nil, fmt.Errorf("update workflow %q with %q: %w", workflowID, updateName, err)
+	}
+	return updateResult{handle: handle}, nil
+}
+
+// UpdateWorkflowWithOptions sends a workflow update and returns once it reaches
+// options.WaitForStage, which defaults to client.WorkflowUpdateStageAccepted.
+// Call Get on the returned handle to wait for and decode the outcome. Setting
+// options.UpdateID makes retries of the same update idempotent.
+func (wm *WorkflowManager) UpdateWorkflowWithOptions(ctx context.Context, options client.UpdateWorkflowOptions) (client.WorkflowUpdateHandle, error) {
+	logger := otel.NewLogHelper(ctx, nil, "github.com/jasoet/pkg/v2/temporal", "WorkflowManager.UpdateWorkflowWithOptions")
+
+	if options.WaitForStage == client.WorkflowUpdateStageUnspecified {
+		options.WaitForStage = client.WorkflowUpdateStageAccepted
+	}
+
+	logger.Debug("Updating workflow",
+		otel.F("workflowID", options.WorkflowID),
+		otel.F("runID", options.RunID),
+		otel.F("updateName", options.UpdateName))
+
+	handle, err := wm.client.UpdateWorkflow(ctx, options)
+	if err != nil {
+		logger.Error(err, "Failed to update workflow",
+			otel.F("workflowID", options.WorkflowID),
+			otel.F("updateName", options.UpdateName))
+		return nil, fmt.Errorf("update workflow %q with %q: %w", options.WorkflowID, options.UpdateName, err)
+	}
+
+	logger.Debug("Workflow update sent successfully",
+		otel.F("workflowID", options.WorkflowID),
+		otel.F("updateName", options.UpdateName),
+		otel.F("updateID", handle.UpdateID()))
+	return handle, nil
+}
+
+// updateResult exposes the outcome of a completed update as a converter.EncodedValue.
+type updateResult struct {
+	handle client.WorkflowUpdateHandle
+}
+
+func (r updateResult) HasValue() bool {
+	var raw converter.RawValue
+	return r.handle.Get(context.Background(), &raw) == nil && raw.Payload() != nil
+}
+
+func (r updateResult) Get(valuePtr interface{}) error {
+	return r.handle.Get(context.Background(), valuePtr)
+}
+
 // ListWorkflowsByStatus lists workflows filtered by execution status
 func (wm *WorkflowManager) ListWorkflowsByStatus(ctx context.Context, status enums.WorkflowExecutionStatus, pageSize int) ([]*WorkflowDetails, error) {
 	logger := otel.NewLogHelper(ctx, nil, "github.com/jasoet/pkg/v2/temporal", "WorkflowManager.ListWorkflowsByStatus")
@@ -523,7 +776,85 @@ func (wm *WorkflowManager) GetRecentWorkflows(ctx context.Context, limit int) ([
 	return workflows, nil
 }
 
-// GetWorkflowResult retrieves the result of a completed workflow
+// TaskQueueInfo describes the workers polling a task queue
+type TaskQueueInfo struct {
+	Name    string
+	Type    enums.TaskQueueType
+	Pollers []PollerInfo
+}
+
+// PollerInfo describes a worker that recently polled a task queue
+type PollerInfo struct {
+	Identity       string
+	LastAccessTime time.Time
+	RatePerSecond  float64
+}
+
+// DescribeTaskQueue lists the workers that recently polled a task queue.
+// An empty Pollers list means no worker is serving the queue, which is the usual
+// cause of workflows or activities that never start.
+//
+// Example:
+//
+//	info, err := wm.DescribeTaskQueue(ctx, "orders", enums.TASK_QUEUE_TYPE_WORKFLOW)
+//	if err == nil && len(info.Pollers) == 0 {
+//	    // no worker is polling the "orders" queue
+//	}
+func (wm *WorkflowManager) DescribeTaskQueue(ctx context.Context, taskQueue string, taskQueueType enums.TaskQueueType) (TaskQueueInfo, error) {
+	logger := otel.NewLogHelper(ctx, nil, "github.com/jasoet/pkg/v2/temporal", "WorkflowManager.DescribeTaskQueue")
+
+	logger.Debug("Describing task queue",
+		otel.F("taskQueue", taskQueue),
+		otel.F("type", taskQueueType.String()))
+
+	resp, err := wm.client.DescribeTaskQueue(ctx, taskQueue, taskQueueType)
+	if err != nil {
+		logger.Error(err, "Failed to describe task queue",
+			otel.F("taskQueue", taskQueue))
+		return TaskQueueInfo{}, fmt.Errorf("describe task queue %q: %w", taskQueue, err)
+	}
+
+	info := TaskQueueInfo{
+		Name:    taskQueue,
+		Type:    taskQueueType,
+		Pollers: make([]PollerInfo, 0, len(resp.GetPollers())),
+	}
+	for _, poller := range resp.GetPollers() {
+		pi := PollerInfo{
+			Identity:      poller.GetIdentity(),
+			RatePerSecond: poller.GetRatePerSecond(),
+		}
+		if poller.GetLastAccessTime() != nil {
+			pi.LastAccessTime = poller.GetLastAccessTime().AsTime()
+		}
+		info.Pollers = append(info.Pollers, pi)
+	}
+
+	logger.Debug("Task queue described",
+		otel.F("taskQueue", taskQueue),
+		otel.F("pollers", len(info.Pollers)))
+	return info, nil
+}
+
+// WorkflowFailedError is returned when a workflow whose result was requested
+// did not complete successfully (failed, canceled, terminated or timed out).
+// Err holds the Temporal failure and can be inspected with errors.As, e.g. for
+// *temporal.ApplicationError or *temporal.CanceledError.
+type WorkflowFailedError struct {
+	WorkflowID string
+	RunID      string
+	Err        error
+}
+
+func (e *WorkflowFailedError) Error() string {
+	return fmt.Sprintf("workflow %q (run %q) failed: %v", e.WorkflowID, e.RunID, e.Err)
+}
+
+func (e *WorkflowFailedError) Unwrap() error { return e.Err }
+
+// GetWorkflowResult retrieves the result of a completed workflow.
+// It blocks until the workflow closes and returns a *WorkflowFailedError if the
+// workflow did not complete successfully.
 func (wm *WorkflowManager) GetWorkflowResult(ctx context.Context, workflowID, runID string, valuePtr interface{}) error {
 	logger := otel.NewLogHelper(ctx, nil, "github.com/jasoet/pkg/v2/temporal", "WorkflowManager.GetWorkflowResult")
 
@@ -536,6 +867,15 @@ func (wm *WorkflowManager) GetWorkflowResult(ctx context.Context, workflowID, ru
 	if err != nil {
 		logger.Error(err, "Failed to get workflow result",
 			otel.F("workflowID", workflowID))
+
+		var execErr *sdktemporal.WorkflowExecutionError
+		if errors.As(err, &execErr) {
+			return &WorkflowFailedError{
+				WorkflowID: workflowID,
+				RunID:      run.GetRunID(),
+				Err:        execErr.Unwrap(),
+			}
+		}
 		return fmt.Errorf("get workflow result %q: %w", workflowID, err)
 	}
 
@@ -543,3 +883,23 @@ func (wm *WorkflowManager) GetWorkflowResult(ctx context.Context, workflowID, ru
 		otel.F("workflowID", workflowID))
 	return nil
 }
+
+// GetWorkflowResultAs waits for a workflow to complete and decodes its result into T.
+// An empty runID targets the latest run. A *WorkflowFailedError is returned if
+// the workflow did not complete successfully.
+//
+// Example:
+//
+//	order, err := temporal.GetWorkflowResultAs[OrderResult](ctx, wm, "order-123", "")
+//	var failed *temporal.WorkflowFailedError
+//	if errors.As(err, &failed) {
+//	    // inspect failed.Err
+//	}
+func GetWorkflowResultAs[T any](ctx context.Context, wm *WorkflowManager, workflowID, runID string) (T, error) {
+	var result T
+	if err := wm.GetWorkflowResult(ctx, workflowID, runID, &result); err != nil {
+		var zero T
+		return zero, err
+	}
+	return result, nil
+}
@@ -3,7 +3,11 @@
 package temporal
 
 import (
+	"bufio"
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"testing"
 	"time"
@@ -12,6 +16,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.temporal.io/api/enums/v1"
 	"go.temporal.io/sdk/client"
+	sdktemporal "go.temporal.io/sdk/temporal"
 	"go.temporal.io/sdk/worker"
 	"go.temporal.io/sdk/workflow"
 
@@ -38,6 +43,72 @@ func SignalTestWorkflow(ctx workflow.Context) (string, error) {
 	return fmt.Sprintf("received: %s", signal), nil
 }
 
+// QuerySignalTestWorkflow accumulates signals until it receives "done" and
+// exposes the received signals through the "signals" query handler.
+func QuerySignalTestWorkflow(ctx workflow.Context) ([]string, error) {
+	received := []string{}
+	if err := workflow.SetQueryHandler(ctx, "signals", func() ([]string, error) {
+		return received, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	signalChan := workflow.GetSignalChannel(ctx, "add-signal")
+	for {
+		var signal string
+		signalChan.Receive(ctx, &signal)
+		if signal == "done" {
+			return received, nil
+		}
+		received = append(received, signal)
+	}
+}
+
+// CartUpdateTestWorkflow keeps a cart that the "add-item" update appends to,
+// returning the new item count. Empty items are rejected by the validator.
+// The workflow completes with the cart when it receives the "checkout" signal.
+func CartUpdateTestWorkflow(ctx workflow.Context) ([]string, error) {
+	cart := []string{}
+	if err := workflow.SetUpdateHandlerWithOptions(ctx, "add-item",
+		func(ctx workflow.Context, item string) (int, error) {
+			cart = append(cart, item)
+			return len(cart), nil
+		},
+		workflow.UpdateHandlerOptions{
+			Validator: func(ctx workflow.Context, item string) error {
+				if item == "" {
+					return errors.New("item must not be empty")
+				}
+				return nil
+			},
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	workflow.GetSignalChannel(ctx, "checkout").Receive(ctx, nil)
+	return cart, nil
+}
+
+// OrderSummary is a struct result used to test typed result decoding.
+type OrderSummary struct {
+	OrderID string
+	Items   []string
+	Total   float64
+}
+
+func OrderSummaryWorkflow(ctx workflow.Context, orderID string) (OrderSummary, error) {
+	return OrderSummary{
+		OrderID: orderID,
+		Items:   []string{"book", "pen"},
+		Total:   42.5,
+	}, nil
+}
+
+func FailingTestWorkflow(ctx workflow.Context) (OrderSummary, error) {
+	return OrderSummary{}, sdktemporal.NewNonRetryableApplicationError("order rejected", "OrderRejected", nil)
+}
+
 func TestWorkflowManagerCreation(t *testing.T) {
 	ctx := context.Background()
 
@@ -78,6 +149,43 @@ func TestWorkflowManagerCreation(t *testing.T) {
 	})
 }
 
+func TestWorkflowManagerHealthCheck(t *testing.T) {
+	ctx := context.Background()
+
+	container, temporalClient, cleanup, err := testcontainer.Setup(
+		ctx,
+		testcontainer.ClientConfig{Namespace: "default"},
+		testcontainer.Options{Logger: t},
+	)
+	require.NoError(t, err, "Failed to setup temporal container")
+	defer cleanup()
+
+	config := &Config{
+		HostPort:  container.HostPort(),
+		Namespace: "default",
+	}
+
+	t.Run("HealthyServer", func(t *testing.T) {
+		wm, err := NewWorkflowManager(temporalClient)
+		require.NoError(t, err)
+
+		assert.NoError(t, wm.HealthCheck(ctx))
+	})
+
+	t.Run("ClosedManager", func(t *testing.T) {
+		wm, err := NewWorkflowManager(config)
+		require.NoError(t, err)
+		require.NoError(t, wm.HealthCheck(ctx))
+
+		wm.Close()
+		assert.Error(t, wm.HealthCheck(ctx))
+	})
+
+	t.Run("ConfigPing", func(t *testing.T) {
+		assert.NoError(t, config.Ping(ctx))
+	})
+}
+
 func TestWorkflowManagerListOperations(t *testing.T) {
 	ctx := context.Background()
 
@@ -320,6 +428,58 @@ func TestWorkflowManagerDescribeOperations(t *testing.T) {
 		assert.NotNil(t, history.History)
 		assert.NotEmpty(t, history.History.Events)
 	})
+
+	t.Run("ExportHistory", func(t *testing.T) {
+		workflowID := fmt.Sprintf("test-export-history-workflow-%d", time.Now().UnixNano())
+		options := client.StartWorkflowOptions{
+			ID:        workflowID,
+			TaskQueue: taskQueue,
+		}
+
+		run, err := temporalClient.ExecuteWorkflow(ctx, options, SimpleTestWorkflow, "Grace")
+		require.NoError(t, err)
+
+		var result string
+		err = run.Get(ctx, &result)
+		require.NoError(t, err)
+
+		// NDJSON: one event per line, starting and ending with the workflow lifecycle events
+		var buf bytes.Buffer
+		err = wm.ExportHistory(ctx, workflowID, run.GetRunID(), &buf)
+		require.NoError(t, err)
+
+		var eventTypes []string
+		scanner := bufio.NewScanner(&buf)
+		for scanner.Scan() {
+			var event struct {
+				EventType string `json:"eventType"`
+			}
+			require.NoError(t, json.Unmarshal(scanner.Bytes(), &event))
+			eventTypes = append(eventTypes, event.EventType)
+		}
+		require.NoError(t, scanner.Err())
+		require.NotEmpty(t, eventTypes)
+		assert.Equal(t, "EVENT_TYPE_WORKFLOW_EXECUTION_STARTED", eventTypes[0])
+		assert.Equal(t, "EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED", eventTypes[len(eventTypes)-1])
+
+		// JSON array filtered to the lifecycle events
+		buf.Reset()
+		err = wm.ExportHistory(ctx, workflowID, "", &buf,
+			WithHistoryJSONArray(),
+			WithHistoryEventTypes(
+				enums.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED,
+				enums.EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED,
+			))
+		require.NoError(t, err)
+
+		var events []struct {
+			EventType string `json:"eventType"`
+		}
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &events))
+		require.Len(t, events, 2)
+		assert.Equal(t, "EVENT_TYPE_WORKFLOW_EXECUTION_STARTED", events[0].EventType)
+		assert.Equal(t, "EVENT_TYPE_WORKFLOW_EXECUTION_COMPLETED", events[1].EventType)
+	})
 }
 
 func TestWorkflowManagerSearchOperations(t *testing.T) {
@@ -632,3 +792,448 @@ func TestWorkflowManagerDashboardOperations(t *testing.T) {
 		assert.Equal(t, "Hello, Isabella!", actualResult)
 	})
 }
+
+func TestWorkflowManagerQueryAndSignal(t *testing.T) {
+	ctx := context.Background()
+
+	// Start Temporal container and get client
+	_, temporalClient, cleanup, err := testcontainer.Setup(
+		ctx,
+		testcontainer.ClientConfig{Namespace: "default"},
+		testcontainer.Options{Logger: t},
+	)
+	require.NoError(t, err, "Failed to setup temporal container")
+	defer cleanup()
+
+	wm, err := NewWorkflowManager(temporalClient)
+	require.NoError(t, err)
+
+	taskQueue := "test-workflow-query-signal-queue"
+	w := worker.New(temporalClient, taskQueue, worker.Options{})
+	w.RegisterWorkflow(QuerySignalTestWorkflow)
+
+	err = w.Start()
+	require.NoError(t, err)
+	defer w.Stop()
+
+	querySignals := func(t *testing.T, workflowID string) []string {
+		value, err := wm.QueryWorkflow(ctx, workflowID, "", "signals")
+		require.NoError(t, err)
+		var signals []string
+		require.NoError(t, value.Get(&signals))
+		return signals
+	}
+
+	t.Run("QueryAndSignalWorkflow", func(t *testing.T) {
+		workflowID := fmt.Sprintf("test-query-signal-workflow-%d", time.Now().UnixNano())
+		run, err := temporalClient.ExecuteWorkflow(ctx, client.StartWorkflowOptions{
+			ID:        workflowID,
+			TaskQueue: taskQueue,
+		}, QuerySignalTestWorkflow)
+		require.NoError(t, err)
+
+		// Query before any signal is sent
+		require.Eventually(t, func() bool {
+			value, err := wm.QueryWorkflow(ctx, workflowID, "", "signals")
+			return err == nil && value != nil
+		}, 10*time.Second, 200*time.Millisecond)
+		assert.Empty(t, querySignals(t, workflowID))
+
+		// Signal and observe the signal through the query handler
+		require.NoError(t, wm.SignalWorkflow(ctx, workflowID, "", "add-signal", "first"))
+		require.Eventually(t, func() bool {
+			return len(querySignals(t, workflowID)) == 1
+		}, 10*time.Second, 200*time.Millisecond)
+		assert.Equal(t, []string{"first"}, querySignals(t, workflowID))
+
+		// Complete the workflow
+		require.NoError(t, wm.SignalWorkflow(ctx, workflowID, "", "add-signal", "done"))
+		var result []string
+		require.NoError(t, run.Get(ctx, &result))
+		assert.Equal(t, []string{"first"}, result)
+	})
+
+	t.Run("SignalWithStartWorkflow", func(t *testing.T) {
+		workflowID := fmt.Sprintf("test-signal-with-start-workflow-%d", time.Now().UnixNano())
+		options := client.StartWorkflowOptions{
+			ID:        workflowID,
+			TaskQueue: taskQueue,
+		}
+
+		// First call starts the workflow and delivers the signal
+		run, err := wm.SignalWithStartWorkflow(ctx, workflowID, "add-signal", "one", options, QuerySignalTestWorkflow)
+		require.NoError(t, err)
+		require.NotNil(t, run)
+
+		// Second call signals the already-running workflow
+		run2, err := wm.SignalWithStartWorkflow(ctx, workflowID, "add-signal", "two", options, QuerySignalTestWorkflow)
+		require.NoError(t, err)
+		assert.Equal(t, run.GetRunID(), run2.GetRunID(), "Expected the running workflow to be signaled")
+
+		require.NoError(t, wm.SignalWorkflow(ctx, workflowID, "", "add-signal", "done"))
+		var result []string
+		require.NoError(t, run.Get(ctx, &result))
+		assert.Equal(t, []string{"one", "two"}, result)
+	})
+
+	t.Run("QueryUnknownWorkflow", func(t *testing.T) {
+		_, err := wm.QueryWorkflow(ctx, "non-existent-workflow", "", "signals")
+		assert.Error(t, err)
+	})
+}
+
+func TestWorkflowManagerUpdateWorkflow(t *testing.T) {
+	ctx := context.Background()
+
+	// Start Temporal container and get client
+	_, temporalClient, cleanup, err := testcontainer.Setup(
+		ctx,
+		testcontainer.ClientConfig{Namespace: "default"},
+		testcontainer.Options{Logger: t},
+	)
+	require.NoError(t, err, "Failed to setup temporal container")
+	defer cleanup()
+
+	wm, err := NewWorkflowManager(temporalClient)
+	require.NoError(t, err)
+
+	taskQueue := "test-workflow-update-queue"
+	w := worker.New(temporalClient, taskQueue, worker.Options{})
+	w.RegisterWorkflow(CartUpdateTestWorkflow)
+
+	err = w.Start()
+	require.NoError(t, err)
+	defer w.Stop()
+
+	startCart := func(t *testing.T) (string, client.WorkflowRun) {
+		workflowID := fmt.Sprintf("test-update-workflow-%d", time.Now().UnixNano())
+		run, err := temporalClient.ExecuteWorkflow(ctx, client.StartWorkflowOptions{
+			ID:        workflowID,
+			TaskQueue: taskQueue,
+		}, CartUpdateTestWorkflow)
+		require.NoError(t, err)
+		return workflowID, run
+	}
+
+	t.Run("UpdateReturnsHandlerResult", func(t *testing.T) {
+		workflowID, run := startCart(t)
+
+		value, err := wm.UpdateWorkflow(ctx, workflowID, "", "add-item", "apple")
+		require.NoError(t, err)
+		var count int
+		require.NoError(t, value.Get(&count))
+		assert.Equal(t, 1, count)
+
+		value, err = wm.UpdateWorkflow(ctx, workflowID, "", "add-item", "pear")
+		require.NoError(t, err)
+		require.NoError(t, value.Get(&count))
+		assert.Equal(t, 2, count)
+
+		// The updates changed workflow state
+		require.NoError(t, wm.SignalWorkflow(ctx, workflowID, "", "checkout", nil))
+		var cart []string
+		require.NoError(t, run.Get(ctx, &cart))
+		assert.Equal(t, []string{"apple", "pear"}, cart)
+	})
+
+	t.Run("RejectedUpdate", func(t *testing.T) {
+		workflowID, run := startCart(t)
+
+		_, err := wm.UpdateWorkflow(ctx, workflowID, "", "add-item", "")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "item must not be empty")
+
+		require.NoError(t, wm.SignalWorkflow(ctx, workflowID, "", "checkout", nil))
+		var cart []string
+		require.NoError(t, run.Get(ctx, &cart))
+		assert.Empty(t, cart, "rejected update must not change state")
+	})
+
+	t.Run("UpdateWithOptionsAcceptedStage", func(t *testing.T) {
+		workflowID, run := startCart(t)
+
+		handle, err := wm.UpdateWorkflowWithOptions(ctx, client.UpdateWorkflowOptions{
+			WorkflowID:   workflowID,
+			UpdateID:     "add-banana",
+			UpdateName:   "add-item",
+			Args:         []interface{}{"banana"},
+			WaitForStage: client.WorkflowUpdateStageAccepted,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "add-banana", handle.UpdateID())
+
+		var count int
+		require.NoError(t, handle.Get(ctx, &count))
+		assert.Equal(t, 1, count)
+
+		require.NoError(t, wm.SignalWorkflow(ctx, workflowID, "", "checkout", nil))
+		require.NoError(t, run.Get(ctx, nil))
+	})
+
+	t.Run("UpdateUnknownWorkflow", func(t *testing.T) {
+		_, err := wm.UpdateWorkflow(ctx, "non-existent-workflow", "", "add-item", "apple")
+		assert.Error(t, err)
+	})
+}
+
+func TestWorkflowManagerListAllWorkflows(t *testing.T) {
+	ctx := context.Background()
+
+	// Start Temporal container and get client
+	_, temporalClient, cleanup, err := testcontainer.Setup(
+		ctx,
+		testcontainer.ClientConfig{Namespace: "default"},
+		testcontainer.Options{Logger: t},
+	)
+	require.NoError(t, err, "Failed to setup temporal container")
+	defer cleanup()
+
+	wm, err := NewWorkflowManager(temporalClient)
+	require.NoError(t, err)
+
+	taskQueue := "test-workflow-list-all-queue"
+	w := worker.New(temporalClient, taskQueue, worker.Options{})
+	w.RegisterWorkflow(SimpleTestWorkflow)
+
+	err = w.Start()
+	require.NoError(t, err)
+	defer w.Stop()
+
+	// Start 30 workflows sharing a unique ID prefix
+	const total = 30
+	prefix := fmt.Sprintf("test-list-all-%d", time.Now().UnixNano())
+	for i := 0; i < total; i++ {
+		_, err := temporalClient.ExecuteWorkflow(ctx, client.StartWorkflowOptions{
+			ID:        fmt.Sprintf("%s-%02d", prefix, i),
+			TaskQueue: taskQueue,
+		}, SimpleTestWorkflow, fmt.Sprintf("User%d", i))
+		require.NoError(t, err)
+	}
+
+	query := fmt.Sprintf("WorkflowId STARTS_WITH '%s'", prefix)
+
+	// Visibility is eventually consistent; wait until all workflows are indexed
+	require.Eventually(t, func() bool {
+		count, err := wm.CountWorkflows(ctx, query)
+		return err == nil && count == total
+	}, 30*time.Second, 500*time.Millisecond)
+
+	t.Run("YieldsAllWorkflowsOnce", func(t *testing.T) {
+		seen := make(map[string]int)
+		err := wm.ListAllWorkflows(ctx, 10, query, func(wf *WorkflowDetails) bool {
+			seen[wf.WorkflowID]++
+			return true
+		})
+		require.NoError(t, err)
+
+		assert.Len(t, seen, total)
+		for id, n := range seen {
+			assert.Equal(t, 1, n, "Workflow %s should be yielded exactly once", id)
+		}
+	})
+
+	t.Run("StopsWhenYieldReturnsFalse", func(t *testing.T) {
+		yielded := 0
+		err := wm.ListAllWorkflows(ctx, 10, query, func(wf *WorkflowDetails) bool {
+			yielded++
+			return yielded < 15
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 15, yielded)
+	})
+}
+
+func TestWorkflowManagerGetWorkflowResultAs(t *testing.T) {
+	ctx := context.Background()
+
+	_, temporalClient, cleanup, err := testcontainer.Setup(
+		ctx,
+		testcontainer.ClientConfig{Namespace: "default"},
+		testcontainer.Options{Logger: t},
+	)
+	require.NoError(t, err, "Failed to setup temporal container")
+	defer cleanup()
+
+	wm, err := NewWorkflowManager(temporalClient)
+	require.NoError(t, err)
+
+	taskQueue := "test-workflow-result-queue"
+	w := worker.New(temporalClient, taskQueue, worker.Options{})
+	w.RegisterWorkflow(OrderSummaryWorkflow)
+	w.RegisterWorkflow(FailingTestWorkflow)
+
+	err = w.Start()
+	require.NoError(t, err)
+	defer w.Stop()
+
+	t.Run("DecodesStructResult", func(t *testing.T) {
+		workflowID := fmt.Sprintf("test-typed-result-workflow-%d", time.Now().UnixNano())
+		run, err := temporalClient.ExecuteWorkflow(ctx, client.StartWorkflowOptions{
+			ID:        workflowID,
+			TaskQueue: taskQueue,
+		}, OrderSummaryWorkflow, "order-123")
+		require.NoError(t, err)
+
+		result, err := GetWorkflowResultAs[OrderSummary](ctx, wm, workflowID, run.GetRunID())
+		require.NoError(t, err)
+		assert.Equal(t, OrderSummary{
+			OrderID: "order-123",
+			Items:   []string{"book", "pen"},
+			Total:   42.5,
+		}, result)
+	})
+
+	t.Run("FailedWorkflowReturnsTypedError", func(t *testing.T) {
+		workflowID := fmt.Sprintf("test-typed-result-failing-workflow-%d", time.Now().UnixNano())
+		run, err := temporalClient.ExecuteWorkflow(ctx, client.StartWorkflowOptions{
+			ID:        workflowID,
+			TaskQueue: taskQueue,
+		}, FailingTestWorkflow)
+		require.NoError(t, err)
+
+		result, err := GetWorkflowResultAs[OrderSummary](ctx, wm, workflowID, "")
+		require.Error(t, err)
+		assert.Equal(t, OrderSummary{}, result)
+
+		var failed *WorkflowFailedError
+		require.True(t, errors.As(err, &failed), "Expected WorkflowFailedError, got %T", err)
+		assert.Equal(t, workflowID, failed.WorkflowID)
+		assert.Equal(t, run.GetRunID(), failed.RunID)
+
+		var appErr *sdktemporal.ApplicationError
+		require.True(t, errors.As(err, &appErr), "Expected the application error to be wrapped")
+		assert.Equal(t, "OrderRejected", appErr.Type())
+	})
+}
+
+func TestWorkflowManagerDescribeTaskQueue(t *testing.T) {
+	ctx := context.Background()
+
+	_, temporalClient, cleanup, err := testcontainer.Setup(
+		ctx,
+		testcontainer.ClientConfig{Namespace: "default"},
+		testcontainer.Options{Logger: t},
+	)
+	require.NoError(t, err, "Failed to setup temporal container")
+	defer cleanup()
+
+	wm, err := NewWorkflowManager(temporalClient)
+	require.NoError(t, err)
+
+	t.Run("QueueWithoutWorkers", func(t *testing.T) {
+		info, err := wm.DescribeTaskQueue(ctx, "test-unpolled-queue", enums.TASK_QUEUE_TYPE_WORKFLOW)
+		require.NoError(t, err)
+		assert.Equal(t, "test-unpolled-queue", info.Name)
+		assert.Empty(t, info.Pollers)
+	})
+
+	t.Run("QueueWithWorker", func(t *testing.T) {
+		taskQueue := "test-describe-task-queue"
+		w := worker.New(temporalClient, taskQueue, worker.Options{Identity: "describe-test-worker"})
+		w.RegisterWorkflow(SimpleTestWorkflow)
+		require.NoError(t, w.Start())
+		defer w.Stop()
+
+		// Pollers are reported once the worker has issued its first long poll
+		var info TaskQueueInfo
+		require.Eventually(t, func() bool {
+			info, err = wm.DescribeTaskQueue(ctx, taskQueue, enums.TASK_QUEUE_TYPE_WORKFLOW)
+			return err == nil && len(info.Pollers) > 0
+		}, 30*time.Second, 500*time.Millisecond, "Expected a poller on %s", taskQueue)
+
+		assert.Equal(t, enums.TASK_QUEUE_TYPE_WORKFLOW, info.Type)
+		assert.Equal(t, "describe-test-worker", info.Pollers[0].Identity)
+		assert.False(t, info.Pollers[0].LastAccessTime.IsZero())
+	})
+}
+
+func TestWorkflowManagerBatchOperations(t *testing.T) {
+	ctx := context.Background()
+
+	_, temporalClient, cleanup, err := testcontainer.Setup(
+		ctx,
+		testcontainer.ClientConfig{Namespace: "default"},
+		testcontainer.Options{Logger: t},
+	)
+	require.NoError(t, err, "Failed to setup temporal container")
+	defer cleanup()
+
+	wm, err := NewWorkflowManager(temporalClient)
+	require.NoError(t, err)
+
+	taskQueue := "test-workflow-batch-queue"
+	w := worker.New(temporalClient, taskQueue, worker.Options{})
+	w.RegisterWorkflow(LongRunningWorkflow)
+	w.RegisterWorkflow(SignalTestWorkflow)
+
+	err = w.Start()
+	require.NoError(t, err)
+	defer w.Stop()
+
+	start := func(prefix string, wf interface{}, args ...interface{}) string {
+		workflowID := fmt.Sprintf("%s-%d", prefix, time.Now().UnixNano())
+		_, err := temporalClient.ExecuteWorkflow(ctx, client.StartWorkflowOptions{
+			ID:        workflowID,
+			TaskQueue: taskQueue,
+		}, wf, args...)
+		require.NoError(t, err)
+		return workflowID
+	}
+
+	var longRunningIDs, signalIDs []string
+	for i := 0; i < 3; i++ {
+		longRunningIDs = append(longRunningIDs, start("test-batch-long", LongRunningWorkflow, 300))
+	}
+	for i := 0; i < 2; i++ {
+		signalIDs = append(signalIDs, start("test-batch-signal", SignalTestWorkflow))
+	}
+
+	// Visibility is eventually consistent
+	require.Eventually(t, func() bool {
+		count, err := wm.CountWorkflows(ctx, "ExecutionStatus='Running'")
+		return err == nil && count == 5
+	}, 30*time.Second, 500*time.Millisecond, "Expected 5 running workflows to be visible")
+
+	assertStatus := func(ids []string, expected enums.WorkflowExecutionStatus) {
+		for _, id := range ids {
+			assert.Eventually(t, func() bool {
+				status, err := wm.GetWorkflowStatus(ctx, id, "")
+				return err == nil && status == expected
+			}, 30*time.Second, 500*time.Millisecond, "Expected %s to be %s", id, expected)
+		}
+	}
+
+	t.Run("RequiresQuery", func(t *testing.T) {
+		_, err := wm.CancelWorkflowsByQuery(ctx, "")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "query is required")
+	})
+
+	t.Run("CancelByType", func(t *testing.T) {
+		count, err := wm.CancelWorkflowsByQuery(ctx, "WorkflowType='LongRunningWorkflow'")
+		require.NoError(t, err)
+		assert.Equal(t, 3, count)
+
+		assertStatus(longRunningIDs, enums.WORKFLOW_EXECUTION_STATUS_CANCELED)
+		assertStatus(signalIDs, enums.WORKFLOW_EXECUTION_STATUS_RUNNING)
+	})
+
+	t.Run("TerminateByType", func(t *testing.T) {
+		count, err := wm.TerminateWorkflowsByQuery(ctx, "WorkflowType='SignalTestWorkflow'", "batch termination")
+		require.NoError(t, err)
+		assert.Equal(t, 2, count)
+
+		assertStatus(signalIDs, enums.WORKFLOW_EXECUTION_STATUS_TERMINATED)
+	})
+
+	t.Run("ClosedWorkflowsAreSkipped", func(t *testing.T) {
+		require.Eventually(t, func() bool {
+			count, err := wm.CountWorkflows(ctx, "ExecutionStatus='Running'")
+			return err == nil && count == 0
+		}, 30*time.Second, 500*time.Millisecond)
+
+		count, err := wm.TerminateWorkflowsByQuery(ctx, "WorkflowType='LongRunningWorkflow'", "already closed")
+		require.NoError(t, err)
+		assert.Equal(t, 0, count)
+	})
+}
@@ -0,0 +1,183 @@
+package temporal
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.temporal.io/sdk/client"
+
+	"github.com/jasoet/pkg/v2/otel"
+)
+
+// ScheduleDefinition describes a Temporal Schedule created through WorkflowManager.
+// Exactly one of CronExpression or Interval must be set.
+type ScheduleDefinition struct {
+	ScheduleID     string
+	CronExpression string        // Cron spec, e.g. "0 * * * *"
+	Interval       time.Duration // Fixed interval between runs
+	WorkflowType   string        // Registered workflow type name
+	WorkflowID     string        // Optional workflow ID (defaults to a generated ID)
+	TaskQueue      string
+	Args           []any
+}
+
+// ScheduleDetails contains summary information about a Temporal Schedule
+type ScheduleDetails struct {
+	ScheduleID      string
+	WorkflowType    string
+	Paused          bool
+	Note            string
+	NextActionTimes []time.Time
+}
+
+// validate ensures the schedule definition can be turned into schedule options
+func (d ScheduleDefinition) validate() error {
+	if d.ScheduleID == "" {
+		return fmt.Errorf("schedule ID is required")
+	}
+	if d.WorkflowType == "" {
+		return fmt.Errorf("workflow type is required")
+	}
+	if d.TaskQueue == "" {
+		return fmt.Errorf("task queue is required")
+	}
+	if d.CronExpression == "" && d.Interval <= 0 {
+		return fmt.Errorf("either cron expression or a positive interval is required")
+	}
+	if d.CronExpression != "" && d.Interval > 0 {
+		return fmt.Errorf("cron expression and interval are mutually exclusive")
+	}
+	return nil
+}
+
+// toScheduleOptions converts the definition into Temporal schedule options
+func (d ScheduleDefinition) toScheduleOptions() client.ScheduleOptions {
+	spec := client.ScheduleSpec{}
+	if d.CronExpression != "" {
+		spec.CronExpressions = []string{d.CronExpression}
+	} else {
+		spec.Intervals = []client.ScheduleIntervalSpec{{Every: d.Interval}}
+	}
+
+	return client.ScheduleOptions{
+		ID:   d.ScheduleID,
+		Spec: spec,
+		Action: &client.ScheduleWorkflowAction{
+			ID:        d.WorkflowID,
+			Workflow:  d.WorkflowType,
+			TaskQueue: d.TaskQueue,
+			Args:      d.Args,
+		},
+	}
+}
+
+// CreateSchedule creates a new Temporal Schedule from the given definition
+func (wm *WorkflowManager) CreateSchedule(ctx context.Context, def ScheduleDefinition) error {
+	logger := otel.NewLogHelper(ctx, nil, "github.com/jasoet/pkg/v2/temporal", "WorkflowManager.CreateSchedule")
+
+	logger.Debug("Creating schedule",
+		otel.F("scheduleID", def.ScheduleID),
+		otel.F("workflowType", def.WorkflowType),
+		otel.F("taskQueue", def.TaskQueue))
+
+	if err := def.validate(); err != nil {
+		return fmt.Errorf("invalid schedule definition: %w", err)
+	}
+
+	_, err := wm.client.ScheduleClient().Create(ctx, def.toScheduleOptions())
+	if err != nil {
+		logger.Error(err, "Failed to create schedule", otel.F("scheduleID", def.ScheduleID))
+		return fmt.Errorf("create schedule %q: %w", def.ScheduleID, err)
+	}
+
+	logger.Debug("Schedule created successfully", otel.F("scheduleID", def.ScheduleID))
+	return nil
+}
+
+// ListSchedules lists up to limit schedules in the namespace. A limit of zero
+// or less lists every schedule.
+func (wm *WorkflowManager) ListSchedules(ctx context.Context, limit int) ([]*ScheduleDetails, error) {
+	logger := otel.NewLogHelper(ctx, nil, "github.com/jasoet/pkg/v2/temporal", "WorkflowManager.ListSchedules")
+
+	logger.Debug("Listing schedules", otel.F("limit", limit))
+
+	// PageSize 0 uses the server's default page size
+	pageSize := max(limit, 0)
+	iter, err := wm.client.ScheduleClient().List(ctx, client.ScheduleListOptions{
+		PageSize: pageSize,
+	})
+	if err != nil {
+		logger.Error(err, "Failed to create schedule list iterator")
+		return nil, fmt.Errorf("list schedules: %w", err)
+	}
+
+	schedules := make([]*ScheduleDetails, 0)
+	for iter.HasNext() && (limit <= 0 || len(schedules) < limit) {
+		entry, err := iter.Next()
+		if err != nil {
+			logger.Error(err, "Failed to get next schedule from iterator")
+			return nil, fmt.Errorf("iterate schedules: %w", err)
+		}
+
+		schedules = append(schedules, &ScheduleDetails{
+			ScheduleID:      entry.ID,
+			WorkflowType:    entry.WorkflowType.Name,
+			Paused:          entry.Paused,
+			Note:            entry.Note,
+			NextActionTimes: entry.NextActionTimes,
+		})
+	}
+
+	logger.Debug("Schedules listed successfully", otel.F("count", len(schedules)))
+	return schedules, nil
+}
+
+// PauseSchedule pauses a schedule so that no new actions are taken
+func (wm *WorkflowManager) PauseSchedule(ctx context.Context, scheduleID, note string) error {
+	logger := otel.NewLogHelper(ctx, nil, "github.com/jasoet/pkg/v2/temporal", "WorkflowManager.PauseSchedule")
+
+	logger.Debug("Pausing schedule", otel.F("scheduleID", scheduleID))
+
+	handle := wm.client.ScheduleClient().GetHandle(ctx, scheduleID)
+	if err := handle.Pause(ctx, client.SchedulePauseOptions{Note: note}); err != nil {
+		logger.Error(err, "Failed to pause schedule", otel.F("scheduleID", scheduleID))
+		return fmt.Errorf("pause schedule %q: %w", scheduleID, err)
+	}
+
+	logger.Debug("Schedule paused successfully", otel.F("scheduleID", scheduleID))
+	return nil
+}
+
+// UnpauseSchedule resumes a paused schedule
+func (wm *WorkflowManager) UnpauseSchedule(ctx context.Context, scheduleID, note string) error {
+	logger := otel.NewLogHelper(ctx, nil, "github.com/jasoet/pkg/v2/temporal", "WorkflowManager.UnpauseSchedule")
+
+	logger.Debug("Unpausing schedule", otel.F("scheduleID", scheduleID))
+
+	handle := wm.client.ScheduleClient().GetHandle(ctx, scheduleID)
+	if err := handle.Unpause(ctx, client.ScheduleUnpauseOptions{Note: note}); err != nil {
+		logger.Error(err, "Failed to unpause schedule", otel.F("scheduleID", scheduleID))
+		return fmt.Errorf("unpause schedule %q: %w", scheduleID, err)
+	}
+
+	logger.Debug("Schedule unpaused successfully", otel.F("scheduleID", scheduleID))
+	return nil
+}
+
+// DeleteSchedule deletes a schedule by ID. Workflows already started by the
+// schedule are not affected.
+func (wm *WorkflowManager) DeleteSchedule(ctx context.Context, scheduleID string) error {
+	logger := otel.NewLogHelper(ctx, nil, "github.com/jasoet/pkg/v2/temporal", "WorkflowManager.DeleteSchedule")
+
+	logger.Debug("Deleting schedule", otel.F("scheduleID", scheduleID))
+
+	handle := wm.client.ScheduleClient().GetHandle(ctx, scheduleID)
+	if err := handle.Delete(ctx); err != nil {
+		logger.Error(err, "Failed to delete schedule", otel.F("scheduleID", scheduleID))
+		return fmt.Errorf("delete schedule %q: %w", scheduleID, err)
+	}
+
+	logger.Debug("Schedule deleted successfully", otel.F("scheduleID", scheduleID))
+	return nil
+}
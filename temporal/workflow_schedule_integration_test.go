@@ -0,0 +1,100 @@
+//go:build integration
+
+package temporal
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jasoet/pkg/v2/temporal/testcontainer"
+)
+
+func TestWorkflowManagerScheduleOperations(t *testing.T) {
+	ctx := context.Background()
+
+	// Start Temporal container and get client
+	_, temporalClient, cleanup, err := testcontainer.Setup(
+		ctx,
+		testcontainer.ClientConfig{Namespace: "default"},
+		testcontainer.Options{Logger: t},
+	)
+	require.NoError(t, err, "Failed to setup temporal container")
+	defer cleanup()
+
+	wm, err := NewWorkflowManager(temporalClient)
+	require.NoError(t, err)
+
+	scheduleID := fmt.Sprintf("test-wm-interval-schedule-%d", time.Now().UnixNano())
+
+	findSchedule := func() *ScheduleDetails {
+		schedules, err := wm.ListSchedules(ctx, 100)
+		require.NoError(t, err)
+		for _, s := range schedules {
+			if s.ScheduleID == scheduleID {
+				return s
+			}
+		}
+		return nil
+	}
+
+	t.Run("CreateSchedule", func(t *testing.T) {
+		err := wm.CreateSchedule(ctx, ScheduleDefinition{
+			ScheduleID:   scheduleID,
+			Interval:     time.Hour,
+			WorkflowType: "SimpleTestWorkflow",
+			TaskQueue:    "test-wm-schedule-queue",
+			Args:         []any{"scheduled"},
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("ListSchedules", func(t *testing.T) {
+		// Schedule visibility is eventually consistent
+		require.Eventually(t, func() bool {
+			return findSchedule() != nil
+		}, 15*time.Second, 500*time.Millisecond, "Schedule should appear in ListSchedules")
+
+		schedule := findSchedule()
+		require.NotNil(t, schedule)
+		assert.Equal(t, "SimpleTestWorkflow", schedule.WorkflowType)
+		assert.False(t, schedule.Paused)
+	})
+
+	t.Run("PauseSchedule", func(t *testing.T) {
+		err := wm.PauseSchedule(ctx, scheduleID, "paused by test")
+		require.NoError(t, err)
+
+		desc, err := temporalClient.ScheduleClient().GetHandle(ctx, scheduleID).Describe(ctx)
+		require.NoError(t, err)
+		assert.True(t, desc.Schedule.State.Paused)
+		assert.Equal(t, "paused by test", desc.Schedule.State.Note)
+	})
+
+	t.Run("UnpauseSchedule", func(t *testing.T) {
+		err := wm.UnpauseSchedule(ctx, scheduleID, "")
+		require.NoError(t, err)
+
+		desc, err := temporalClient.ScheduleClient().GetHandle(ctx, scheduleID).Describe(ctx)
+		require.NoError(t, err)
+		assert.False(t, desc.Schedule.State.Paused)
+	})
+
+	t.Run("DeleteSchedule", func(t *testing.T) {
+		err := wm.DeleteSchedule(ctx, scheduleID)
+		require.NoError(t, err)
+
+		_, err = temporalClient.ScheduleClient().GetHandle(ctx, scheduleID).Describe(ctx)
+		assert.Error(t, err, "Describing a deleted schedule should fail")
+	})
+
+	t.Run("CreateScheduleInvalidDefinition", func(t *testing.T) {
+		err := wm.CreateSchedule(ctx, ScheduleDefinition{ScheduleID: "missing-spec"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid schedule definition")
+	})
+}
@@ -0,0 +1,148 @@
+package temporal
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/client"
+)
+
+func TestScheduleDefinitionValidate(t *testing.T) {
+	valid := ScheduleDefinition{
+		ScheduleID:   "schedule-1",
+		Interval:     time.Minute,
+		WorkflowType: "ReportWorkflow",
+		TaskQueue:    "reports",
+	}
+
+	tests := []struct {
+		name     string
+		modify   func(d *ScheduleDefinition)
+		errorMsg string
+	}{
+		{name: "valid interval", modify: func(d *ScheduleDefinition) {}},
+		{name: "valid cron", modify: func(d *ScheduleDefinition) { d.Interval = 0; d.CronExpression = "0 * * * *" }},
+		{name: "missing schedule ID", modify: func(d *ScheduleDefinition) { d.ScheduleID = "" }, errorMsg: "schedule ID is required"},
+		{name: "missing workflow type", modify: func(d *ScheduleDefinition) { d.WorkflowType = "" }, errorMsg: "workflow type is required"},
+		{name: "missing task queue", modify: func(d *ScheduleDefinition) { d.TaskQueue = "" }, errorMsg: "task queue is required"},
+		{name: "missing spec", modify: func(d *ScheduleDefinition) { d.Interval = 0 }, errorMsg: "either cron expression or a positive interval is required"},
+		{name: "both cron and interval", modify: func(d *ScheduleDefinition) { d.CronExpression = "0 * * * *" }, errorMsg: "mutually exclusive"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			def := valid
+			tt.modify(&def)
+			err := def.validate()
+			if tt.errorMsg == "" {
+				assert.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errorMsg)
+			}
+		})
+	}
+}
+
+func TestScheduleDefinitionToScheduleOptions(t *testing.T) {
+	t.Run("interval", func(t *testing.T) {
+		opts := ScheduleDefinition{
+			ScheduleID:   "interval-schedule",
+			Interval:     30 * time.Second,
+			WorkflowType: "ReportWorkflow",
+			TaskQueue:    "reports",
+			Args:         []any{"daily"},
+		}.toScheduleOptions()
+
+		assert.Equal(t, "interval-schedule", opts.ID)
+		assert.Equal(t, []client.ScheduleIntervalSpec{{Every: 30 * time.Second}}, opts.Spec.Intervals)
+		assert.Empty(t, opts.Spec.CronExpressions)
+
+		action, ok := opts.Action.(*client.ScheduleWorkflowAction)
+		require.True(t, ok)
+		assert.Equal(t, "ReportWorkflow", action.Workflow)
+		assert.Equal(t, "reports", action.TaskQueue)
+		assert.Equal(t, []any{"daily"}, action.Args)
+	})
+
+	t.Run("cron", func(t *testing.T) {
+		opts := ScheduleDefinition{
+			ScheduleID:     "cron-schedule",
+			CronExpression: "0 * * * *",
+			WorkflowType:   "ReportWorkflow",
+			TaskQueue:      "reports",
+		}.toScheduleOptions()
+
+		assert.Equal(t, []string{"0 * * * *"}, opts.Spec.CronExpressions)
+		assert.Empty(t, opts.Spec.Intervals)
+	})
+}
+
+// fakeScheduleClient serves a fixed list of schedules so ListSchedules can be
+// tested without a server. Only List is implemented.
+type fakeScheduleClient struct {
+	client.ScheduleClient
+	ids      []string
+	pageSize int
+}
+
+func (f *fakeScheduleClient) List(_ context.Context, options client.ScheduleListOptions) (client.ScheduleListIterator, error) {
+	f.pageSize = options.PageSize
+	return &fakeScheduleIterator{ids: f.ids}, nil
+}
+
+// fakeTemporalClient is a Temporal client whose only working method is
+// ScheduleClient.
+type fakeTemporalClient struct {
+	client.Client
+	schedules *fakeScheduleClient
+}
+
+func (f *fakeTemporalClient) ScheduleClient() client.ScheduleClient { return f.schedules }
+
+type fakeScheduleIterator struct {
+	ids []string
+}
+
+func (it *fakeScheduleIterator) HasNext() bool { return len(it.ids) > 0 }
+
+func (it *fakeScheduleIterator) Next() (*client.ScheduleListEntry, error) {
+	entry := &client.ScheduleListEntry{ID: it.ids[0]}
+	it.ids = it.ids[1:]
+	return entry, nil
+}
+
+func TestWorkflowManagerListSchedulesLimit(t *testing.T) {
+	ids := []string{"a", "b", "c"}
+
+	tests := []struct {
+		name         string
+		limit        int
+		wantIDs      []string
+		wantPageSize int
+	}{
+		{name: "positive limit", limit: 2, wantIDs: []string{"a", "b"}, wantPageSize: 2},
+		{name: "zero lists all", limit: 0, wantIDs: ids, wantPageSize: 0},
+		{name: "negative lists all", limit: -1, wantIDs: ids, wantPageSize: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeScheduleClient{ids: ids}
+			wm := &WorkflowManager{client: &fakeTemporalClient{schedules: fake}}
+
+			schedules, err := wm.ListSchedules(context.Background(), tt.limit)
+			require.NoError(t, err)
+
+			var got []string
+			for _, s := range schedules {
+				got = append(got, s.ScheduleID)
+			}
+			assert.Equal(t, tt.wantIDs, got)
+			assert.Equal(t, tt.wantPageSize, fake.pageSize)
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package temporal
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/converter"
+)
+
+// updateClient is a client.Client that completes every update with a fixed outcome.
+type updateClient struct {
+	client.Client
+	options client.UpdateWorkflowOptions
+	result  interface{}
+	err     error
+}
+
+func (c *updateClient) UpdateWorkflow(_ context.Context, options client.UpdateWorkflowOptions) (client.WorkflowUpdateHandle, error) {
+	c.options = options
+	return &updateHandle{result: c.result, err: c.err}, nil
+}
+
+type updateHandle struct {
+	client.WorkflowUpdateHandle
+	result interface{}
+	err    error
+}
+
+func (h *updateHandle) UpdateID() string { return "update-1" }
+
+func (h *updateHandle) Get(_ context.Context, valuePtr interface{}) error {
+	if h.err != nil || valuePtr == nil {
+		return h.err
+	}
+	payload, err := converter.GetDefaultDataConverter().ToPayload(h.result)
+	if err != nil {
+		return err
+	}
+	if raw, ok := valuePtr.(*converter.RawValue); ok {
+		*raw = converter.NewRawValue(payload)
+		return nil
+	}
+	return converter.GetDefaultDataConverter().FromPayload(payload, valuePtr)
+}
+
+func TestUpdateWorkflow(t *testing.T) {
+	t.Run("waits for completion and decodes result", func(t *testing.T) {
+		fake := &updateClient{result: 42}
+		wm := &WorkflowManager{client: fake, namespace: "default"}
+
+		value, err := wm.UpdateWorkflow(context.Background(), "order-1", "", "add-item", "apple", 2)
+		require.NoError(t, err)
+		assert.Equal(t, client.WorkflowUpdateStageCompleted, fake.options.WaitForStage)
+		assert.Equal(t, "add-item", fake.options.UpdateName)
+		assert.Equal(t, []interface{}{"apple", 2}, fake.options.Args)
+
+		assert.True(t, value.HasValue())
+		var total int
+		require.NoError(t, value.Get(&total))
+		assert.Equal(t, 42, total)
+	})
+
+	t.Run("handler failure is returned", func(t *testing.T) {
+		wm := &WorkflowManager{client: &updateClient{err: errors.New("item out of stock")}, namespace: "default"}
+
+		value, err := wm.UpdateWorkflow(context.Background(), "order-1", "", "add-item", "apple")
+		assert.Nil(t, value)
+		assert.ErrorContains(t, err, `update workflow "order-1" with "add-item": item out of stock`)
+	})
+
+	t.Run("options default to accepted stage", func(t *testing.T) {
+		fake := &updateClient{}
+		wm := &WorkflowManager{client: fake, namespace: "default"}
+
+		handle, err := wm.UpdateWorkflowWithOptions(context.Background(), client.UpdateWorkflowOptions{
+			WorkflowID: "order-1",
+			UpdateName: "add-item",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "update-1", handle.UpdateID())
+		assert.Equal(t, client.WorkflowUpdateStageAccepted, fake.options.WaitForStage)
+	})
+}